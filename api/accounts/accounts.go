@@ -12,6 +12,7 @@ import (
 	"math/big"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -20,25 +21,37 @@ import (
 	"github.com/meterio/meter-pov/api/utils"
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/logdb"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/runtime"
 	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/trie"
 	"github.com/meterio/meter-pov/tx"
 	"github.com/meterio/meter-pov/xenv"
 	"github.com/pkg/errors"
 )
 
+const (
+	// maxProofKeys limits the number of storage keys proven in a request
+	maxProofKeys = 256
+	// page size of account txs
+	defaultTxsLimit = 100
+	maxTxsLimit     = 1000
+)
+
 type Accounts struct {
 	chain        *chain.Chain
 	stateCreator *state.Creator
+	logDB        *logdb.LogDB
 	callGasLimit uint64
 	logger       *slog.Logger
 }
 
-func New(chain *chain.Chain, stateCreator *state.Creator, callGasLimit uint64) *Accounts {
+func New(chain *chain.Chain, stateCreator *state.Creator, logDB *logdb.LogDB, callGasLimit uint64) *Accounts {
 	return &Accounts{
 		chain,
 		stateCreator,
+		logDB,
 		callGasLimit,
 		slog.With("api", "acct"),
 	}
@@ -123,6 +136,86 @@ func (a *Accounts) handleGetAccount(w http.ResponseWriter, req *http.Request) er
 	return utils.WriteJSON(w, acc)
 }
 
+func (a *Accounts) handleGetTransactions(w http.ResponseWriter, req *http.Request) error {
+	addr, err := meter.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	filter, err := parseAccountTxFilter(addr, req.URL.Query())
+	if err != nil {
+		return err
+	}
+	txs, err := a.logDB.FilterAccountTxs(req.Context(), filter)
+	if err != nil {
+		return err
+	}
+	result := make([]*AccountTx, 0, len(txs))
+	for _, t := range txs {
+		result = append(result, convertAccountTx(t))
+	}
+	return utils.WriteJSON(w, result)
+}
+
+func parseUintQuery(query url.Values, key string, def uint64) (uint64, error) {
+	s := query.Get(key)
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseUint(s, 0, 63)
+	if err != nil {
+		return 0, utils.BadRequest(errors.WithMessage(err, key))
+	}
+	return n, nil
+}
+
+// parseAccountTxFilter parses range, options and order from query, as logs filters do.
+func parseAccountTxFilter(addr meter.Address, query url.Values) (*logdb.AccountTxFilter, error) {
+	filter := &logdb.AccountTxFilter{Address: addr, Order: logdb.ASC}
+
+	unit := logdb.Block
+	switch query.Get("unit") {
+	case "", string(logdb.Block):
+	case string(logdb.Time):
+		unit = logdb.Time
+	default:
+		return nil, utils.BadRequest(errors.New("unit: should be block or time"))
+	}
+	from, err := parseUintQuery(query, "from", 0)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseUintQuery(query, "to", 0)
+	if err != nil {
+		return nil, err
+	}
+	// upper bound is omitted if to is less than from
+	if from > 0 || query.Get("to") != "" {
+		filter.Range = &logdb.Range{Unit: unit, From: from, To: to}
+	}
+
+	offset, err := parseUintQuery(query, "offset", 0)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := parseUintQuery(query, "limit", defaultTxsLimit)
+	if err != nil {
+		return nil, err
+	}
+	if limit > maxTxsLimit {
+		return nil, utils.BadRequest(errors.Errorf("limit: should not exceed %v", maxTxsLimit))
+	}
+	filter.Options = &logdb.Options{Offset: offset, Limit: limit}
+
+	switch query.Get("order") {
+	case "", string(logdb.ASC):
+	case string(logdb.DESC):
+		filter.Order = logdb.DESC
+	default:
+		return nil, utils.BadRequest(errors.New("order: should be asc or desc"))
+	}
+	return filter, nil
+}
+
 func (a *Accounts) handleGetStorage(w http.ResponseWriter, req *http.Request) error {
 	addr, err := meter.ParseAddress(mux.Vars(req)["address"])
 	if err != nil {
@@ -143,6 +236,80 @@ func (a *Accounts) handleGetStorage(w http.ResponseWriter, req *http.Request) er
 	return utils.WriteJSON(w, map[string]string{"value": storage.String()})
 }
 
+func (a *Accounts) getProof(addr meter.Address, keys []meter.Bytes32, header *block.Header) (*AccountProof, error) {
+	state, err := a.stateCreator.NewState(header.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	var accProof trie.ProofList
+	acc, err := state.ProveAccount(addr, &accProof)
+	if err != nil {
+		return nil, err
+	}
+	result := &AccountProof{
+		BlockID:      header.ID(),
+		BlockNumber:  header.Number(),
+		StateRoot:    header.StateRoot(),
+		Address:      addr,
+		Balance:      math.HexOrDecimal256(*acc.Balance),
+		Energy:       math.HexOrDecimal256(*acc.Energy),
+		BoundBalance: math.HexOrDecimal256(*acc.BoundBalance),
+		BoundEnergy:  math.HexOrDecimal256(*acc.BoundEnergy),
+		Master:       hexutil.Encode(acc.Master),
+		CodeHash:     hexutil.Encode(acc.CodeHash),
+		StorageRoot:  hexutil.Encode(acc.StorageRoot),
+		AccountProof: encodeProof(accProof),
+		StorageProof: make([]*StorageProof, 0, len(keys)),
+	}
+	for _, key := range keys {
+		var proof trie.ProofList
+		if err := state.ProveStorage(addr, key, &proof); err != nil {
+			return nil, err
+		}
+		value := state.GetStorage(addr, key)
+		if err := state.Err(); err != nil {
+			return nil, err
+		}
+		result.StorageProof = append(result.StorageProof, &StorageProof{
+			Key:   key,
+			Value: value,
+			Proof: encodeProof(proof),
+		})
+	}
+	return result, nil
+}
+
+func (a *Accounts) handleGetProof(w http.ResponseWriter, req *http.Request) error {
+	addr, err := meter.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	query := req.URL.Query()
+	if len(query["key"]) > maxProofKeys {
+		return utils.BadRequest(fmt.Errorf("key: at most %d keys allowed", maxProofKeys))
+	}
+	keys := make([]meter.Bytes32, 0, len(query["key"]))
+	for _, k := range query["key"] {
+		key, err := meter.ParseBytes32(k)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "key"))
+		}
+		keys = append(keys, key)
+	}
+	h, err := a.handleRevision(query.Get("revision"))
+	if err != nil {
+		return err
+	}
+	proof, err := a.getProof(addr, keys, h)
+	if err != nil {
+		if _, ok := errors.Cause(err).(*trie.MissingNodeError); ok {
+			return utils.HTTPError(fmt.Errorf("revision: state of block %v is pruned", h.Number()), http.StatusGone)
+		}
+		return err
+	}
+	return utils.WriteJSON(w, proof)
+}
+
 func (a *Accounts) handleCallContract(w http.ResponseWriter, req *http.Request) error {
 	callData := &CallData{}
 	if err := utils.ParseJSON(req.Body, &callData); err != nil {
@@ -166,6 +333,17 @@ func (a *Accounts) handleCallContract(w http.ResponseWriter, req *http.Request)
 	} else {
 		addr = nil
 	}
+	result, err := a.CallContract(req.Context(), addr, callData, h)
+	if err != nil {
+		a.logger.Error("eth_call failed", "err", err, "caller", callData.Caller, "value", callData.Value, "token", callData.Token, "data", callData.Data, "gas", callData.Gas, "gasPrice", callData.GasPrice, "sender", mux.Vars(req)["address"])
+		return err
+	}
+	// a.logger.Debug("handleCallContract Results:", results)
+	return utils.WriteJSON(w, result)
+}
+
+// CallContract executes a single clause call to addr (nil for contract creation) on the state of header.
+func (a *Accounts) CallContract(ctx context.Context, addr *meter.Address, callData *CallData, header *block.Header) (*CallResult, error) {
 	var batchCallData = &BatchCallData{
 		Clauses: Clauses{
 			Clause{
@@ -179,13 +357,11 @@ func (a *Accounts) handleCallContract(w http.ResponseWriter, req *http.Request)
 		GasPrice: callData.GasPrice,
 		Caller:   callData.Caller,
 	}
-	results, err := a.batchCall(req.Context(), batchCallData, h)
+	results, err := a.batchCall(ctx, batchCallData, header)
 	if err != nil {
-		a.logger.Error("eth_call failed", "err", err, "caller", callData.Caller, "value", callData.Value, "token", callData.Token, "data", callData.Data, "gas", callData.Gas, "gasPrice", callData.GasPrice, "sender", mux.Vars(req)["address"])
-		return err
+		return nil, err
 	}
-	// a.logger.Debug("handleCallContract Results:", results)
-	return utils.WriteJSON(w, results[0])
+	return results[0], nil
 }
 
 func (a *Accounts) handleCallBatchCode(w http.ResponseWriter, req *http.Request) error {
@@ -343,6 +519,8 @@ func (a *Accounts) Mount(root *mux.Router, pathPrefix string) {
 	sub.Path("/{address}").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetAccount))
 	sub.Path("/{address}/code").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetCode))
 	sub.Path("/{address}/storage/{key}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(a.handleGetStorage))
+	sub.Path("/{address}/proof").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetProof))
+	sub.Path("/{address}/transactions").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(a.handleGetTransactions))
 	sub.Path("/{address}").Methods("POST").HandlerFunc(utils.WrapHandlerFunc(a.handleCallContract))
 
 }
@@ -19,16 +19,19 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
 	ABI "github.com/meterio/meter-pov/abi"
 	"github.com/meterio/meter-pov/api/accounts"
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/logdb"
 	"github.com/meterio/meter-pov/lvldb"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/packer"
 	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/trie"
 	"github.com/meterio/meter-pov/tx"
 	"github.com/stretchr/testify/assert"
 )
@@ -99,6 +102,7 @@ var invalidBytes32 = "0x00000000000000000000000000000000000000000000000000000000
 var invalidNumberRevision = "4294967296"                                                  //invalid block number
 
 var ts *httptest.Server
+var logDB *logdb.LogDB
 
 func TestAccount(t *testing.T) {
 	initAccountServer(t)
@@ -106,6 +110,8 @@ func TestAccount(t *testing.T) {
 	getAccount(t)
 	getCode(t)
 	getStorage(t)
+	getProof(t)
+	getTransactions(t)
 	deployContractWithCall(t)
 	callContract(t)
 	batchCall(t)
@@ -174,6 +180,58 @@ func getStorage(t *testing.T) {
 	assert.Equal(t, http.StatusOK, statusCode, "OK")
 }
 
+func getProof(t *testing.T) {
+	res, statusCode := httpGet(t, ts.URL+"/accounts/"+invalidAddr+"/proof")
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad address")
+
+	res, statusCode = httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/proof?key="+invalidBytes32)
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad storage key")
+
+	res, statusCode = httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/proof?revision="+invalidNumberRevision)
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad revision")
+
+	res, statusCode = httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/proof?key="+storageKey.String())
+	assert.Equal(t, http.StatusOK, statusCode, "OK")
+	var proof accounts.AccountProof
+	if err := json.Unmarshal(res, &proof); err != nil {
+		t.Fatal(err)
+	}
+
+	decode := func(nodes []string) trie.ProofSet {
+		var list [][]byte
+		for _, n := range nodes {
+			list = append(list, hexutil.MustDecode(n))
+		}
+		return trie.NewProofSet(list)
+	}
+	raw, err, _ := trie.VerifyProof(proof.StateRoot, meter.Blake2b(contractAddr[:]).Bytes(), decode(proof.AccountProof))
+	assert.Nil(t, err)
+	var acc state.Account
+	assert.Nil(t, rlp.DecodeBytes(raw, &acc))
+	assert.Equal(t, proof.StorageRoot, hexutil.Encode(acc.StorageRoot))
+
+	assert.Equal(t, 1, len(proof.StorageProof))
+	assert.Equal(t, meter.BytesToBytes32([]byte{storageValue}), proof.StorageProof[0].Value, "storage should be equal")
+	raw, err, _ = trie.VerifyProof(meter.BytesToBytes32(acc.StorageRoot), meter.Blake2b(storageKey[:]).Bytes(), decode(proof.StorageProof[0].Proof))
+	assert.Nil(t, err)
+	assert.NotEmpty(t, raw)
+
+	// the genesis state is built in another db, as if it's pruned
+	stateDB, _ := lvldb.NewMem()
+	b, _, err := genesis.NewDevnet().Build(state.NewCreator(stateDB))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, _ := lvldb.NewMem()
+	c, _ := chain.New(db, b, false)
+	router := mux.NewRouter()
+	accounts.New(c, state.NewCreator(db), logDB, math.MaxUint64).Mount(router, "/accounts")
+	pruned := httptest.NewServer(router)
+	defer pruned.Close()
+	_, statusCode = httpGet(t, pruned.URL+"/accounts/"+addr.String()+"/proof?revision=0")
+	assert.Equal(t, http.StatusGone, statusCode, "pruned")
+}
+
 func initAccountServer(t *testing.T) {
 	meter.InitBlockChainConfig("test")
 	db, _ := lvldb.NewMem()
@@ -185,6 +243,7 @@ func initAccountServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	chain, _ := chain.New(db, b, false)
+	logDB, _ = logdb.NewMem()
 	claTransfer := tx.NewClause(&addr).WithValue(value)
 	claDeploy := tx.NewClause(nil).WithData(bytecode)
 	transaction := buildTxWithClauses(t, chain.Tag(), claTransfer, claDeploy)
@@ -203,7 +262,7 @@ func initAccountServer(t *testing.T) {
 	packTx(chain, stateC, transactionCall, t)
 
 	router := mux.NewRouter()
-	accounts.New(chain, stateC, math.MaxUint64).Mount(router, "/accounts")
+	accounts.New(chain, stateC, logDB, math.MaxUint64).Mount(router, "/accounts")
 	ts = httptest.NewServer(router)
 }
 
@@ -244,6 +303,48 @@ func packTx(chain *chain.Chain, stateC *state.Creator, transaction *tx.Transacti
 	if _, err := chain.AddBlock(b, escortQC, receipts); err != nil {
 		t.Fatal(err)
 	}
+	if err := logDB.Prepare(b.Header()).InsertTx(0, transaction, genesis.DevAccounts()[0].Address, receipts[0].Reverted).Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func getTransactions(t *testing.T) {
+	_, statusCode := httpGet(t, ts.URL+"/accounts/"+addr.String()+"/transactions?order=random")
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad order")
+	_, statusCode = httpGet(t, ts.URL+"/accounts/"+addr.String()+"/transactions?limit=1001")
+	assert.Equal(t, http.StatusBadRequest, statusCode, "bad limit")
+
+	var txs []*accounts.AccountTx
+	res, statusCode := httpGet(t, ts.URL+"/accounts/"+addr.String()+"/transactions")
+	assert.Equal(t, http.StatusOK, statusCode)
+	if err := json.Unmarshal(res, &txs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(txs), "received transfer")
+	assert.Equal(t, uint32(1), txs[0].Meta.BlockNumber)
+
+	// zero value contract call is included
+	res, _ = httpGet(t, ts.URL+"/accounts/"+contractAddr.String()+"/transactions")
+	if err := json.Unmarshal(res, &txs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(txs), "contract call")
+	assert.Equal(t, uint32(2), txs[0].Meta.BlockNumber)
+
+	origin := genesis.DevAccounts()[0].Address
+	res, _ = httpGet(t, ts.URL+"/accounts/"+origin.String()+"/transactions?order=desc&limit=1")
+	if err := json.Unmarshal(res, &txs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(txs), "paginated")
+	assert.Equal(t, uint32(2), txs[0].Meta.BlockNumber)
+	assert.Equal(t, origin, txs[0].Meta.TxOrigin)
+
+	res, _ = httpGet(t, ts.URL+"/accounts/"+origin.String()+"/transactions?from=2")
+	if err := json.Unmarshal(res, &txs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(txs), "ranged")
 }
 
 func deployContractWithCall(t *testing.T) {
@@ -12,8 +12,10 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 
 	"github.com/meterio/meter-pov/api/transactions"
+	"github.com/meterio/meter-pov/logdb"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/runtime"
+	"github.com/meterio/meter-pov/trie"
 )
 
 // Account for marshal account
@@ -25,6 +27,38 @@ type Account struct {
 	HasCode      bool                 `json:"hasCode"`
 }
 
+// AccountProof for marshal account with merkle proofs against the state root, like eth_getProof
+type AccountProof struct {
+	BlockID      meter.Bytes32        `json:"blockID"`
+	BlockNumber  uint32               `json:"blockNumber"`
+	StateRoot    meter.Bytes32        `json:"stateRoot"`
+	Address      meter.Address        `json:"address"`
+	Balance      math.HexOrDecimal256 `json:"balance"`
+	Energy       math.HexOrDecimal256 `json:"energy"`
+	BoundBalance math.HexOrDecimal256 `json:"boundbalance"`
+	BoundEnergy  math.HexOrDecimal256 `json:"boundenergy"`
+	Master       string               `json:"master"`
+	CodeHash     string               `json:"codeHash"`
+	StorageRoot  string               `json:"storageRoot"`
+	AccountProof []string             `json:"accountProof"`
+	StorageProof []*StorageProof      `json:"storageProof"`
+}
+
+// StorageProof for marshal storage value with merkle proof against the account storage root
+type StorageProof struct {
+	Key   meter.Bytes32 `json:"key"`
+	Value meter.Bytes32 `json:"value"`
+	Proof []string      `json:"proof"`
+}
+
+func encodeProof(proof trie.ProofList) []string {
+	nodes := make([]string, len(proof))
+	for i, n := range proof {
+		nodes[i] = hexutil.Encode(n)
+	}
+	return nodes
+}
+
 // CallData represents contract-call body
 type CallData struct {
 	Value    *math.HexOrDecimal256 `json:"value"`
@@ -114,3 +148,24 @@ type BatchCallData struct {
 }
 
 type BatchCallResults []*CallResult
+
+// AccountTx is a tx sent or received by the account.
+type AccountTx struct {
+	TxIndex  uint32               `json:"txIndex"`
+	Reverted bool                 `json:"reverted"`
+	Meta     transactions.LogMeta `json:"meta"`
+}
+
+func convertAccountTx(t *logdb.AccountTx) *AccountTx {
+	return &AccountTx{
+		TxIndex:  t.Index,
+		Reverted: t.Reverted,
+		Meta: transactions.LogMeta{
+			BlockID:        t.BlockID,
+			BlockNumber:    t.BlockNumber,
+			BlockTimestamp: t.BlockTime,
+			TxID:           t.TxID,
+			TxOrigin:       t.TxOrigin,
+		},
+	}
+}
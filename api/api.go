@@ -20,6 +20,7 @@ import (
 	"github.com/meterio/meter-pov/api/doc"
 	"github.com/meterio/meter-pov/api/events"
 	"github.com/meterio/meter-pov/api/eventslegacy"
+	"github.com/meterio/meter-pov/api/jsonrpc"
 	"github.com/meterio/meter-pov/api/node"
 	"github.com/meterio/meter-pov/api/peers"
 	"github.com/meterio/meter-pov/api/slashing"
@@ -28,6 +29,8 @@ import (
 	"github.com/meterio/meter-pov/api/transactions"
 	"github.com/meterio/meter-pov/api/transfers"
 	"github.com/meterio/meter-pov/api/transferslegacy"
+	txpoolapi "github.com/meterio/meter-pov/api/txpool"
+	"github.com/meterio/meter-pov/api/utils"
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/consensus"
 	"github.com/meterio/meter-pov/logdb"
@@ -37,12 +40,13 @@ import (
 )
 
 // New return api router
-func New(reactor *consensus.Reactor, chain *chain.Chain, stateCreator *state.Creator, txPool *txpool.TxPool, logDB *logdb.LogDB, nw node.Network, allowedOrigins string, backtraceLimit uint32, callGasLimit uint64, p2pServer *p2psrv.Server, pubKey string) (http.HandlerFunc, func()) {
+func New(reactor *consensus.Reactor, chain *chain.Chain, stateCreator *state.Creator, txPool *txpool.TxPool, logDB *logdb.LogDB, nw node.Network, allowedOrigins string, backtraceLimit uint32, callGasLimit uint64, p2pServer *p2psrv.Server, pubKey string, adminToken string) (http.HandlerFunc, func()) {
 	origins := strings.Split(strings.TrimSpace(allowedOrigins), ",")
 	for i, o := range origins {
 		origins[i] = strings.ToLower(strings.TrimSpace(o))
 	}
 
+	utils.RegisterMetrics()
 	router := mux.NewRouter()
 
 	// to serve api doc and swagger-ui
@@ -59,7 +63,7 @@ func New(reactor *consensus.Reactor, chain *chain.Chain, stateCreator *state.Cre
 			http.Redirect(w, req, "doc/swagger-ui/", http.StatusTemporaryRedirect)
 		})
 
-	accounts.New(chain, stateCreator, callGasLimit).
+	accounts.New(chain, stateCreator, logDB, callGasLimit).
 		Mount(router, "/accounts")
 	eventslegacy.New(logDB).
 		Mount(router, "/events")
@@ -73,10 +77,12 @@ func New(reactor *consensus.Reactor, chain *chain.Chain, stateCreator *state.Cre
 		Mount(router, "/logs/transfers")
 	transfers.New(logDB).
 		Mount(router, "/logs/transfer")
-	blocks.New(chain, stateCreator).
+	blocks.New(chain, stateCreator, txPool).
 		Mount(router, "/blocks")
 	transactions.New(chain, stateCreator, txPool).
 		Mount(router, "/transactions")
+	txpoolapi.New(chain, txPool, adminToken).
+		Mount(router, "/txpool")
 	debug.New(chain, stateCreator).
 		Mount(router, "/debug")
 	node.New(nw, reactor, pubKey).
@@ -84,17 +90,24 @@ func New(reactor *consensus.Reactor, chain *chain.Chain, stateCreator *state.Cre
 	peers.New(p2pServer).Mount(router, "/peers")
 	subs := subscriptions.New(chain, origins, backtraceLimit)
 	subs.Mount(router, "/subscriptions")
-	staking.New(chain, stateCreator).
-		Mount(router, "/staking")
+	stakingAPI := staking.New(chain, stateCreator, logDB)
+	stakingAPI.Mount(router, "/staking")
 	slashing.New(chain, stateCreator).
 		Mount(router, "/slashing")
 	auction.New(chain, stateCreator).
 		Mount(router, "/auction")
 	accountlock.New(chain, stateCreator).
 		Mount(router, "/accountlock")
+	rpc := jsonrpc.New(chain, stateCreator, txPool, logDB, origins, callGasLimit)
+	rpc.Mount(router, "/rpc")
 
 	return handlers.CORS(
 			handlers.AllowedOrigins(origins),
 			handlers.AllowedHeaders([]string{"content-type"}))(router).ServeHTTP,
-		subs.Close // subscriptions handles hijacked conns, which need to be closed
+		func() {
+			// subscriptions and rpc websockets handle hijacked conns, which need to be closed
+			subs.Close()
+			rpc.Close()
+			stakingAPI.Close()
+		}
 }
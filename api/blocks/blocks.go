@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/gorilla/mux"
@@ -20,19 +21,24 @@ import (
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/state"
 	"github.com/meterio/meter-pov/tx"
+	"github.com/meterio/meter-pov/txpool"
 	"github.com/pkg/errors"
 )
 
 type Blocks struct {
 	chain  *chain.Chain
 	stateC *state.Creator
+	pool   *txpool.TxPool
+	fees   *feeCache
 	logger *slog.Logger
 }
 
-func New(chain *chain.Chain, stateC *state.Creator) *Blocks {
+func New(chain *chain.Chain, stateC *state.Creator, pool *txpool.TxPool) *Blocks {
 	return &Blocks{
 		chain,
 		stateC,
+		pool,
+		newFeeCache(),
 		slog.With("api", "blk"),
 	}
 }
@@ -316,9 +322,64 @@ func (b *Blocks) handleGetBaseFee(w http.ResponseWriter, req *http.Request) erro
 	return utils.WriteJSON(w, baseGasPrice)
 }
 
+func (b *Blocks) handleGetFeeHistory(w http.ResponseWriter, req *http.Request) error {
+	query := req.URL.Query()
+	blockCount, err := strconv.ParseUint(query.Get("blockCount"), 0, 32)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "blockCount"))
+	}
+	if blockCount == 0 || blockCount > maxFeeHistoryBlocks {
+		return utils.BadRequest(errors.WithMessage(errors.Errorf("should be in range [1, %v]", maxFeeHistoryBlocks), "blockCount"))
+	}
+	revision, err := b.parseRevision(query.Get("newestBlock"))
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "newestBlock"))
+	}
+	var percentiles []float64
+	if s := query.Get("rewardPercentiles"); s != "" {
+		items := strings.Split(s, ",")
+		if len(items) > maxRewardPercentiles {
+			return utils.BadRequest(errors.WithMessage(errors.New("too many percentiles"), "rewardPercentiles"))
+		}
+		for i, item := range items {
+			p, err := strconv.ParseFloat(strings.TrimSpace(item), 64)
+			if err != nil {
+				return utils.BadRequest(errors.WithMessage(err, "rewardPercentiles"))
+			}
+			if p < 0 || p > 100 || (i > 0 && p < percentiles[i-1]) {
+				return utils.BadRequest(errors.WithMessage(errors.New("should be ascending and in range [0, 100]"), "rewardPercentiles"))
+			}
+			percentiles = append(percentiles, p)
+		}
+	}
+
+	newest, err := b.getBlock(revision)
+	if err != nil {
+		if b.chain.IsNotFound(err) {
+			return utils.WriteJSON(w, nil)
+		}
+		return err
+	}
+	history, err := b.feeHistory(newest, uint32(blockCount), percentiles)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, history)
+}
+
+func (b *Blocks) handleGetGasPriceOracle(w http.ResponseWriter, req *http.Request) error {
+	oracle, err := b.gasPriceOracle()
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, oracle)
+}
+
 func (b *Blocks) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 	sub.Path("/baseFee").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetBaseFee))
+	sub.Path("/feeHistory").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetFeeHistory))
+	sub.Path("/gasPriceOracle").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetGasPriceOracle))
 	sub.Path("/qc/{revision}").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(b.handleGetQC))
 	sub.Path("/{revision}").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(b.handleGetBlock))
 	sub.Path("/epoch/{epoch}").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(b.handleGetEpochPowInfo))
@@ -18,6 +18,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/meterio/meter-pov/api/blocks"
 	meter_block "github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/builtin"
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/genesis"
 	"github.com/meterio/meter-pov/lvldb"
@@ -34,6 +35,7 @@ const (
 )
 
 var blk *meter_block.Block
+var baseFee *big.Int
 var ts *httptest.Server
 
 var invalidBytes32 = "0x000000000000000000000000000000000000000000000000000000000000000g" //invlaid bytes32
@@ -73,6 +75,42 @@ func TestBlock(t *testing.T) {
 
 }
 
+func TestFeeHistory(t *testing.T) {
+	initBlockServer(t)
+	defer ts.Close()
+
+	_, statusCode := httpGet(t, ts.URL+"/blocks/feeHistory?blockCount=0")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	_, statusCode = httpGet(t, ts.URL+"/blocks/feeHistory?blockCount=2&rewardPercentiles=50,10")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	res, statusCode := httpGet(t, ts.URL+"/blocks/feeHistory?blockCount=10&rewardPercentiles=10,50")
+	assert.Equal(t, http.StatusOK, statusCode)
+	var history blocks.FeeHistory
+	if err := json.Unmarshal(res, &history); err != nil {
+		t.Fatal(err)
+	}
+	// tx with gas price coef 1 pays baseFee/255 above base fee
+	priorityFee := new(big.Int).Div(baseFee, big.NewInt(255))
+	assert.Equal(t, uint32(0), history.OldestBlock)
+	assert.Equal(t, 3, len(history.BaseFee))
+	assert.Equal(t, baseFee, (*big.Int)(history.BaseFee[1]))
+	assert.Equal(t, []float64{0, float64(blk.GasUsed()) / float64(blk.GasLimit())}, history.GasUsedRatio)
+	assert.Equal(t, int64(0), (*big.Int)(history.Reward[0][1]).Int64())
+	assert.Equal(t, priorityFee, (*big.Int)(history.Reward[1][0]))
+	assert.Equal(t, priorityFee, (*big.Int)(history.Reward[1][1]))
+
+	res, statusCode = httpGet(t, ts.URL+"/blocks/gasPriceOracle")
+	assert.Equal(t, http.StatusOK, statusCode)
+	var oracle blocks.GasPriceOracle
+	if err := json.Unmarshal(res, &oracle); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, baseFee, (*big.Int)(oracle.BaseFee))
+	assert.Equal(t, priorityFee, (*big.Int)(oracle.PriorityFee))
+	assert.Equal(t, new(big.Int).Add(baseFee, priorityFee), (*big.Int)(oracle.GasPrice))
+}
+
 func initBlockServer(t *testing.T) {
 	meter.InitBlockChainConfig("test")
 	db, _ := lvldb.NewMem()
@@ -84,6 +122,8 @@ func initBlockServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	chain, _ := chain.New(db, b, false)
+	st, _ := stateC.NewState(b.Header().StateRoot())
+	baseFee = builtin.Params.Native(st).Get(meter.KeyBaseGasPrice)
 	addr := meter.BytesToAddress([]byte("to"))
 	cla := tx.NewClause(&addr).WithValue(big.NewInt(10000))
 	tx := new(tx.Builder).
@@ -123,7 +163,7 @@ func initBlockServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	router := mux.NewRouter()
-	blocks.New(chain, stateC).Mount(router, "/blocks")
+	blocks.New(chain, stateC, nil).Mount(router, "/blocks")
 	ts = httptest.NewServer(router)
 	blk = block
 }
@@ -0,0 +1,259 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package blocks
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/builtin"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxFeeHistoryBlocks  = 1024
+	maxRewardPercentiles = 100
+	feeCacheSize         = 2048
+
+	oracleSampleBlocks    = 20 // number of recent blocks sampled by gas price oracle
+	oracleSamplesPerBlock = 3  // lowest priority fees sampled from each block
+	oraclePercentile      = 60
+)
+
+// FeeHistory is the fee history over a block range, as eth_feeHistory.
+type FeeHistory struct {
+	OldestBlock  uint32                    `json:"oldestBlock"`
+	BaseFee      []*math.HexOrDecimal256   `json:"baseFeePerGas"` // includes base fee of the block next to newest
+	GasUsedRatio []float64                 `json:"gasUsedRatio"`
+	Reward       [][]*math.HexOrDecimal256 `json:"reward,omitempty"`
+}
+
+// GasPriceOracle is the suggested gas price of next block.
+type GasPriceOracle struct {
+	BaseFee     *math.HexOrDecimal256 `json:"baseFeePerGas"`
+	PriorityFee *math.HexOrDecimal256 `json:"priorityFeePerGas"`
+	GasPrice    *math.HexOrDecimal256 `json:"gasPrice"` // base fee + priority fee
+	Pending     int                   `json:"pending"`  // count of executable txs in the pool
+}
+
+type txFee struct {
+	priorityFee *big.Int
+	gasUsed     uint64
+}
+
+// blockFees is fee data of a block, computed from its parent state and receipts.
+type blockFees struct {
+	baseFee      *big.Int
+	gasUsedRatio float64
+	txs          []*txFee // sorted by priority fee in ascending order
+}
+
+// feeCache caches fee data of blocks, keyed by block ID.
+type feeCache struct {
+	*lru.Cache
+}
+
+func newFeeCache() *feeCache {
+	c, err := lru.New(feeCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &feeCache{c}
+}
+
+func (b *Blocks) baseFee(stateRoot meter.Bytes32) (*big.Int, error) {
+	s, err := b.stateC.NewState(stateRoot)
+	if err != nil {
+		return nil, err
+	}
+	baseFee := builtin.Params.Native(s).Get(meter.KeyBaseGasPrice)
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return baseFee, nil
+}
+
+// blockFees returns fee data of the block. The base fee applied to a block is read from its parent state,
+// and priority fee of a tx is the price it paid above the base fee.
+func (b *Blocks) blockFees(blk *block.Block) (*blockFees, error) {
+	if cached, ok := b.fees.Get(blk.ID()); ok {
+		return cached.(*blockFees), nil
+	}
+
+	stateRoot := blk.StateRoot()
+	if blk.Number() > 0 {
+		parent, err := b.chain.GetBlockHeader(blk.ParentID())
+		if err != nil {
+			return nil, errors.WithMessage(err, "parent")
+		}
+		stateRoot = parent.StateRoot()
+	}
+	baseFee, err := b.baseFee(stateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	fees := &blockFees{
+		baseFee: baseFee,
+		txs:     make([]*txFee, 0, len(blk.Txs)),
+	}
+	if gasLimit := blk.GasLimit(); gasLimit > 0 {
+		fees.gasUsedRatio = float64(blk.GasUsed()) / float64(gasLimit)
+	}
+	if len(blk.Txs) > 0 {
+		receipts, err := b.chain.GetBlockReceipts(blk.ID())
+		if err != nil {
+			return nil, errors.WithMessage(err, "receipts")
+		}
+		for _, r := range receipts {
+			if r.GasUsed == 0 || r.Paid == nil {
+				continue
+			}
+			priorityFee := new(big.Int).Div(r.Paid, new(big.Int).SetUint64(r.GasUsed))
+			priorityFee.Sub(priorityFee, baseFee)
+			if priorityFee.Sign() < 0 {
+				priorityFee.SetUint64(0)
+			}
+			fees.txs = append(fees.txs, &txFee{priorityFee, r.GasUsed})
+		}
+		sort.Slice(fees.txs, func(i, j int) bool {
+			return fees.txs[i].priorityFee.Cmp(fees.txs[j].priorityFee) < 0
+		})
+	}
+
+	b.fees.Add(blk.ID(), fees)
+	return fees, nil
+}
+
+// rewards returns priority fees at the given percentiles, weighted by gas used.
+func (f *blockFees) rewards(percentiles []float64) []*math.HexOrDecimal256 {
+	rewards := make([]*math.HexOrDecimal256, len(percentiles))
+	if len(f.txs) == 0 {
+		for i := range rewards {
+			rewards[i] = (*math.HexOrDecimal256)(new(big.Int))
+		}
+		return rewards
+	}
+
+	var totalGasUsed uint64
+	for _, t := range f.txs {
+		totalGasUsed += t.gasUsed
+	}
+	var (
+		txIndex    = 0
+		sumGasUsed = f.txs[0].gasUsed
+	)
+	for i, p := range percentiles {
+		threshold := uint64(float64(totalGasUsed) * p / 100)
+		for sumGasUsed < threshold && txIndex < len(f.txs)-1 {
+			txIndex++
+			sumGasUsed += f.txs[txIndex].gasUsed
+		}
+		rewards[i] = (*math.HexOrDecimal256)(f.txs[txIndex].priorityFee)
+	}
+	return rewards
+}
+
+// feeHistory collects fee data of blockCount blocks up to newest.
+func (b *Blocks) feeHistory(newest *block.Block, blockCount uint32, percentiles []float64) (*FeeHistory, error) {
+	if blockCount > newest.Number()+1 {
+		blockCount = newest.Number() + 1
+	}
+
+	nextBaseFee, err := b.baseFee(newest.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	history := &FeeHistory{
+		OldestBlock:  newest.Number() + 1 - blockCount,
+		BaseFee:      make([]*math.HexOrDecimal256, blockCount+1),
+		GasUsedRatio: make([]float64, blockCount),
+	}
+	if len(percentiles) > 0 {
+		history.Reward = make([][]*math.HexOrDecimal256, blockCount)
+	}
+	history.BaseFee[blockCount] = (*math.HexOrDecimal256)(nextBaseFee)
+
+	blk := newest
+	for i := int(blockCount) - 1; i >= 0; i-- {
+		if i < int(blockCount)-1 {
+			if blk, err = b.chain.GetBlock(blk.ParentID()); err != nil {
+				return nil, err
+			}
+		}
+		fees, err := b.blockFees(blk)
+		if err != nil {
+			return nil, err
+		}
+		history.BaseFee[i] = (*math.HexOrDecimal256)(fees.baseFee)
+		history.GasUsedRatio[i] = fees.gasUsedRatio
+		if history.Reward != nil {
+			history.Reward[i] = fees.rewards(percentiles)
+		}
+	}
+	return history, nil
+}
+
+// gasPriceOracle suggests priority fee from the lowest priority fees of recent blocks. If executable txs
+// in the pool exceed the gas limit of next block, the priority fee is raised to the one at the limit.
+func (b *Blocks) gasPriceOracle() (*GasPriceOracle, error) {
+	best := b.chain.BestBlock()
+	baseFee, err := b.baseFee(best.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+
+	var samples []*big.Int
+	blk := best
+	for i := 0; i < oracleSampleBlocks; i++ {
+		fees, err := b.blockFees(blk)
+		if err != nil {
+			return nil, err
+		}
+		for j := 0; j < len(fees.txs) && j < oracleSamplesPerBlock; j++ {
+			samples = append(samples, fees.txs[j].priorityFee)
+		}
+		if blk.Number() == 0 {
+			break
+		}
+		if blk, err = b.chain.GetBlock(blk.ParentID()); err != nil {
+			return nil, err
+		}
+	}
+	priorityFee := new(big.Int)
+	if len(samples) > 0 {
+		sort.Slice(samples, func(i, j int) bool { return samples[i].Cmp(samples[j]) < 0 })
+		priorityFee.Set(samples[(len(samples)-1)*oraclePercentile/100])
+	}
+
+	var executables tx.Transactions
+	if b.pool != nil {
+		executables = b.pool.Executables()
+	}
+	// executables are sorted by overall gas price from high to low
+	var gasSum uint64
+	for _, trx := range executables {
+		gasSum += trx.Gas()
+		if gasSum > best.GasLimit() {
+			if fee := new(big.Int).Sub(trx.GasPrice(baseFee), baseFee); fee.Cmp(priorityFee) > 0 {
+				priorityFee = fee
+			}
+			break
+		}
+	}
+
+	return &GasPriceOracle{
+		BaseFee:     (*math.HexOrDecimal256)(baseFee),
+		PriorityFee: (*math.HexOrDecimal256)(priorityFee),
+		GasPrice:    (*math.HexOrDecimal256)(new(big.Int).Add(baseFee, priorityFee)),
+		Pending:     len(executables),
+	}, nil
+}
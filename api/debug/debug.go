@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/gorilla/mux"
+	"github.com/meterio/meter-pov/api/transactions"
 	"github.com/meterio/meter-pov/api/utils"
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/builtin"
@@ -28,6 +29,7 @@ import (
 	"github.com/meterio/meter-pov/runtime"
 	"github.com/meterio/meter-pov/state"
 	"github.com/meterio/meter-pov/tracers"
+	"github.com/meterio/meter-pov/tracers/native"
 	"github.com/meterio/meter-pov/trie"
 	"github.com/meterio/meter-pov/vm"
 	"github.com/meterio/meter-pov/xenv"
@@ -44,6 +46,13 @@ var (
 	Magic = [4]byte{0x00, 0x00, 0x00, 0x00}
 )
 
+const (
+	maxStateDiffRange        = 1000 // max number of blocks between revisions of a state diff
+	defaultStateDiffLimit    = 100  // changed accounts per page
+	maxStateDiffLimit        = 1000
+	maxStateDiffStorageLimit = 1000 // changed storage slots of each account
+)
+
 func New(chain *chain.Chain, stateC *state.Creator) *Debug {
 	return &Debug{
 		chain,
@@ -208,10 +217,9 @@ func (d *Debug) handleClauseEnv(ctx context.Context, blockID meter.Bytes32, txIn
 
 // trace an existed transaction
 func (d *Debug) traceTransactionWithAllClauses(ctx context.Context, blockID meter.Bytes32, txIndex uint64) (interface{}, error) {
-	se := d.isScriptEngineClause(blockID, txIndex, uint64(0))
-	if se {
-		d.logger.Debug("Tx has Script Engine clause, skip tracing", "se", se, "blockID", blockID, "txIndex", txIndex, "clauseIndex", 0)
-		return nil, nil
+	if clauses := d.scriptEngineClauses(blockID, txIndex); len(clauses) > 0 {
+		d.logger.Debug("Tx has Script Engine clause, trace each clause", "blockID", blockID, "txIndex", txIndex, "clauses", clauses)
+		return d.traceClauses(ctx, blockID, txIndex)
 	}
 	rt, txExec, err := d.handleTxEnvNew(ctx, blockID, txIndex)
 	defer func() {
@@ -221,11 +229,7 @@ func (d *Debug) traceTransactionWithAllClauses(ctx context.Context, blockID mete
 	if err != nil {
 		return nil, err
 	}
-	code, ok := tracers.CodeByName("callTracer")
-	if !ok {
-		return nil, errors.New("name: unsupported tracer")
-	}
-	tracer, err := tracers.New(code)
+	tracer, err := tracers.NewByName("callTracer", nil)
 	if err != nil {
 		return nil, errors.New("could not get tracer")
 	}
@@ -248,6 +252,59 @@ func (d *Debug) traceTransactionWithAllClauses(ctx context.Context, blockID mete
 	return tracer.GetResult()
 }
 
+// scriptEngineClauses returns indexes of script engine clauses in the transaction.
+func (d *Debug) scriptEngineClauses(blockID meter.Bytes32, txIndex uint64) (indexes []uint64) {
+	block, err := d.chain.GetBlock(blockID)
+	if err != nil || txIndex >= uint64(len(block.Transactions())) {
+		return nil
+	}
+	for i := range block.Transactions()[txIndex].Clauses() {
+		if d.isScriptEngineClause(blockID, txIndex, uint64(i)) {
+			indexes = append(indexes, uint64(i))
+		}
+	}
+	return
+}
+
+// trace each clause of an existed transaction with script engine clauses, the result of a script engine
+// clause is *TraceResult, and that of other clauses is the call tracer result.
+func (d *Debug) traceClauses(ctx context.Context, blockID meter.Bytes32, txIndex uint64) ([]interface{}, error) {
+	rt, txExec, err := d.handleTxEnvNew(ctx, blockID, txIndex)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]interface{}, 0)
+	for clauseIndex := uint64(0); txExec.HasNextClause(); clauseIndex++ {
+		se := d.isScriptEngineClause(blockID, txIndex, clauseIndex)
+		var tracer tracers.ResultTracer
+		if !se {
+			if tracer, err = tracers.NewByName("callTracer", nil); err != nil {
+				return nil, errors.New("could not get tracer")
+			}
+			rt.SetVMConfig(vm.Config{Debug: true, Tracer: tracer})
+		}
+		gasUsed, output, err := txExec.NextClause()
+		if err != nil {
+			return nil, err
+		}
+		rt.SetVMConfig(vm.Config{})
+		if se {
+			res, err := d.scriptEngineTrace(blockID, txIndex, clauseIndex, gasUsed, output)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, res)
+			continue
+		}
+		res, err := tracer.GetResult()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
 type TraceResult struct {
 	Type    string        `json:"type"`
 	From    meter.Address `json:"from"`
@@ -260,6 +317,11 @@ type TraceResult struct {
 	Error   string        `json:"error"`
 	Time    string        `json:"time"`
 	// Calls
+
+	// script engine call, balance movements and events of the clause
+	Call      *ScriptCall              `json:"call"`
+	Transfers []*transactions.Transfer `json:"transfers"`
+	Events    []*transactions.Event    `json:"events"`
 }
 
 // trace an existed transaction
@@ -276,22 +338,20 @@ func (d *Debug) execClause(ctx context.Context, blockID meter.Bytes32, txIndex u
 		return nil, err
 	}
 
-	errMsg := ""
-	if output.VMErr != nil {
-		errMsg = output.VMErr.Error()
+	return d.scriptEngineTrace(blockID, txIndex, clauseIndex, gasUsed, output)
+}
+
+func (d *Debug) scriptEngineTrace(blockID meter.Bytes32, txIndex uint64, clauseIndex uint64, gasUsed uint64, output *runtime.Output) (*TraceResult, error) {
+	b, err := d.chain.GetBlock(blockID)
+	if err != nil {
+		return nil, err
 	}
-	b, _ := d.chain.GetBlock(blockID)
 	tx := b.Transactions()[txIndex]
-	clause := tx.Clauses()[clauseIndex]
-	return &TraceResult{
-		Type:    "SCRIPTENGINE",
-		To:      *clause.To(),
-		Input:   "",
-		Output:  "0x" + hex.EncodeToString(output.Data),
-		Error:   errMsg,
-		Value:   clause.Value(),
-		Gas:     big.NewInt(int64(tx.Gas())),
-		GasUsed: big.NewInt(int64(gasUsed))}, nil
+	origin, err := tx.Signer()
+	if err != nil {
+		return nil, err
+	}
+	return newScriptEngineTrace(origin, tx.Clauses()[clauseIndex], gasUsed, output), nil
 }
 
 // trace an existed transaction
@@ -300,6 +360,9 @@ func (d *Debug) traceTransaction(ctx context.Context, tracer vm.Tracer, blockID
 	if err != nil {
 		return nil, err
 	}
+	if st, ok := tracer.(native.StateTracer); ok {
+		st.SetState(rt.State())
+	}
 	rt.SetVMConfig(vm.Config{Debug: true, Tracer: tracer})
 	gasUsed, output, err := txExec.NextClause()
 	if err != nil {
@@ -313,7 +376,11 @@ func (d *Debug) traceTransaction(ctx context.Context, tracer vm.Tracer, blockID
 			ReturnValue: hexutil.Encode(output.Data),
 			StructLogs:  formatLogs(tr.StructLogs()),
 		}, nil
-	case *tracers.Tracer:
+	case tracers.ResultTracer:
+		// script engine clauses never reach the VM, report the script engine call instead
+		if d.isScriptEngineClause(blockID, txIndex, clauseIndex) {
+			return d.scriptEngineTrace(blockID, txIndex, clauseIndex, gasUsed, output)
+		}
 		return tr.GetResult()
 	default:
 		return nil, fmt.Errorf("bad tracer type %T", tracer)
@@ -417,6 +484,29 @@ func (d *Debug) convertTraceData(callTraceResult CallTraceResult, path []uint64,
 	return datas, nil
 }
 
+func convertScriptEngineTrace(res *TraceResult, blockHash meter.Bytes32, blockNumber uint64, txHash meter.Bytes32, txIndex uint64) *TraceData {
+	return &TraceData{
+		Action: TraceAction{
+			CallType: "scriptengine",
+			From:     res.From,
+			Input:    res.Input,
+			Gas:      hexutil.EncodeBig(res.Gas),
+			To:       res.To,
+			Value:    hexutil.EncodeBig(res.Value),
+		},
+		BlockHash:   blockHash,
+		BlockNumber: blockNumber,
+		Result: TraceDataResult{
+			GasUsed: hexutil.EncodeBig(res.GasUsed),
+			Output:  res.Output,
+		},
+		TraceAddress:        []uint64{},
+		TransactionHash:     txHash,
+		TransactionPosition: txIndex,
+		Type:                "call",
+	}
+}
+
 func (d *Debug) handleRerunTransaction(w http.ResponseWriter, req *http.Request) error {
 	clauseIndexStr := mux.Vars(req)["clauseIndex"]
 	clauseIndex, err := strconv.Atoi(clauseIndexStr)
@@ -471,11 +561,7 @@ func (d *Debug) handleNewTraceTransaction(w http.ResponseWriter, req *http.Reque
 			return err
 		}
 	} else {
-		code, ok := tracers.CodeByName("callTracer")
-		if !ok {
-			return utils.BadRequest(errors.New("name: unsupported tracer"))
-		}
-		tracer, err := tracers.New(code)
+		tracer, err := tracers.NewByName("callTracer", nil)
 		if err != nil {
 			return err
 		}
@@ -500,18 +586,21 @@ func (d *Debug) handleTraceTransaction(w http.ResponseWriter, req *http.Request)
 	var tracer vm.Tracer
 	if opt.Name == "" {
 		tracer = vm.NewStructLogger(nil)
+	} else if strings.HasPrefix(strings.TrimSpace(opt.Name), "{") {
+		// custom JavaScript tracer
+		tr, err := tracers.New(opt.Name)
+		if err != nil {
+			return utils.BadRequest(errors.WithMessage(err, "name"))
+		}
+		tracer = tr
 	} else {
 		name := opt.Name
 		if !strings.HasSuffix(name, "Tracer") {
 			name += "Tracer"
 		}
-		code, ok := tracers.CodeByName(name)
-		if !ok {
-			return utils.BadRequest(errors.New("name: unsupported tracer"))
-		}
-		tr, err := tracers.New(code)
+		tr, err := tracers.NewByName(name, opt.Config)
 		if err != nil {
-			return err
+			return utils.BadRequest(errors.WithMessage(err, "name"))
 		}
 		tracer = tr
 	}
@@ -684,6 +773,21 @@ func (d *Debug) openEthTraceTransaction(ctx context.Context, txHash meter.Bytes3
 	if res == nil {
 		return datas, nil
 	}
+	if clauseResults, ok := res.([]interface{}); ok {
+		for _, r := range clauseResults {
+			switch r := r.(type) {
+			case *TraceResult:
+				datas = append(datas, convertScriptEngineTrace(r, meta.BlockID, uint64(blk.Number()), tx.ID(), meta.Index))
+			case json.RawMessage:
+				clauseDatas, err := d.parseMeterTrace(r, meta.BlockID, uint64(blk.Number()), tx.ID(), meta.Index)
+				if err != nil {
+					return datas, err
+				}
+				datas = append(datas, clauseDatas...)
+			}
+		}
+		return datas, nil
+	}
 	resBytes, ok := res.(json.RawMessage)
 	if !ok {
 		return datas, errors.New("not expected res")
@@ -731,7 +835,7 @@ func (d *Debug) handleOpenEthTraceBlock(w http.ResponseWriter, req *http.Request
 	revision, err := d.parseRevision(params[0])
 	d.logger.Debug("handle trace block", "revision:", revision)
 	if err != nil {
-		d.logger.Debug("Error: could not parse reivision", err)
+		d.logger.Debug("Error: could not parse reivision", "err", err)
 		return utils.BadRequest(errors.WithMessage(err, "could not parse revision"))
 	}
 	blk, err := d.getBlock(revision)
@@ -931,11 +1035,80 @@ func (d *Debug) handleGetRawStorage(w http.ResponseWriter, req *http.Request) er
 	return utils.WriteJSON(w, map[string]string{"raw": "0x" + hex.EncodeToString(raw)})
 }
 
+func (d *Debug) handleStateDiff(w http.ResponseWriter, req *http.Request) error {
+	query := req.URL.Query()
+	if query.Get("from") == "" || query.Get("to") == "" {
+		return utils.BadRequest(errors.New("from and to revisions are required"))
+	}
+	from, err := d.handleRevision(query.Get("from"))
+	if err != nil {
+		return err
+	}
+	to, err := d.handleRevision(query.Get("to"))
+	if err != nil {
+		return err
+	}
+	if from.Number() > to.Number()+maxStateDiffRange || to.Number() > from.Number()+maxStateDiffRange {
+		return utils.BadRequest(fmt.Errorf("revisions are more than %v blocks apart", maxStateDiffRange))
+	}
+	offset, err := parseUintQuery(query.Get("offset"), 0)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "offset"))
+	}
+	limit, err := parseUintQuery(query.Get("limit"), defaultStateDiffLimit)
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "limit"))
+	}
+	if limit == 0 || limit > maxStateDiffLimit {
+		return utils.BadRequest(fmt.Errorf("limit: should be in [1, %v]", maxStateDiffLimit))
+	}
+
+	// one more account tells whether there is a next page
+	diffs, err := d.stateC.Diff(from.StateRoot(), to.StateRoot(), &state.DiffOptions{
+		Offset:       offset,
+		Limit:        limit + 1,
+		StorageLimit: maxStateDiffStorageLimit,
+	})
+	if err != nil {
+		return err
+	}
+	more := uint64(len(diffs)) > limit
+	if more {
+		diffs = diffs[:limit]
+	}
+
+	fromState, err := d.stateC.NewState(from.StateRoot())
+	if err != nil {
+		return err
+	}
+	toState, err := d.stateC.NewState(to.StateRoot())
+	if err != nil {
+		return err
+	}
+	result, err := ConvertStateDiff(from, to, diffs, state.NewStorageDecoder(fromState), state.NewStorageDecoder(toState))
+	if err != nil {
+		return err
+	}
+	if more {
+		next := offset + limit
+		result.Next = &next
+	}
+	return utils.WriteJSON(w, result)
+}
+
+func parseUintQuery(value string, def uint64) (uint64, error) {
+	if value == "" {
+		return def, nil
+	}
+	return strconv.ParseUint(value, 0, 64)
+}
+
 func (d *Debug) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 	sub.Path("/tracers").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleTraceTransaction))
 	sub.Path("/storage-range").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(d.handleDebugStorage))
 	sub.Path("/rawstorage/{address}/{key}").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(d.handleGetRawStorage))
+	sub.Path("/state-diff").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(d.handleStateDiff))
 	sub.Path("/openeth_trace_transaction").Methods(http.MethodPost).HandlerFunc((utils.WrapHandlerFunc(d.handleOpenEthTraceTransaction)))
 	sub.Path("/openeth_trace_block").Methods(http.MethodPost).HandlerFunc((utils.WrapHandlerFunc(d.handleOpenEthTraceBlock)))
 	sub.Path("/openeth_trace_filter").Methods(http.MethodPost).HandlerFunc((utils.WrapHandlerFunc(d.handleOpenEthTraceFilter)))
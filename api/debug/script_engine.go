@@ -0,0 +1,255 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package debug
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/meterio/meter-pov/api/transactions"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/runtime"
+	"github.com/meterio/meter-pov/script"
+	"github.com/meterio/meter-pov/script/accountlock"
+	"github.com/meterio/meter-pov/script/auction"
+	"github.com/meterio/meter-pov/script/staking"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/pkg/errors"
+)
+
+var (
+	stakingOpNames = map[uint32]string{
+		staking.OP_BOUND:                "OP_BOUND",
+		staking.OP_UNBOUND:              "OP_UNBOUND",
+		staking.OP_CANDIDATE:            "OP_CANDIDATE",
+		staking.OP_UNCANDIDATE:          "OP_UNCANDIDATE",
+		staking.OP_DELEGATE:             "OP_DELEGATE",
+		staking.OP_UNDELEGATE:           "OP_UNDELEGATE",
+		staking.OP_CANDIDATE_UPDT:       "OP_CANDIDATE_UPDT",
+		staking.OP_BUCKET_UPDT:          "OP_BUCKET_UPDT",
+		staking.OP_DELEGATE_STATISTICS:  "OP_DELEGATE_STATISTICS",
+		staking.OP_DELEGATE_EXITJAIL:    "OP_DELEGATE_EXITJAIL",
+		staking.OP_FLUSH_ALL_STATISTICS: "OP_FLUSH_ALL_STATISTICS",
+		staking.OP_GOVERNING:            "OP_GOVERNING",
+	}
+	auctionOpNames = map[uint32]string{
+		meter.OP_START: "OP_START",
+		meter.OP_STOP:  "OP_STOP",
+		meter.OP_BID:   "OP_BID",
+	}
+	accountLockOpNames = map[uint32]string{
+		accountlock.OP_ADDLOCK:    "OP_ADDLOCK",
+		accountlock.OP_REMOVELOCK: "OP_REMOVELOCK",
+		accountlock.OP_TRANSFER:   "OP_TRANSFER",
+		accountlock.OP_GOVERNING:  "OP_GOVERNING",
+	}
+)
+
+// ScriptCall is the decoded script data of a script engine clause.
+type ScriptCall struct {
+	ModuleID uint32      `json:"moduleID"`
+	Module   string      `json:"module"`
+	Opcode   uint32      `json:"opcode"`
+	OpName   string      `json:"opName"`
+	Body     interface{} `json:"body"`
+}
+
+type StakingCallBody struct {
+	Version         uint32        `json:"version"`
+	Option          uint32        `json:"option"`
+	HolderAddr      meter.Address `json:"holderAddr"`
+	CandAddr        meter.Address `json:"candAddr"`
+	CandName        string        `json:"candName"`
+	CandDescription string        `json:"candDescription"`
+	CandPubKey      string        `json:"candPubKey"`
+	CandIP          string        `json:"candIP"`
+	CandPort        uint16        `json:"candPort"`
+	StakingID       meter.Bytes32 `json:"stakingID"`
+	Amount          string        `json:"amount"`
+	Token           byte          `json:"token"`
+	Autobid         uint8         `json:"autobid"`
+	Timestamp       uint64        `json:"timestamp"`
+	Nonce           uint64        `json:"nonce"`
+	ExtraData       string        `json:"extraData"`
+}
+
+type AuctionCallBody struct {
+	Version       uint32        `json:"version"`
+	Option        uint32        `json:"option"`
+	StartHeight   uint64        `json:"startHeight"`
+	StartEpoch    uint64        `json:"startEpoch"`
+	EndHeight     uint64        `json:"endHeight"`
+	EndEpoch      uint64        `json:"endEpoch"`
+	Sequence      uint64        `json:"sequence"`
+	AuctionID     meter.Bytes32 `json:"auctionID"`
+	Bidder        meter.Address `json:"bidder"`
+	Amount        string        `json:"amount"`
+	ReserveAmount string        `json:"reserveAmount"`
+	Token         byte          `json:"token"`
+	Timestamp     uint64        `json:"timestamp"`
+	Nonce         uint64        `json:"nonce"`
+}
+
+type AccountLockCallBody struct {
+	Version        uint32        `json:"version"`
+	Option         uint32        `json:"option"`
+	LockEpoch      uint32        `json:"lockEpoch"`
+	ReleaseEpoch   uint32        `json:"releaseEpoch"`
+	FromAddr       meter.Address `json:"fromAddr"`
+	ToAddr         meter.Address `json:"toAddr"`
+	MeterAmount    string        `json:"meterAmount"`
+	MeterGovAmount string        `json:"meterGovAmount"`
+	Memo           string        `json:"memo"`
+}
+
+func bigString(b *big.Int) string {
+	if b == nil {
+		return "0"
+	}
+	return b.String()
+}
+
+func opName(names map[uint32]string, op uint32) string {
+	if name, ok := names[op]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// decodeScriptCall decodes the data of a script engine clause, which is prefixed with the
+// script engine magic and script pattern.
+func decodeScriptCall(data []byte) (*ScriptCall, error) {
+	prefixLen := 4 + len(script.ScriptPattern)
+	if len(data) <= prefixLen || !ScriptEngineCheck(data) || !bytes.Equal(data[4:prefixLen], script.ScriptPattern[:]) {
+		return nil, errors.New("not script engine data")
+	}
+	sd, err := script.DecodeScriptData(data[prefixLen:])
+	if err != nil {
+		return nil, errors.WithMessage(err, "decode script data")
+	}
+
+	call := &ScriptCall{ModuleID: sd.Header.ModID}
+	switch sd.Header.ModID {
+	case script.STAKING_MODULE_ID:
+		sb, err := staking.DecodeFromBytes(sd.Payload)
+		if err != nil {
+			return nil, errors.WithMessage(err, "decode staking body")
+		}
+		call.Module = script.STAKING_MODULE_NAME
+		call.Opcode = sb.Opcode
+		call.OpName = opName(stakingOpNames, sb.Opcode)
+		call.Body = &StakingCallBody{
+			Version:         sb.Version,
+			Option:          sb.Option,
+			HolderAddr:      sb.HolderAddr,
+			CandAddr:        sb.CandAddr,
+			CandName:        string(sb.CandName),
+			CandDescription: string(sb.CandDescription),
+			CandPubKey:      string(sb.CandPubKey),
+			CandIP:          string(sb.CandIP),
+			CandPort:        sb.CandPort,
+			StakingID:       sb.StakingID,
+			Amount:          bigString(sb.Amount),
+			Token:           sb.Token,
+			Autobid:         sb.Autobid,
+			Timestamp:       sb.Timestamp,
+			Nonce:           sb.Nonce,
+			ExtraData:       hexutil.Encode(sb.ExtraData),
+		}
+	case script.AUCTION_MODULE_ID:
+		ab, err := auction.DecodeFromBytes(sd.Payload)
+		if err != nil {
+			return nil, errors.WithMessage(err, "decode auction body")
+		}
+		call.Module = script.AUCTION_MODULE_NAME
+		call.Opcode = ab.Opcode
+		call.OpName = opName(auctionOpNames, ab.Opcode)
+		call.Body = &AuctionCallBody{
+			Version:       ab.Version,
+			Option:        ab.Option,
+			StartHeight:   ab.StartHeight,
+			StartEpoch:    ab.StartEpoch,
+			EndHeight:     ab.EndHeight,
+			EndEpoch:      ab.EndEpoch,
+			Sequence:      ab.Sequence,
+			AuctionID:     ab.AuctionID,
+			Bidder:        ab.Bidder,
+			Amount:        bigString(ab.Amount),
+			ReserveAmount: bigString(ab.ReserveAmount),
+			Token:         ab.Token,
+			Timestamp:     ab.Timestamp,
+			Nonce:         ab.Nonce,
+		}
+	case script.ACCOUNTLOCK_MODULE_ID:
+		lb, err := accountlock.DecodeFromBytes(sd.Payload)
+		if err != nil {
+			return nil, errors.WithMessage(err, "decode accountlock body")
+		}
+		call.Module = script.ACCOUNTLOCK_MODULE_NAME
+		call.Opcode = lb.Opcode
+		call.OpName = opName(accountLockOpNames, lb.Opcode)
+		call.Body = &AccountLockCallBody{
+			Version:        lb.Version,
+			Option:         lb.Option,
+			LockEpoch:      lb.LockEpoch,
+			ReleaseEpoch:   lb.ReleaseEpoch,
+			FromAddr:       lb.FromAddr,
+			ToAddr:         lb.ToAddr,
+			MeterAmount:    bigString(lb.MeterAmount),
+			MeterGovAmount: bigString(lb.MeterGovAmount),
+			Memo:           string(lb.Memo),
+		}
+	default:
+		return nil, fmt.Errorf("unknown module %v", sd.Header.ModID)
+	}
+	return call, nil
+}
+
+// newScriptEngineTrace builds the trace frame of an executed script engine clause, gas is what's left
+// to the clause when it starts.
+func newScriptEngineTrace(origin meter.Address, clause *tx.Clause, gasUsed uint64, output *runtime.Output) *TraceResult {
+	res := &TraceResult{
+		Type:      "SCRIPTENGINE",
+		From:      origin,
+		Input:     hexutil.Encode(clause.Data()),
+		Output:    hexutil.Encode(output.Data),
+		Value:     clause.Value(),
+		Gas:       new(big.Int).SetUint64(gasUsed + output.LeftOverGas),
+		GasUsed:   new(big.Int).SetUint64(gasUsed),
+		Transfers: make([]*transactions.Transfer, 0),
+		Events:    make([]*transactions.Event, 0),
+	}
+	if clause.To() != nil {
+		res.To = *clause.To()
+	}
+	if call, err := decodeScriptCall(clause.Data()); err == nil {
+		res.Call = call
+	}
+	if output.VMErr != nil {
+		// transfers and events of failed clause are discarded, as in receipt
+		res.Error = output.VMErr.Error()
+		return res
+	}
+	for _, t := range output.Transfers {
+		res.Transfers = append(res.Transfers, &transactions.Transfer{
+			Sender:    t.Sender,
+			Recipient: t.Recipient,
+			Amount:    (*math.HexOrDecimal256)(t.Amount),
+			Token:     uint32(t.Token),
+		})
+	}
+	for _, e := range output.Events {
+		res.Events = append(res.Events, &transactions.Event{
+			Address: e.Address,
+			Topics:  e.Topics,
+			Data:    hexutil.Encode(e.Data),
+		})
+	}
+	return res
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package debug
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/runtime"
+	"github.com/meterio/meter-pov/script"
+	"github.com/meterio/meter-pov/script/auction"
+	"github.com/meterio/meter-pov/script/staking"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	holder    = meter.MustParseAddress("0x0205c2D862cA051010698b69b54278cbAf945C0b")
+	candidate = meter.MustParseAddress("0x8a88c59bf15451f9deb1d62f7734fece2002668e")
+)
+
+func TestDecodeScriptCall(t *testing.T) {
+	data, err := script.EncodeScriptData(&staking.StakingBody{
+		Opcode:     staking.OP_DELEGATE,
+		Version:    1,
+		HolderAddr: holder,
+		CandAddr:   candidate,
+		CandName:   []byte("node1"),
+		Amount:     big.NewInt(1e18),
+		Token:      meter.MTRG,
+		Nonce:      7,
+	})
+	assert.Nil(t, err)
+
+	call, err := decodeScriptCall(data)
+	assert.Nil(t, err)
+	assert.Equal(t, script.STAKING_MODULE_ID, call.ModuleID)
+	assert.Equal(t, "staking", call.Module)
+	assert.Equal(t, staking.OP_DELEGATE, call.Opcode)
+	assert.Equal(t, "OP_DELEGATE", call.OpName)
+	body := call.Body.(*StakingCallBody)
+	assert.Equal(t, holder, body.HolderAddr)
+	assert.Equal(t, candidate, body.CandAddr)
+	assert.Equal(t, "node1", body.CandName)
+	assert.Equal(t, "1000000000000000000", body.Amount)
+	assert.Equal(t, uint64(7), body.Nonce)
+
+	data, err = script.EncodeScriptData(&auction.AuctionBody{
+		Opcode: meter.OP_BID,
+		Bidder: holder,
+		Amount: big.NewInt(100),
+	})
+	assert.Nil(t, err)
+	call, err = decodeScriptCall(data)
+	assert.Nil(t, err)
+	assert.Equal(t, "auction", call.Module)
+	assert.Equal(t, "OP_BID", call.OpName)
+	assert.Equal(t, "0", call.Body.(*AuctionCallBody).ReserveAmount)
+
+	_, err = decodeScriptCall([]byte{0xff, 0xff, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04, 0x05})
+	assert.NotNil(t, err)
+}
+
+func TestScriptEngineTrace(t *testing.T) {
+	data, err := script.EncodeScriptData(&staking.StakingBody{
+		Opcode:     staking.OP_BOUND,
+		HolderAddr: holder,
+		CandAddr:   candidate,
+		Amount:     big.NewInt(1e18),
+		Token:      meter.MTRG,
+	})
+	assert.Nil(t, err)
+	to := meter.BytesToAddress([]byte("staking"))
+	clause := tx.NewClause(&to).WithData(data)
+	output := &runtime.Output{
+		LeftOverGas: 79000,
+		Transfers:   tx.Transfers{{Sender: holder, Recipient: to, Amount: big.NewInt(1e18), Token: meter.MTRG}},
+		Events:      tx.Events{{Address: to, Topics: []meter.Bytes32{{1}}, Data: []byte{2}}},
+	}
+
+	res := newScriptEngineTrace(holder, clause, 21000, output)
+	assert.Equal(t, "SCRIPTENGINE", res.Type)
+	assert.Equal(t, holder, res.From)
+	assert.Equal(t, to, res.To)
+	assert.Equal(t, "OP_BOUND", res.Call.OpName)
+	assert.Equal(t, int64(21000), res.GasUsed.Int64())
+	assert.Equal(t, int64(100000), res.Gas.Int64())
+	assert.Equal(t, 1, len(res.Transfers))
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, "0x02", res.Events[0].Data)
+
+	raw, err := json.Marshal(res)
+	assert.Nil(t, err)
+	var decoded map[string]interface{}
+	assert.Nil(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, "OP_BOUND", decoded["call"].(map[string]interface{})["opName"])
+	assert.Equal(t, holder.String(), decoded["call"].(map[string]interface{})["body"].(map[string]interface{})["holderAddr"])
+
+	// failed clause has no transfers nor events
+	output.VMErr = errors.New("bucket not found")
+	res = newScriptEngineTrace(holder, clause, 21000, output)
+	assert.Equal(t, "bucket not found", res.Error)
+	assert.Equal(t, 0, len(res.Transfers))
+	assert.Equal(t, 0, len(res.Events))
+}
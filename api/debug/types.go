@@ -1,17 +1,25 @@
 package debug
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"math/big"
 
+	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"github.com/pkg/errors"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/meterio/meter-pov/vm"
 )
 
 type TracerOption struct {
-	Name   string `json:"name"`
-	Target string `json:"target"`
+	Name   string          `json:"name"`
+	Target string          `json:"target"`
+	Config json.RawMessage `json:"config"` // config of native tracer, e.g. {"diffMode": true} for prestate
 }
 
 type ExecutionResult struct {
@@ -133,3 +141,151 @@ type StorageEntry struct {
 	Key   *meter.Bytes32 `json:"key"`
 	Value *meter.Bytes32 `json:"value"`
 }
+
+type StateRevision struct {
+	Number    uint32 `json:"number"`
+	ID        string `json:"id"`
+	StateRoot string `json:"stateRoot"`
+}
+
+type StateDiff struct {
+	From     StateRevision  `json:"from"`
+	To       StateRevision  `json:"to"`
+	Accounts []*AccountDiff `json:"accounts"`
+	Next     *uint64        `json:"next,omitempty"` // offset of the next page, nil if no more accounts
+}
+
+type AccountState struct {
+	Balance      *math.HexOrDecimal256 `json:"balance"`
+	Energy       *math.HexOrDecimal256 `json:"energy"`
+	BoundBalance *math.HexOrDecimal256 `json:"boundbalance"`
+	BoundEnergy  *math.HexOrDecimal256 `json:"boundenergy"`
+	Master       string                `json:"master"`
+	CodeHash     string                `json:"codeHash"`
+}
+
+type AccountDiff struct {
+	Address string         `json:"address"`
+	From    *AccountState  `json:"from"` // nil if the account does not exist
+	To      *AccountState  `json:"to"`   // nil if the account does not exist
+	Changed []string       `json:"changed"`
+	Storage []*StorageDiff `json:"storage"`
+
+	StorageTruncated bool `json:"storageTruncated,omitempty"` // more storage slots changed than listed
+}
+
+type StorageDiff struct {
+	Key         string      `json:"key"`
+	From        string      `json:"from"` // raw value, 0x if not set
+	To          string      `json:"to"`   // raw value, 0x if not set
+	FromDecoded interface{} `json:"fromDecoded"`
+	ToDecoded   interface{} `json:"toDecoded"`
+}
+
+func newStateRevision(header *block.Header) StateRevision {
+	return StateRevision{
+		Number:    header.Number(),
+		ID:        header.ID().String(),
+		StateRoot: header.StateRoot().String(),
+	}
+}
+
+func newAccountState(acc *state.Account) *AccountState {
+	if acc == nil {
+		return nil
+	}
+	as := &AccountState{
+		Balance:      (*math.HexOrDecimal256)(acc.Balance),
+		Energy:       (*math.HexOrDecimal256)(acc.Energy),
+		BoundBalance: (*math.HexOrDecimal256)(acc.BoundBalance),
+		BoundEnergy:  (*math.HexOrDecimal256)(acc.BoundEnergy),
+	}
+	if len(acc.Master) > 0 {
+		as.Master = meter.BytesToAddress(acc.Master).String()
+	}
+	if len(acc.CodeHash) > 0 {
+		as.CodeHash = meter.BytesToBytes32(acc.CodeHash).String()
+	}
+	return as
+}
+
+// changedFields returns names of the account fields which differ.
+func changedFields(from, to *state.Account) []string {
+	if from == nil || to == nil {
+		return nil
+	}
+	var changed []string
+	for _, f := range []struct {
+		name     string
+		from, to *big.Int
+	}{
+		{"balance", from.Balance, to.Balance},
+		{"energy", from.Energy, to.Energy},
+		{"boundbalance", from.BoundBalance, to.BoundBalance},
+		{"boundenergy", from.BoundEnergy, to.BoundEnergy},
+	} {
+		if f.from.Cmp(f.to) != 0 {
+			changed = append(changed, f.name)
+		}
+	}
+	if !bytes.Equal(from.Master, to.Master) {
+		changed = append(changed, "master")
+	}
+	if !bytes.Equal(from.CodeHash, to.CodeHash) {
+		changed = append(changed, "codeHash")
+	}
+	if !bytes.Equal(from.StorageRoot, to.StorageRoot) {
+		changed = append(changed, "storage")
+	}
+	return changed
+}
+
+func decodeStorage(decoder *state.StorageDecoder, addr meter.Address, key meter.Bytes32, raw []byte) (interface{}, error) {
+	v, err := decoder.Decode(addr, key, raw)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "decode storage %v of %v", key, addr)
+	}
+	// Bytes32 marshals into string only by pointer
+	if b, ok := v.(meter.Bytes32); ok {
+		return b.String(), nil
+	}
+	return v, nil
+}
+
+// ConvertStateDiff converts state diff between two blocks, storage values of script engine modules are decoded
+// by decoders of the from and to states.
+func ConvertStateDiff(from, to *block.Header, diffs []*state.AccountDiff, fromDecoder, toDecoder *state.StorageDecoder) (*StateDiff, error) {
+	result := &StateDiff{
+		From:     newStateRevision(from),
+		To:       newStateRevision(to),
+		Accounts: make([]*AccountDiff, 0, len(diffs)),
+	}
+	for _, d := range diffs {
+		ad := &AccountDiff{
+			Address: d.Address.String(),
+			From:    newAccountState(d.From),
+			To:      newAccountState(d.To),
+			Changed: changedFields(d.From, d.To),
+			Storage: make([]*StorageDiff, 0, len(d.Storage)),
+
+			StorageTruncated: d.StorageTruncated,
+		}
+		for _, s := range d.Storage {
+			sd := &StorageDiff{
+				Key:  s.Key.String(),
+				From: hexutil.Encode(s.From),
+				To:   hexutil.Encode(s.To),
+			}
+			var err error
+			if sd.FromDecoded, err = decodeStorage(fromDecoder, d.Address, s.Key, s.From); err != nil {
+				return nil, err
+			}
+			if sd.ToDecoded, err = decodeStorage(toDecoder, d.Address, s.Key, s.To); err != nil {
+				return nil, err
+			}
+			ad.Storage = append(ad.Storage, sd)
+		}
+		result.Accounts = append(result.Accounts, ad)
+	}
+	return result, nil
+}
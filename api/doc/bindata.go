@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// meter.yaml (44.043kB)
+// meter.yaml (66.678kB)
 // swagger-ui/favicon-16x16.png (445B)
 // swagger-ui/favicon-32x32.png (1.141kB)
 // swagger-ui/index.html (1.363kB)
@@ -76,7 +76,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _meterYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x5d\x73\xdb\x38\xb6\xe0\xbb\x7f\x05\xca\xb3\xb5\x9d\x9e\x4a\x64\x00\x04\x09\xd0\x2f\x5b\xdd\x9d\xdc\x69\xd7\xa4\x27\xd9\x24\x77\xe7\xe1\xd6\xad\x15\x3e\x0e\x24\x4e\x24\x52\x43\x52\xfe\xd8\xee\xfb\xdf\xb7\x40\x90\x14\x29\x51\x32\xe5\xc8\xb9\x76\x26\x9e\x87\x49\x53\xf8\x38\x38\xdf\x38\x38\x38\xc8\x56\x90\xca\x55\x72\x89\x82\x09\x9e\x90\xb3\x24\xb5\xd9\xe5\x19\x42\x65\x52\x2e\xe0\x12\xfd\x06\x25\xe4\x50\x94\x67\x08\x19\x28\x74\x9e\xac\xca\x24\x4b\x2f\xd1\x1f\x67\x08\x21\xf4\xe1\xcd\xc7\x4f\x76\xbd\x40\x3f\xbd\xbf\x42\x65\x86\xa4\xd6\x50\x14\xbe\xcf\x24\xc9\xce\xaa\x36\xff\xf1\x3e\xcf\xfe\x01\xba\x44\xbf\x66\x4b\xf8\xcf\x17\xf3\xb2\x5c\x15\x97\x17\x17\xb3\xa4\x9c\xaf\xd5\x44\x67\xcb\x8b\xa5\x6b\x9f\x64\x3f\x9e\x21\xb4\x48\x34\xa4\x05\x5c\x56\x3d\x53\xb9\x84\x4b\xf4\xf6\x2f\xef\xdf\x3a\xd8\xaa\x4f\xeb\x7c\x71\x89\xce\x9b\x31\x6e\x6e\x6e\x26\xb3\x74\x3d\xc9\xf2\xd9\x45\xdd\xb3\xb8\x58\xcc\x56\x8b\x57\x6e\x2d\x90\x4e\xe6\xe5\x72\x71\x7e\x86\xd0\x35\xe4\x45\x05\x36\x99\xd0\x09\x3d\x3b\x2b\x20\x77\x9f\xdc\x34\xaf\xea\x31\x2f\xce\xab\x09\x7a\x8b\x5c\x64\x5a\x2e\x50\x05\x1e\x4a\x33\x03\x67\x67\xa5\x9c\xd5\xbd\x3c\x70\x3f\x69\x9d\xad\xd3\xb2\xd8\xed\xfb\x93\xc7\x85\xc7\x8a\x6b\x83\x32\xe5\xd0\x50\x74\x7a\x7f\xca\x65\x5a\x48\xed\x3a\x1c\x1c\xa1\xec\xb7\x6b\xba\xff\xbc\xc8\xf4\xe7\x83\x1d\x55\xd3\xa2\xe9\xf2\x36\x9b\x1d\xec\x00\xd7\x90\x96\xe8\x7f\xfa\x19\x2d\xe4\x68\xe1\x3b\x34\xfd\xff\xe6\xb0\x70\xa0\xbf\xc3\x12\x2a\x4a\x59\xae\x0b\xe4\x38\xa9\xd3\xf5\xe3\x5a\xb5\x5d\x06\x60\xa8\x7f\x56\x80\x92\xd4\xb3\x1c\x18\x54\xac\x77\x70\xf6\x1a\xd4\x7a\xb6\xdb\xbd\xfa\x8c\xd6\x65\xb2\x48\xca\x04\xba\x1d\x3e\x96\xf2\x73\x92\x0e\x74\xd9\x40\x5d\xf8\x26\xc8\xc8\x52\x9e\x9d\xad\x64\x39\xaf\x88\x7c\x51\x53\xae\xb8\xf8\x5d\x1a\x93\x43\x51\xfc\x97\x67\xcc\x95\xcc\x65\xc5\x15\x85\xff\x6f\x37\xd9\xff\xc8\xc1\x5e\xa2\xf3\x3f\x5d\xe8\x6c\xb9\xca\x52\x70\xdd\x36\xed\x2e\x7e\xf2\x03\x5c\xa5\xef\x65\x39\x3f\x1f\xdb\xeb\x03\x5c\x27\x8e\x71\xaf\xd2\xff\xbd\x86\xfc\xce\xf7\x9b\x41\xd9\x4c\xdb\xb0\x63\x33\x5c\x8f\x1d\x11\x2a\xd6\xcb\xa5\xcc\xef\x2e\xd1\x07\x28\xf3\x04\xae\xa1\xe5\x45\x03\xa5\x4c\x16\x75\xb3\x01\xc1\x76\x7f\x49\xaa\x17\x6b\x03\x05\x9a\x2a\xb9\x90\xa9\x86\xe9\x4b\x34\x85\x14\xf2\xd9\xdd\x14\xc9\xd4\xa0\xe9\x5c\x16\xbf\x64\xc6\x7d\x57\x77\xed\xd0\xd3\x1a\x57\xd3\x09\xfa\x29\x6d\xbf\xde\x24\xe5\x7c\xd3\x01\x29\x40\x7f\x2e\xf3\x35\xfc\x19\x25\x05\x92\x48\x67\x69\x99\x4b\x5d\x4e\xce\xda\xd9\x7f\x4d\x8a\x32\xcb\x13\x27\x80\x7d\xa0\x91\x96\xa9\xeb\xff\xcf\x35\xe4\x09\x18\x37\x75\xb1\x02\x9d\xd8\x3b\x47\xc2\x69\x5e\xa3\x6c\x5a\x35\xb8\x43\x45\x99\x27\xe9\x6c\x52\x8f\x9b\x43\xb1\xca\x9c\x9a\xd8\x60\xed\x9c\x62\x7c\xbe\xf9\xcf\x2d\x74\xbc\xfb\x6b\xe7\x17\x07\x26\xa4\x65\xb7\x31\x42\x72\xb5\x5a\x24\x5a\xba\xe6\x17\xff\x28\xb2\xb4\xff\x2b\x42\x85\x9e\xc3\x52\x6e\x7f\x45\x83\xa4\xf7\x6d\x8b\x8b\x9a\x8e\xe7\x1e\x1d\xab\xac\x38\x9a\xe2\x6f\x6e\x41\xaf\xcb\x0d\xc1\x75\x23\xb8\x7b\xc9\xed\xe4\x20\x59\xae\x17\xb2\x84\x96\x1e\x4e\xf7\xcd\x33\x83\xb4\x5c\x2c\x5e\x56\x34\xcc\xd6\x25\x2a\x20\x35\x0e\xd7\x1d\xb5\xd4\x2a\x1b\xa4\xe7\x32\x49\x3b\x74\xbc\x2a\x7f\x28\xd0\xba\x00\x67\x26\x9c\x82\x29\xca\x64\xe9\xa6\x98\x49\xf7\x59\xce\xa0\x62\x25\xa8\xc0\x75\x03\xe5\x50\xac\x17\x25\xca\xac\x63\x8b\x85\x5c\x17\xb0\xa1\xdd\x3f\xd7\x50\x94\x3f\x67\xe6\x6e\x83\x81\xde\x62\x64\x3e\x5b\x2f\x1d\x22\xfd\x98\xe9\x75\x92\x67\xa9\xfb\xd0\x36\x77\x63\x24\x39\x98\x4b\xe4\xb8\xef\xec\x00\x61\x0f\x93\x75\x98\xa8\x87\x48\xfa\x8b\x5c\x2c\x5e\xcb\x52\x9e\x3f\x2f\x4e\x74\x60\x7f\xa8\x48\x72\xde\xd3\x88\x7f\xbe\xdc\x61\xcd\x5d\xad\xf8\x50\x0d\xf7\x00\x36\x47\x4a\x96\x7a\xee\xd8\xc6\x71\x7a\x31\x9e\xd5\x37\x9c\x57\xb1\x5c\x87\xa7\xbf\x0d\xbe\xfb\xd9\xe1\xe5\x99\x32\x5f\x0b\x7b\xc3\x81\x5d\x16\x7c\x5a\x0c\xa8\xee\x4a\x38\x92\xf3\x5a\x25\x6b\x60\xb5\xc8\xee\x1c\xbf\x3c\xa6\x8a\x1d\x9a\x6e\x48\xd9\xb6\xc3\xfe\xe9\x4f\x7f\x42\x9f\xae\xde\x7f\xec\xd2\xec\x15\x9a\x3a\x27\x69\x8a\x92\xb4\x91\x0b\xa4\x32\x73\xe7\xcc\x78\x39\xef\xa0\xa1\x1e\xb3\x9e\x73\xef\x08\x9e\x0d\x7b\x43\xe4\xeb\xb4\x4c\x96\xdd\xa1\x64\x51\x24\xb3\x14\x4c\xd7\x99\xbe\x99\x27\x7a\x5e\xb5\x6f\xd7\xe5\xf0\x03\xf5\xea\xc0\x7c\x13\xc2\xfb\x4c\xe5\x76\x9f\xc8\x6e\xfc\xe8\x0b\x47\xd9\x6f\xc5\x99\xbe\xdf\xb7\x4a\x2c\x92\xe9\xdd\x04\xfd\x0a\x39\xd4\x4c\x6b\xc0\x31\xfc\x0e\xb3\x3f\x33\x47\xd5\x79\xf3\x7b\x69\xec\x1c\x78\x39\x83\x8b\xdf\x3f\xc3\xdd\xd7\xde\x39\x7d\xf4\x73\xff\x15\xee\x9e\x0a\x97\xd4\xd8\x40\xd7\x72\xb1\xbe\x87\x5d\x6c\x96\xa3\x59\x72\x0d\x29\xfa\x0c\x77\xcf\x8c\x23\x6a\xc4\x7b\xa6\xe8\x06\x2e\x2e\x7e\x4f\xcc\xc3\xb9\xe0\xd3\xed\xd5\xeb\x63\x29\x29\x6f\xb6\x8c\xfa\xbd\x5d\x7e\x05\x69\xc6\x12\x7e\x27\x78\x33\x44\xfc\x0e\x02\x0e\x93\x5c\xdd\xa1\xab\xd7\xcf\x8c\xd4\x9f\x6e\xdf\xe5\x1f\xe4\xcd\xa7\xdb\xbf\x27\xe5\xfc\x37\x70\x66\x6a\x90\xe8\x17\x39\x68\x48\x56\xe5\xd7\x24\xfe\x63\x52\x12\xd5\xeb\xf9\xf6\x28\xfa\xc1\x2f\x6c\x97\x8e\xbb\xce\xf6\x31\x48\xfc\x25\x5b\x2e\x93\x72\xbc\x30\x38\xd7\x50\xde\xa0\x2c\x47\x45\x99\xaf\x75\xb9\xce\xc1\x38\xa5\xb8\x94\xe5\x04\x5d\x59\x94\x3a\x2f\x7a\x96\x4a\xf7\x83\x6b\xbc\xd3\xea\x65\x3b\xd4\xd4\x35\x4c\xd2\xd9\xaf\xb2\x98\x4f\x2b\x8b\x0b\xe5\x3a\x77\xce\xe4\xb6\xff\x79\x70\xbb\xf7\xdf\xe7\x02\x7e\x90\x37\xef\xf2\x8f\x95\xff\xfb\x2e\xff\xf7\xd4\x7b\xc2\x9f\x6e\x9f\x99\x47\x78\xf5\xda\x2f\xa2\xa6\x84\x67\x30\x1f\x9d\xbe\xf8\xbd\x09\xd9\x3d\xdc\x3a\x6c\x8c\xf6\x46\x49\x1c\x10\xf8\x4e\xe0\x7c\x48\xd4\x2b\xb8\x46\x08\xb7\x63\xd0\x74\xbd\x54\x90\xbf\x74\xff\xfc\x41\x41\x51\xfe\x50\x19\x6f\xb7\xbf\x73\x3b\x23\x37\xd0\x13\x54\x01\x72\xb1\x78\x67\x77\x3f\xef\x43\x74\xbb\x1d\x77\xcb\x39\x1f\xec\x56\xde\xad\xe0\xb2\x3e\xe1\x18\x68\x80\xd0\x2a\xcf\x56\x90\x97\x49\x77\xf9\xfd\xbf\xa4\xf8\x94\xaf\xd3\xcf\xfb\x7e\x46\xf5\x1c\x2a\xcb\x16\x20\xd3\xbd\xad\x7a\x28\xbc\x99\x43\x39\x87\xdc\x6f\x4d\xab\xbd\x73\x52\x20\xb7\x95\x9e\x3b\x39\x4e\x3f\x57\x6c\xb8\xc8\x66\xc5\x45\x75\xf0\x71\xbf\x96\x6b\xcf\x4f\x3a\x7c\xf3\x6f\xc9\xa2\x84\xbc\x3e\x3a\x59\x6c\x1a\xec\x61\x9d\x37\x6d\x3b\x24\x73\x70\x88\x31\x6b\xed\xe3\xd8\xd3\x77\xef\xff\xef\xdb\x77\x7f\xa9\xf6\xc6\x6f\xfe\xcf\x6f\x4f\x54\x23\x55\x0b\xf0\x8b\x7e\x82\x5a\xc8\x73\x89\xcc\x73\x79\xb7\xf3\x5b\x52\xc2\x72\x90\xff\xf6\x0a\xc4\x7d\x22\x51\xe1\x62\x48\x24\x46\x09\xc5\x18\xb1\x40\x68\x09\xe5\xc0\x3a\x37\x7f\x87\xe0\x7b\x9b\xcd\x36\x8e\x59\xc5\xe8\xcd\xc9\xde\x17\xf1\xfa\xf6\xf1\x20\xda\xcf\xee\x9f\xba\x4d\x2b\x8e\xcf\x41\x67\xb9\x01\xe3\x04\xf1\xb7\x4f\x1f\xfe\xd2\x8e\xd6\x3f\xa8\x79\x52\x3c\xdf\xac\xe2\x3b\xdb\xf7\xd0\xf1\xac\x38\xbf\x52\xd0\x03\x9e\xac\x81\x55\x0e\x5a\x96\x5b\x7c\xf5\x24\x54\xff\x83\x42\xda\x1e\xaa\x77\xb9\x81\x7c\x6b\x03\x3c\xba\x73\x1b\x74\xe9\x75\xbf\x3f\x98\xea\x31\x61\x3d\x5a\x74\x9e\x94\x90\x27\xf2\x09\xc8\x6f\xc7\x66\xbd\x85\x99\xd4\x77\xff\xf2\x22\xfc\x7c\x2d\xd7\xa3\x88\xf0\x29\x2d\xda\xa0\x41\x3b\xb1\x24\xdf\x2f\x8a\xdd\x15\x3d\x41\x89\xec\x5b\xd4\xef\x42\xf9\x2c\xed\xea\x57\x34\xa9\xdf\x2d\xe1\x77\x4b\x88\xbe\x5b\xc2\xaf\x6e\x04\xbf\xdb\xad\xef\x76\xeb\x9b\xb3\x5b\x69\x66\xe0\x22\x85\xf2\x26\xcb\x3f\x5f\xac\xa0\x65\xee\x03\x31\xe3\xbf\x6d\x0e\xf9\x77\x23\xc6\x3a\x4b\x53\xd0\x25\x18\x54\x0d\xf6\xf4\xd8\x61\x2f\xc9\x0f\xa1\xec\x3d\x40\xfe\xb1\x94\x65\xd1\x41\x9a\x76\x0b\x4a\x8b\x75\xe1\x3a\x2c\x93\xb2\x04\xf8\x42\xd4\xad\xf3\x1c\xaa\x24\x8a\x7a\x38\xb4\x84\xa5\xea\x22\xf1\x79\xe0\xb0\x42\x51\x9d\x48\x7e\xa1\xd6\xfa\x33\x94\xf7\x33\x55\x37\x37\x7d\x08\x39\x4d\x62\x7a\x3d\xde\x73\x46\x89\x96\xa9\x49\x8c\x2c\xe1\x74\x58\xd9\x0c\xf9\x9c\x11\xe3\xfe\x1f\xe6\xd9\xc2\x8c\xd1\x42\x63\x51\xd3\x19\xf4\x39\xe3\xc6\xc0\x02\x66\x27\xe5\x99\x76\xc4\xea\x9c\xae\xd5\x66\xcf\x12\x49\xdd\x0b\x35\xfe\x30\xf5\x7e\x34\xed\x5c\xc2\xe9\x20\xeb\xc5\xdf\x41\x15\x99\xd3\x34\x3f\x76\xae\xe3\xa4\x70\xb3\xb9\x47\x84\x1e\xea\x11\xbe\xcf\x8a\xa4\xdc\x4d\xb3\x7d\x42\x26\xf2\xf4\x87\xa2\x87\xba\xbd\x53\x45\xb6\x80\xb2\xce\x93\xea\x93\xb2\x73\x16\x79\x7a\x52\xfa\x2d\xfb\xd9\x00\x6a\x37\x11\x2e\x9f\x5c\x5b\xc8\x32\x29\xec\x5d\xeb\x7c\xa3\x24\xf5\x77\x6a\xda\x1c\xe1\x53\x72\xc2\xe6\xc2\x96\x34\x26\xef\xe0\x33\x49\x2f\xfd\xb4\x67\x87\x69\xe8\x7d\x4c\x7f\xdd\x67\x1f\xf7\xd4\xa9\x89\x28\xb3\xf5\xfe\x1c\x2a\x9f\x23\xdf\x81\xa1\xc4\x8f\x04\x41\x99\xad\x12\x8d\x5b\x00\x76\x27\x26\x8f\x39\x31\x39\x30\x31\x7d\xcc\x89\xe9\x81\x89\x83\xc7\x9c\x38\x38\x30\x31\x7b\xcc\x89\xd9\xf6\xc4\xcf\x5f\xd5\xed\x0d\x94\x8c\x55\x75\x43\x3d\x4f\x90\x39\x72\x78\x5b\x38\x7a\x53\xd8\x57\xc2\xfd\x73\xf2\xd3\xeb\xe1\x36\x90\xf3\x85\xaa\xb8\x46\xd2\xa3\x68\xe2\xf2\xf6\x5d\x9e\xcc\x92\xf4\x91\xe4\xa4\xca\x65\xcb\xbb\x4a\xb9\xbc\xad\x17\xec\xd8\x5d\x26\xa9\xbf\x11\xd2\xa0\x6a\x07\xbe\x02\x52\x03\x5f\xc1\x56\x94\xd9\x67\x48\xb7\x67\x6b\x80\xc8\x41\x27\xab\xa4\xab\x60\x1e\x19\x8e\xed\x09\x9f\xbf\x62\x39\x14\x3e\xfa\x16\x75\x8b\x02\xf9\x28\xfe\x5d\xe7\x7a\xd8\x0f\x05\x72\xb3\x8c\xd2\x2e\xb5\xb0\x35\xa3\x3b\x4e\xdb\x38\xfd\x2f\xab\x6b\x51\x6a\x91\x65\xcb\x3a\x1a\xeb\x84\x52\x56\x01\x9b\x95\x53\x20\x60\xfc\xe5\x6e\x69\xad\x0f\x81\xd5\x0c\xbb\xb9\xcb\xf2\x7d\xc3\xd0\xdb\x30\x80\xfc\x22\x23\xea\xd8\xc9\x80\x5a\xcf\x9c\x89\xd2\x83\x27\x01\xdb\xac\xb4\xa9\x99\xd0\x4d\xca\xce\x41\x56\x37\x67\xfd\x30\x43\x8c\xe2\xb6\xc8\xcd\xc5\xc0\x16\xff\x4f\x2d\x2f\x4b\x43\xfe\xae\x82\xf7\xfc\xec\xe9\xf1\x48\x4f\x03\x6d\x28\x57\xdf\x05\x7a\x95\xcb\x74\x06\x0f\xa4\x5f\x27\xb4\xe1\x2f\x16\x55\x83\x1d\x96\x77\x67\x43\xe6\xd0\x2f\xca\xe0\x2f\x1a\xd5\x42\xfb\x34\xa9\x5c\xdf\x29\xfa\xe0\x16\xd8\xd0\xfa\xc9\x91\x7a\xec\x02\xce\xcf\xce\x36\x2d\xdc\x30\x75\x23\x3f\x62\x7d\x9d\xac\x19\x7e\xc8\x40\xd5\xd5\x38\xba\x10\x8c\xf1\x23\xea\x6e\xce\x75\x5c\xa7\x49\x89\xfe\xfe\xe6\xea\x25\x5a\xe5\x50\x40\xda\xea\xf0\x39\xdc\xee\x8e\x02\xb7\x72\xb9\x5a\xc0\x25\x3a\xc7\xb7\x8c\x5b\x4b\x6c\x8c\x03\xca\xa5\xc4\x56\x74\x0c\xaf\xaf\x0c\x72\x2c\x54\xbe\x57\x05\x54\x92\x3e\x10\x28\x6d\x23\xca\x48\x28\x4c\x18\x93\x20\x16\x1b\x90\xea\x72\x23\xbb\x30\xed\x26\x91\xef\x4d\x1b\x6f\x64\x65\x2e\x8b\xee\x45\xcf\x1e\x0c\x56\x2e\x0a\xf0\xda\xa7\x3b\xdf\x10\xf1\xf4\x20\x3c\x07\x97\x17\x61\xf7\x3f\x86\x43\x1a\x61\x8c\x05\xb6\x06\x63\x49\xa2\x30\xa2\x5c\x72\xc9\x69\x80\x43\x41\xb1\xa6\x81\x09\x24\x50\xa3\x45\x24\x0d\x09\x70\x18\x11\x49\x05\x8d\x8d\xe0\x9a\x6b\x25\x58\x10\x06\x51\xc8\x62\xaa\x0c\x09\x99\x00\xc5\x81\x5b\x8d\x6d\x10\x05\x54\x41\x8c\x31\x8d\x6b\x0d\x5a\x73\xeb\xa1\x65\x54\x97\x18\x8f\x5c\x07\xfe\xb2\x3f\x52\x43\xf7\xe9\xf6\xb7\x8e\x4f\xb6\x7b\x74\x5c\xdf\xd1\x72\x8e\x5b\x53\x82\x68\xdf\x2a\x2a\x0f\xe7\xea\xf5\xd1\x92\xe4\x6f\x12\x18\x48\xcb\xc4\x26\x90\xa3\x17\xea\xae\x84\x22\xa0\x3f\xee\x5f\x39\xb3\x91\xd6\x42\x28\xc5\x22\x1a\xc9\x98\xc6\x98\x73\x22\x40\x50\x4b\xc3\x50\x09\x2b\x43\x42\x58\x18\x48\x2e\x40\xf0\x98\x83\x12\x1a\x64\x10\xc4\x81\xa2\x24\x3c\xef\x43\xfc\xb7\xea\xce\xc9\x2e\xd4\x49\x5a\xc2\xac\xb7\x1f\xf2\xb7\xa1\x2e\x2b\xd9\x0a\xe8\xe1\xf5\xf8\x9b\x2c\xe8\xc5\x1c\x92\xd9\xbc\x1c\x5c\x4a\x40\xc3\x80\xb2\x3e\x30\x9f\x92\x25\x14\xa5\x5c\xae\x8e\x85\x27\x62\x87\xe1\x59\xa7\xc9\x2d\x2a\x9b\xd1\x87\xc0\x21\x61\x10\xd0\x88\xc7\x18\x7b\xce\xa8\x3d\xee\x41\xd6\xf0\xe1\xbe\xac\x9f\xe3\xf0\x9d\x49\xfe\xa5\x98\xa4\x9d\xf8\xf6\x78\x72\x76\x55\xcb\x86\xa8\x7b\x48\x49\x05\x53\x4a\x86\x18\x2c\xe7\x5c\x88\xd8\x5a\x22\x83\x88\x83\xc1\x2a\x10\x26\x84\x30\xa2\x11\x27\x8c\x71\xae\x19\x36\x10\x08\xc3\x89\x06\x63\x22\x1b\x5b\xc9\x38\x3f\xef\x80\xea\x23\x30\x5f\x02\x6e\x56\x8d\x80\x5e\xf8\x70\xcb\x3e\xf6\x33\x8a\x61\xca\x19\xe7\x8a\x4a\x61\x81\x69\x11\xe8\xc8\x48\x0b\xdc\x8a\x28\xe2\x42\x29\xa2\x84\x14\xa6\xd6\xc2\x3f\x6f\x8e\x9b\x86\xc5\x26\x7d\x22\xfc\x97\x98\x11\xb8\x6b\x40\xa8\x45\x74\xac\x4c\x3f\xba\x24\x17\xc9\xff\x1b\xb0\xb2\x0f\x45\xe1\x87\xb7\xef\x11\xa4\xce\x03\x31\xf5\x52\xdc\xf8\xce\xf7\xaa\xd6\x3d\x88\x4c\xbe\x89\xce\xaf\x64\x0e\x69\x39\x4a\x74\x46\xe2\xd3\x8f\x58\xc3\x72\xf5\xfa\x30\x3a\x15\x0f\xb0\x51\x26\xc6\x16\x0c\x8e\x0d\x89\x42\x65\x8d\x0d\x02\xad\x31\x80\x61\x1c\x34\x8e\x44\x1c\x08\x1b\x01\x70\xc5\x35\xa1\x92\x81\x8c\x1d\xc7\xb6\xd0\x3e\x29\x35\x34\x93\xc5\xdb\x64\x99\x94\xa7\x06\x66\x26\x0b\xb4\x70\x03\xa3\x17\x4b\x79\x8b\xe4\x62\x91\xdd\x80\x71\x8e\xec\xba\xaa\x3c\x94\x5c\x77\x4b\x03\xb9\x2d\x61\xe7\xea\xf6\xa0\x48\x11\x42\xc3\x20\xe4\xf1\x46\xa9\x43\x0a\x36\xd1\x89\xdb\x88\x9e\x8c\x1b\x3a\x81\xce\xc6\xe9\x2e\x33\x7f\xd7\xbe\xb9\x94\x8b\x72\xb8\x91\xb9\xd9\xc3\x28\x8a\xe1\x98\x69\x1a\x5a\x11\x99\x88\x0a\x6b\x4c\xc8\x89\xb4\x9a\x61\xce\x2d\x36\x98\xc4\x91\xb4\x8a\x75\x36\x08\x33\x59\xfc\x7b\x01\x03\x1a\xe2\xa1\x14\x18\x87\xe4\x21\xf8\x29\xc1\x5d\x13\x95\x95\x72\xf1\x51\x67\xf9\xd1\xe2\xbf\x1f\xb6\x62\xbd\xac\x70\xbb\x58\x20\xb7\x11\x2c\xca\x5c\x2e\xea\x20\xdf\x0f\xa8\x70\x73\x0d\xd2\x1e\xd3\x38\x16\xa2\x63\x91\x8a\x0f\x59\x36\xc0\xb4\x0f\x25\x7b\x9e\x65\xd5\xee\x6a\xbe\x8d\x25\xa7\x98\xda\x8b\xbb\x7b\x48\x2e\x62\x63\x4d\x6c\xb5\x21\x58\xc7\x10\x06\x26\x12\x61\x4c\xb5\x15\x2a\x64\x58\x51\x81\x15\xa7\x26\x10\x44\x89\x48\x84\x34\xa0\x34\x88\x63\x6a\x03\xc0\xb1\x14\x38\x52\xaa\xa3\x6a\x4b\x59\xc2\x23\xae\xac\xa9\x8f\xe3\x27\xda\xb7\x9c\x48\x69\x1d\x19\x4a\x98\xd2\xb1\x11\x06\x1b\x30\x4a\x12\x4c\xa8\x8c\x02\x2d\x02\xc2\x0d\x89\x35\xc4\xdc\x46\x58\x0b\x49\xc1\x86\x3a\x8c\x95\x32\x0c\x1b\x46\x23\xb2\x59\x4e\x5d\xa2\xe2\x2b\xd1\xaa\x9d\x6e\xcf\xba\x48\xc8\x05\x07\x1a\x06\x81\x66\x1c\x83\x90\x91\x10\x10\x69\x43\xb8\x24\x00\x84\x1a\xc1\x42\xe7\x2a\x99\xd0\x0a\x6a\xa8\x26\x38\x06\x6a\x22\x4a\x23\x23\x20\x64\xd0\xb5\x88\xce\x89\x39\x76\x45\x14\xef\x75\x94\xe6\x80\xb2\x14\xd0\xcd\xdc\xd7\x94\x00\x83\xca\x79\x52\x1c\x64\x3a\xa9\xb8\xa2\xdc\xea\x18\xb8\xa1\xb1\x8d\x2d\x85\x50\x99\x20\x22\x9c\x71\x19\x86\x24\x34\x58\x6b\x6a\x3a\xd4\xd8\x2d\xa5\xd1\x85\x7d\x3b\x4b\x77\x9f\x27\x57\xa0\xab\xd7\x5d\x0c\x0f\xe4\x08\xed\x41\xc6\x21\x02\x1f\xf0\x1c\x7b\x26\xf9\xd4\x2e\xae\x0f\x97\x54\xb1\xe5\x43\x7e\x64\x99\x1d\xeb\xfb\x9e\xb7\x07\x64\x55\x39\xc8\x6a\x86\x97\x28\x5d\x2f\x16\x4d\xce\xd7\x4e\x1d\xbc\x76\x6f\x76\xbe\x87\xe4\x21\x0e\x98\x94\x61\x8c\x09\x0d\x55\xc4\x30\x0d\x24\xa6\x11\x25\x84\xaa\x58\x18\x4e\x21\xd0\x02\x18\xee\x30\xea\xd8\x08\x49\x0f\xf4\x39\xdc\x56\x94\xda\x1c\xf6\xf9\xa2\x76\xed\x85\x32\xd8\x67\xfb\x18\xb7\x46\x05\x3a\xb0\x2c\x8c\x34\xc6\x18\x6f\x20\x31\xb2\x7f\x84\x35\x06\x90\x24\x5d\xad\xcb\xaa\x67\x8d\x9b\x7d\xdb\x86\x36\x28\xd3\x8d\x15\x0f\x06\xbe\xe6\x32\x49\x3f\xc9\xd9\xb1\xf6\x4c\xec\x03\x71\x21\x8b\xb2\x62\x67\x87\xac\x19\xa4\x50\x34\x62\xbb\xc7\x95\x0c\xe2\xfe\xa6\xf4\x03\xd8\x63\xd1\x22\xbc\xfc\xa0\x55\x0e\x36\xb9\x75\x13\x17\xd9\x12\x8e\x75\x60\x3b\x31\xd3\xdb\x55\x92\x57\xa1\xe6\xd3\x79\xf9\xe7\x9b\x41\x51\x0e\xb5\x2b\xd2\xd4\x8e\xfc\x00\xf6\x65\x1b\x01\x56\xdb\xa9\x6b\x2d\xd0\xbc\xa3\x30\xbd\x00\x8d\x50\x5b\x03\xea\xe8\x60\xe9\xb8\x6a\xdc\x9e\x2f\xf6\x3e\x4f\x34\xfc\x92\x0d\xd1\xe5\x81\x4c\xa2\x33\xb0\xce\x51\x75\x42\xbe\x2e\x7c\x09\x49\x2d\x17\xda\x57\xe0\x74\xca\xdf\x26\xa9\x5c\x54\xae\xda\xca\xcd\x3e\x84\x8d\x9e\xcb\x7e\x3a\x7f\xac\x72\xce\x97\xbe\x34\xbc\xad\x20\xa8\x2b\x5b\xeb\x2c\x2d\xd6\x4b\x0f\x2c\xd4\xf5\x45\x2b\xa3\xb4\x5b\x40\x09\xed\x77\x21\x0d\xac\x20\x35\xc5\xbb\x31\xb1\x83\x91\xe6\xff\xea\x75\x73\x82\xd4\x8b\x2f\xa4\xdd\x6a\x9c\x75\x3a\x7f\xb7\x41\x0d\x09\xca\xd2\x49\xb3\x44\xa7\x8d\x27\x43\x6b\x70\x3f\x6c\x62\x08\xd9\xb8\x73\x8e\x9e\x61\x8a\x35\x0d\x39\x04\x11\xc8\x08\x38\x95\x4d\x4c\xbb\xae\x9b\xd4\x8c\xb6\x75\x78\x7b\x4f\x7e\x42\xa5\xdd\xba\xf9\x31\x7b\x32\x0b\xf6\xe5\x13\xb4\xd5\xaa\xb6\x8f\x8d\xf6\xda\xeb\x81\x54\x19\x5f\xee\x6a\xf0\x34\x64\x1b\x09\x11\xd7\x46\x84\x44\xc5\xd8\x2a\x4c\x22\x16\x72\xa5\x02\xac\xb5\x32\x52\x06\x0c\x87\x36\x30\x2a\x8a\xb8\x91\xa0\xe2\x90\x86\x02\x88\x08\x63\x1d\xb2\x50\x41\x80\x35\xc1\x96\x70\x81\x19\x8f\x2c\xd7\x91\x92\x94\x69\x1e\x1a\x1a\x69\x61\x89\x8c\x8d\x0d\x63\x0b\x22\x56\x04\x87\x3a\xb2\x22\xe2\x41\xa0\x89\x09\x35\xd1\x9c\x59\xc2\xb4\x89\x69\x27\x58\xdf\xd4\x86\xfb\xef\x41\x7c\x3f\xfa\x73\x0c\xc6\x3b\x91\xdb\x5d\x9e\x3f\x80\xfa\xd3\xc5\xfe\xdc\x5f\xb6\x13\xfd\x3b\x66\x0d\x83\xce\xed\xd8\x85\x8c\x0f\x08\x76\x47\xd8\x8e\x58\xa1\x03\x6a\x12\x1d\xb2\x69\x3b\x8b\xa9\x4c\x7d\x15\xb0\x1a\xd0\x41\x55\x46\x4a\x52\x74\x43\x5c\xfb\x96\x46\x02\xdc\xfb\x69\x28\xc3\xe7\x30\x4f\xb6\x69\x3d\x08\x55\xe5\x0f\x0f\xb9\x3d\xb9\xbc\xf9\x12\x27\xb0\x09\xd7\xdd\xa3\xf9\xcf\xf1\xad\x15\x51\x2c\x88\x92\x02\x63\x69\xa4\x89\x63\x36\xe6\x50\x8d\xb3\xc8\x0a\x4a\x39\xc1\x02\x63\x22\x68\x48\xb1\x70\xff\xd2\x58\x09\x46\x18\x8f\xa9\x8e\x59\x10\x87\x31\xc3\xb1\x08\x68\x10\x63\x0c\x11\xe3\x98\x33\xaa\x8d\xe0\x1c\x74\x6c\xe3\x18\x47\x4a\x4b\x1c\x86\x04\x03\xa3\xc4\x06\x0a\x93\x00\x0c\xa5\x24\xa0\x0c\x38\xd7\x92\x60\x13\xb0\x28\x52\x01\x55\x44\x60\xac\x39\x05\x42\x39\x89\x15\x25\x81\x25\x86\xe9\x80\xe3\x00\x87\x41\x1c\x1b\x43\xb9\xb4\x71\x44\x23\x1a\x31\xe7\xc5\x6e\xd0\xbc\xad\x49\xbe\xa3\xfb\x11\xd0\xbd\x4f\x2a\x46\x4b\xc4\x9b\x6b\x38\x9c\xbe\x50\x87\xf9\x8e\x3e\xd1\x98\x43\x37\x42\xd8\xee\xe2\xbc\xeb\x51\x97\xd2\xf1\xf9\xa2\xfe\xac\xef\x45\xbd\xf3\xdf\xb7\x73\xe1\x61\xc4\x8d\x08\x14\x57\xc2\x08\x2c\x8d\xd1\x8a\x0a\x22\x39\x31\x21\xb3\x9a\xab\x20\x88\x98\xb5\xd0\x0d\x1f\x67\xab\x44\x3f\xcc\x11\xde\xab\xb1\x7b\x7b\x38\x03\x9c\x58\x6a\x42\x21\xa4\x14\x92\x80\xc4\xd8\x82\x08\x08\x35\x31\x8d\xa3\xc8\x48\x46\x99\x89\xe3\x20\x96\x21\x21\x56\x63\x05\x82\x40\x14\x5a\x69\x42\x2a\xad\x38\x7a\xcb\x77\xda\xc9\xbd\xc1\xef\xa5\x4d\x0f\x73\x80\x4f\xa4\x3d\x96\x01\x1a\xe2\x57\xaa\xbe\xa8\x1c\xca\x6a\x8b\xbc\x2f\xe6\x74\xbc\xfd\x6a\xe3\x06\x5f\x04\x5a\x1d\xb0\xbe\x07\xba\xe3\x03\x0a\x7e\xab\x70\x34\x68\xed\x06\xe3\x20\x38\x03\xe1\x03\xaf\x78\xbb\x95\x7b\x87\xa9\x79\x8a\x18\xfa\x9e\x2d\x8c\xdb\x12\xca\xbb\x87\xb3\x4a\xe7\x24\xc1\xb9\x40\x2b\x99\x18\xbf\x0b\x9c\xc9\xd3\x71\x8d\x1b\xf5\x4b\x6c\xce\x86\x42\x15\x7c\x3e\x21\x6a\x0f\x74\x84\x06\x11\x58\xad\xb4\x52\x01\xeb\x47\x79\xfc\xc9\xc8\x69\x00\x39\x78\xca\x12\xf2\x08\x88\x88\x2d\x66\x36\xda\x06\xe1\x1a\xf2\x72\x88\x15\xee\xc9\xb7\x2a\xf3\x35\xa0\x25\xc8\x6e\xbe\x7f\xed\xd8\xdd\xc8\xa2\x1d\x77\x08\x20\x9f\x7a\xd5\x7c\xce\xd6\xe5\x6a\x5d\x3e\x4c\x45\xef\xcf\x05\x6f\x6c\xcd\x4f\xbb\x96\xeb\x20\xa6\x07\x56\xfa\xc7\xce\xcf\xa8\x7d\xb1\x62\x63\xd3\x6a\xfe\x7d\x89\x12\x5b\xbf\x16\x90\xfb\x44\xc7\xea\x4d\x10\x1f\x90\xa9\x1e\xce\x1a\x18\x6d\x28\xbc\xd9\xcb\xe0\x1d\x40\x62\x6f\xd3\x5d\xff\xd6\xa9\xec\xb3\xbb\xd4\x23\xeb\x4a\x1c\x7d\xa9\x68\xab\xca\xc9\xa3\x02\xb0\x7b\xf9\xe0\x18\xdf\xa7\x9b\xe5\x8f\x50\xf3\x5e\xc7\x29\x52\xe7\x0e\xa9\xf1\x03\x61\xe1\x2f\x8c\xf6\xf6\x22\xe4\x5a\x76\xf8\xe2\xf4\xb1\xaf\xfa\x60\x7a\x26\xfd\x65\xec\xf6\x25\xa6\x9e\xfe\xcf\x93\xe3\x53\x5d\xa5\x2a\xb2\xc5\xba\x7e\x01\xa7\x1f\xd6\x73\x4b\x3a\xde\xa0\xf8\x5e\xad\x5d\x79\xb1\x2c\x66\x13\xef\xc5\x34\xde\x65\x23\x4b\x5b\x64\xae\x4c\x0a\x60\x15\xa9\x40\xf2\x88\x0d\x04\xe6\x2b\x95\x1a\x45\x21\x0b\x22\x11\x91\x28\x8e\x80\xe2\x90\x45\x22\xb2\x9c\x76\xb8\xca\x3f\xa7\x72\x88\xaf\x1e\x42\xf8\x2a\x40\x50\xe9\x4c\xff\xf6\xe1\xa6\x59\xcf\xea\xe0\x20\x0c\x23\xc9\x03\x4d\x30\x04\xc2\x5a\xa0\x56\x3b\xef\x05\x5b\x1d\x1b\x16\x49\x83\x09\x13\x16\x73\xa0\x11\x23\x1c\x08\xe1\xca\x10\xd0\x10\x9b\x98\x09\xd5\xc9\x67\xd9\xd5\x2a\x27\x09\x25\x6f\xe9\x90\x41\xed\x71\x92\x89\x76\x75\xc5\xc9\x33\x08\x7c\xd2\x00\x18\x64\xd6\x8e\x72\x03\x52\xb1\xd7\x5d\x3a\xc6\xfe\xee\x31\xa0\xd7\xcb\x37\x79\x9e\x8d\x91\x8f\x0d\x83\x34\x19\x61\xdd\x87\xc6\x0e\x9e\x04\x7d\xbd\x03\x85\xef\x0a\x6b\xbc\xc2\x1a\x20\xcb\x2b\x54\x66\x0f\xdc\xad\xa0\x71\x2a\x10\x1d\xa1\x06\xb7\x5e\x83\x6b\x00\xdd\xe5\x9d\x2d\xbe\x39\xe2\xa5\x2a\x54\x57\x42\xf3\x97\x4c\x8a\x43\x5c\x9c\x59\x5b\xc0\xa8\xdc\xad\x81\x73\xa4\x83\x5e\xa1\x1f\x19\x25\x29\x5a\xba\x15\x83\xa9\xeb\x8b\xa2\x02\x36\x21\xef\xc5\xd8\xcc\xb1\x4e\x22\xcf\xb8\xe9\x7d\xea\x58\xb5\x0b\x70\xb3\x56\x0f\xe4\x7a\x1b\x71\x76\xb0\xef\x4a\x56\x3b\x60\x28\xa0\x73\xe1\xcf\x79\xb0\x77\xd9\x1a\xa5\x00\xa6\xbe\x3e\x58\xad\xc7\x61\xbc\x40\x2b\x39\x03\x33\x41\x30\x99\x4d\x50\x3b\xce\x74\x3a\x6d\xff\xfd\x7b\x07\xb2\xf3\xcc\x13\xe5\xfc\xb2\xf7\xd9\xfd\x50\x21\xec\xfc\x12\xe1\x97\xfd\x1f\xaa\xa5\x9c\xbb\xa5\x23\x84\x3a\x3f\xfd\xd7\xd9\xee\xbf\xba\xd3\x56\xb1\x26\x95\x5d\x03\xca\xc1\xb6\x17\x1e\x57\x3e\x93\xcb\x13\xa7\x40\xd8\xdf\x8c\x74\x6d\xab\x5f\x7c\x2e\x65\x81\x08\x9e\xf4\x71\x52\xc3\x8d\xa6\xce\xcd\x9e\x36\x18\x31\x59\xfa\x43\xe9\xf1\x52\x66\xc8\xc0\xd2\x0d\xb6\x92\xb3\xaa\x64\x6c\x87\x15\x3f\x6c\xae\x88\x0d\x33\xe2\x3a\x1d\x62\x84\x5d\x7d\x9d\xae\x97\x7d\x5d\xfa\x6a\x27\xc9\xa5\x92\xf8\x64\x09\x67\x43\xfc\xb3\xdd\xf8\x00\x0b\x19\xb0\x49\x5a\x07\xe3\xaa\x93\xe6\xcc\xa2\xa9\xcd\xb3\x65\xfd\x6e\x70\x99\x4d\x27\xbd\x0e\xd3\x6a\xf0\x69\xbd\x07\xec\xa6\xfa\xbe\x44\x53\x07\x51\xff\xa7\x36\xd3\xf2\xa5\x9b\x4a\xae\x17\x55\xa6\x60\x3d\x48\xe7\x05\x43\x37\xe5\x69\xe2\x12\x78\x33\xe8\x50\x42\xca\x43\x86\x24\x55\x44\xf8\xec\xb0\x50\x75\x31\x59\xdd\xef\x73\x0b\xad\x8b\x22\x26\xa9\x17\x9d\xfb\x25\xa7\xea\xb9\x2b\x37\x8e\x34\xe7\x97\xe8\xdc\xe7\x01\x6c\xc9\x8e\xc3\x5d\x25\x3a\x5b\xdf\xcb\xec\xdc\xc3\x7e\x84\x3c\x35\x52\x94\x75\xd6\xe1\xc6\xaf\xc9\x49\xf0\xe6\x6d\x4b\x37\x72\x67\x45\x5e\x64\x8a\x52\xa6\xc6\xdb\xdc\xea\xc8\x7e\xbd\x58\xf8\x51\x6a\x5a\x7f\xca\x56\x89\xfe\x08\xa5\xaf\xc6\x78\x38\x9b\x68\x95\x68\x7c\xaf\xb8\xf8\x62\x29\xe3\x9a\xd1\x71\xcd\x82\x71\xcd\xd8\x3d\xcd\xf6\xf0\x89\x74\xc6\xc1\x6f\x0f\x57\x89\x2e\xd0\x3f\xb2\x24\x6d\xee\xcf\x4d\x65\x6a\xa6\xc8\xe1\x42\x96\x59\x3e\x69\x90\x5a\xb7\x94\x39\xa0\x64\x96\x66\xf9\x11\x9a\xd8\x63\xf1\xdc\x9b\x76\x63\x69\x48\xa5\x21\x0a\xa8\x16\xb1\x8a\x62\x4d\x15\x8e\x84\xd5\x01\x17\x46\xca\x38\xa4\x4a\x72\x4b\xa2\x40\x33\x49\x48\x44\x85\x0d\x43\xc9\x8c\x0d\x69\xa0\x02\xb0\x3d\xbe\xf3\x23\x93\xf3\xad\x90\xc4\x30\x57\x79\xeb\x58\xd4\x9b\x0a\x74\x33\xcf\x0a\x70\xba\xc5\xc1\x36\x45\xf0\xcf\xb5\x5c\x14\x68\xfa\xe5\x10\xb6\xba\x6a\xc7\x65\xaa\xb9\xe9\x24\x68\xe8\x9c\x9e\x74\x4b\x8b\x1e\x3e\xec\xea\x98\x06\x74\x8f\xa7\xd3\xb1\x26\x1b\xf7\x2b\x5b\xed\xa4\x24\xde\x3f\x46\xed\x1c\x6d\x9d\x8b\x7c\x84\x47\xd8\xd8\xf5\x05\xbb\xc6\x51\x1d\x86\x1b\x27\xef\xe3\xef\xcf\x74\x77\xbc\x10\xc6\x86\xf1\x50\x2a\x88\xe2\x50\x73\x1b\x71\x29\x24\x0d\x28\xb1\x41\x20\x45\x18\x29\xac\x98\xe6\xa4\x13\x05\x1e\x7d\xa6\xf1\x65\xd3\x1c\x73\x44\xf1\xb0\xc3\x2e\xaf\x50\x07\x8a\xdc\x3e\x07\x4e\x94\x2d\x6b\x9c\x9e\x17\xb7\xd9\xae\x2b\xb1\xbf\xd4\x75\x79\x1e\xe1\xdc\xf3\xde\x92\x65\xdf\xaa\x49\x6b\x6b\x1d\x6d\x3c\x9e\x6c\x5d\x7a\x24\x4c\xd0\x4f\x8b\x05\xb2\x09\x2c\x8c\xb7\x60\x23\xec\x5d\xd5\xfa\x41\xe6\xae\x26\xc1\xf9\x71\x32\xbb\x6b\xd7\x4e\x65\x31\x8f\xb3\x8b\x9e\x5f\xfc\x23\x33\xe3\xc1\xf7\x9e\xba\xc7\xe7\xd7\x34\xa9\x8d\x94\x3c\x4c\x3d\x3e\xaa\x41\x7e\x0e\x0a\xb0\x11\x9a\x8f\x43\xa1\x89\x53\x44\x59\x1b\x4d\xd7\x01\x3c\xdf\x32\x7c\x87\x42\x1b\xae\xad\x53\x65\x75\x25\xa1\x76\xff\x56\x6d\x0e\xa6\xb2\xd0\xd3\x87\xed\x64\x65\xa1\xb7\xbe\x38\x28\xfa\xc6\x6c\x8c\x92\xfe\xee\x2f\x9c\xc0\x5f\xf8\x57\x17\x94\x6d\x86\x7b\x46\xb2\xd2\x96\x7c\x3f\x44\xc3\xaa\xee\xdd\x31\xfc\x54\xc5\x02\x2f\xae\xc9\x04\x4f\xf0\xab\x28\x12\x58\xc5\xe2\x95\x81\xeb\x8b\x45\x92\xae\x6f\x2f\x66\x19\x99\x10\x3c\x09\x3a\x15\x0c\xa0\x28\x7f\x1e\x5d\x77\x61\xbb\xc8\x88\xe0\x2a\x90\xcc\x30\x6d\x2c\xd1\x3a\xa4\x26\x8c\x54\xcc\x31\xb3\x4c\x13\x61\x31\xc5\x40\x14\x13\x46\x29\xcb\x24\x0d\x0c\x01\x60\x96\x58\x19\x5a\x1b\xb3\xae\x2d\x39\xe6\x0a\x66\x0b\x43\x24\x58\xcc\xdb\x1f\x56\x00\xf9\x91\x6b\x08\x31\x10\x4a\x65\x88\x43\x80\x30\x54\x82\x05\x01\xc1\x91\x90\xda\x1a\x11\x72\x08\xb8\x34\xa1\xb0\x2c\x0a\x24\xb6\x52\xc5\x52\x5a\x4b\x35\x01\xa6\x28\x50\x43\xa9\x04\x4e\x8c\x26\xcc\x1a\x69\x23\x00\x69\x38\x53\x26\xb0\x11\x0e\x63\x16\x31\x26\x65\x10\xea\x50\x08\x1b\x6b\x19\x29\x08\x02\x46\x80\x6a\x20\xc2\x18\xcd\x48\x10\xd0\xce\xad\xb5\x14\xaa\x94\x87\xa3\xa0\x27\x54\x4c\xc8\x24\x88\x27\x84\xe2\x4b\x42\x68\xd0\x39\xfd\x4b\x52\x95\xad\xd3\x2f\x39\x9e\x32\xeb\xf1\xb7\x65\x36\x87\x64\xa2\xc9\x45\xdf\x7a\xae\xbc\x19\x25\x4b\xe1\x98\x94\xf4\xcd\x6b\xe7\xe3\x7a\x6c\x3d\x91\xde\x87\x6e\x83\x1c\x73\xe2\x1c\xc0\xf6\xc2\x15\xda\x94\x14\x6e\xef\x3d\x75\xea\xf1\x90\x66\x9c\xc1\x6b\x49\x28\xd8\xbd\x09\x84\xfe\xe3\x3f\xdb\x6f\xbd\x5b\x3b\x88\x50\xd1\xfd\x65\xfb\x80\xb2\xce\x66\x7f\x58\xf6\xa5\xbf\x0c\x52\xb9\x76\x5b\x98\xe8\x9c\xa8\xb7\x77\x5e\xfa\x01\xa4\x2a\x2b\x1d\x11\xb1\x01\x65\x3b\xc7\xa3\xa9\xf2\xd2\x45\x8c\x66\xa1\x88\x59\x1c\x8b\x50\x46\x46\x44\x8a\x93\x20\x8e\x62\xac\x84\x20\xc4\x98\x40\xb1\x88\x71\x8d\xa9\x61\x96\x11\x6d\xc0\x2a\x6e\x02\x1a\xd0\x5e\x0a\x7f\xb7\x16\x0b\x22\xdb\x3f\x6c\xea\xa2\x20\x12\xd2\x80\x84\x11\xe5\xa4\x4d\x79\x1e\x7a\xf7\xfb\x41\x3c\x5b\x71\xe0\x58\x76\xfd\xb8\xf5\xc2\xf8\x71\x77\x34\x76\xf8\x3a\x97\x37\xdf\x74\xc2\x74\xb5\xd0\xad\xe7\xcd\xf7\x12\xe9\x71\x6e\xaf\x3c\xe8\x3a\xd2\x16\xa8\x07\x26\x78\x3c\x55\x55\x8d\xd8\x54\xb6\x3c\x78\xd8\xba\xd5\x06\x8d\xcd\x2c\xec\xbb\x54\x49\x6a\x12\x5d\x3d\xfe\xb0\xfb\x30\x78\x5d\x04\x35\x49\x67\xfe\xae\x5d\x95\x0d\xa5\x40\x57\xf7\x3b\x73\x99\xea\x79\x7d\x02\xd6\x38\xbc\x6d\xdd\xc9\x53\xb8\x4a\x03\x6e\x1a\x53\x77\x25\x6c\x7d\x53\xc9\x2c\x97\xcb\xad\x8f\xbd\x2c\x2d\xff\x09\xae\x97\x26\x29\xb6\x3e\xa6\x59\xb6\xda\xfa\x94\xad\xaa\xec\xd8\xad\xaf\xab\x1c\xb6\x8b\x14\x54\x4c\x96\x0f\xcd\xbe\x4e\xb7\xbf\x1e\x20\x80\x43\x47\x5d\x3a\x40\x43\x3e\x41\x6f\x96\xab\xf2\xce\x7f\xed\x9c\xf1\x34\x67\x7a\x45\x99\xaf\x75\xf5\x08\xe0\xcc\x3f\x4e\xe6\xfa\x0c\x7a\x1d\x1d\xaf\x4d\xe6\xb3\x21\x97\xfe\x70\x90\xad\x0f\x65\x7d\x6c\x69\x13\x30\x68\x25\x4b\x5f\xec\xa0\x1a\x77\x93\x77\xa7\xc1\xf4\x8f\x30\x7f\xf1\x17\x1f\x17\x77\x2f\x51\x96\x2e\xee\x3a\x59\x9a\xc5\x7a\xb5\xca\xf2\x12\xcc\x04\xfd\x9b\x3f\x15\xec\x75\x9c\xd6\xa6\xe7\xe2\x45\x79\x7b\x95\x1a\xb8\xfd\xa3\xbc\xbd\x32\x3f\x5e\xf8\x01\xaa\x2f\xd3\xfd\xce\xae\x91\x4a\x31\x13\x59\x2c\x9d\x15\xe5\xd2\x70\x6d\x30\x60\x2e\x89\xa5\x58\x85\x2c\x32\x0a\xf3\x00\x1b\x11\xc5\x26\xd4\x5a\x61\x63\xa8\x24\x11\xf0\x30\x0e\xd5\x05\xbe\xc0\xfd\x9a\x7f\x9d\x12\x9b\x8f\x10\xcb\xec\xa3\x79\x37\x4b\x7b\xcf\x32\x25\x8b\x28\xc7\x41\x04\x14\xc7\x21\x28\x4e\x34\x0d\x18\xc1\x21\x33\x52\x46\x41\xc8\xb9\xc6\x11\x65\xdd\xc2\x8f\x9f\xe1\xee\x63\x29\xf3\x63\x77\xbd\x63\x2c\xd2\xa1\xbf\x4e\x0a\xa9\xbc\xed\x67\xa9\x6c\x20\xf0\xe7\xda\x43\x10\x74\x32\x34\x46\xb3\xf1\x16\xf8\xe0\xdc\x10\xc6\x44\x24\x42\x1b\x6b\x4e\xad\xa6\x2a\x66\x51\x2c\x30\xd8\x90\x18\x61\x28\x16\x4a\x49\xc9\x4c\x60\x8d\xb6\x58\x87\xdc\x30\xc1\xb8\xd4\x92\xc2\x1e\x76\x38\xa8\xdf\xe0\xb6\xfc\x2b\x8c\x29\x02\x34\x9c\x6c\x5c\xf4\x4b\x4d\x6e\xfa\xef\xd8\xb2\x1d\x2b\xe4\xfe\xfe\x97\x5b\x76\x10\x00\xa3\x41\x2c\xb0\x8e\x55\xc0\x0d\x66\x42\x19\x67\x64\x94\x61\x92\x56\x37\x5c\x09\x8b\x62\x4a\x31\x0b\x19\x0e\xa5\xd6\x9a\x5a\x16\x09\x83\xc1\xc6\x51\x2c\x44\x3f\x1b\xe9\xd2\x31\xcf\x89\xea\x55\xa2\xde\xdf\x26\x1d\xea\xcb\x06\xd6\x35\xc4\x3f\x83\x3c\x98\x10\xfa\xbd\x02\xdb\x09\x2a\xb0\x7d\x2f\x7a\xb6\x97\x0a\x0f\x2a\x7a\x56\x15\x92\x3f\x02\x99\x73\xb8\x1d\x6f\xb4\xbb\x55\xea\x47\xd4\xa7\x7f\x24\x2b\xf0\xfd\xef\x79\xff\x75\xdc\x88\xd3\x29\xcf\x5d\x66\xad\x15\x69\x66\x7d\x41\x2d\xbb\x4e\xeb\x22\x4f\xce\x05\xee\x72\xf2\xa0\x8a\x75\x66\x7a\xfb\x5d\x85\xf6\xa9\xea\xf7\xb2\x6c\x37\x76\x9b\x37\xb5\x36\xe5\xdd\x93\x4a\x0d\x95\xf3\xb3\x01\x40\xf7\x7a\x65\x83\xe5\xdf\xb7\x8b\xa1\x0f\x4a\xf3\x70\x29\xae\x87\xdd\x86\x6c\x62\x23\xf5\xb3\x10\xfd\x55\xe6\xf2\xa6\xb3\xc2\xee\x03\x2c\x83\xe5\xbd\xf3\xa6\x82\xbe\x74\x3d\xbb\xd7\xce\x26\x3b\x6b\xee\xc6\x21\x87\x17\xdd\xec\x44\x3d\x84\x70\x9d\x14\x9b\xd7\x2b\xb6\xc0\xac\x7f\x1c\x03\x6b\x5d\x2f\xa5\x67\x7b\xb3\x1c\x5d\xbd\x9e\x54\x41\xf2\xc6\x20\x16\x48\x16\xbe\x66\x4c\x62\x51\xe6\x4f\x7c\x27\x63\x68\xb4\x05\xed\x2e\xe7\x0c\x00\xbb\x8f\x75\xfe\xe8\x6a\xf9\xca\xb8\x39\x50\x9b\x3c\xce\x2c\x47\x3f\x38\x90\x7f\xe8\x6e\xf5\x16\x6e\x37\x5e\xf6\x72\x4b\x1f\xca\x67\x9b\x44\x55\x28\x4a\xbf\xae\x5f\x41\x9a\x41\x0a\xcc\x41\x9a\x31\xd8\xf7\x05\x6f\x5c\x6b\x0f\xe2\xfd\x48\x1f\x8d\xf3\xda\xc3\xfe\x2b\xdc\xf5\xb1\x7e\x08\xc1\x4e\x6d\x7c\x86\xbb\x17\xab\xfa\x6d\x94\x1f\xdd\x7e\x54\x6a\xed\xe4\xb5\xb9\xe8\x5a\x7b\xd4\x87\x90\xe9\x71\xf0\x19\xee\x1e\x80\xdc\xd3\xd5\x71\x1f\x7a\x5e\xff\x3e\xa5\xb5\x97\x50\x83\x37\x7e\x13\x3d\x77\x44\x6a\x4b\x02\x14\x5b\xb9\x33\xc7\x48\xf7\x83\xb0\xc1\xc2\x08\xa2\x90\xd3\x88\xf3\xb8\x7f\xbf\xa0\xf3\x32\x7a\x7f\xcd\xd5\xa9\xdf\x98\x15\x77\x1e\xf5\x18\x7d\x50\xf8\xe0\x05\xef\x46\xa8\xb6\x8f\x11\xeb\x43\xc4\x2d\xfc\xc8\xf6\x0c\xfe\xf6\xea\xf5\x78\x3e\xaf\xeb\x4c\xed\x14\xe1\x38\xc0\xcd\xc9\x28\xc9\xdb\x25\x5f\xac\xb4\x8e\x42\x1a\x49\x1e\x49\x08\x23\x4c\x19\xb3\x6e\x53\x88\x43\xad\x31\x26\x31\xe7\x94\x45\x5a\xc5\x54\x53\xc5\x2c\x01\xaa\xb8\xa4\x98\x01\x73\x9b\xc9\x18\x9a\x8b\x9f\x5b\xaf\x15\xf5\x69\xba\xca\x46\xf1\x70\x2f\x9b\x57\x5e\xb7\x45\x9a\xaf\x5e\x57\xaa\x32\x87\x62\xbd\xf4\xe1\x49\x40\xdd\xf7\xa4\x7a\x4a\xe9\xea\xf5\x17\x18\x83\xff\x1f\x00\x00\xff\xff\x3d\x36\xa1\xe7\x0b\xac\x00\x00")
+var _meterYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xed\x7d\xdb\x92\xdb\x46\xb2\xe0\x7b\x7f\x05\x82\xb3\xb1\x92\x66\x5b\x6c\xdc\x2f\x8a\x8d\xdd\x90\x25\x5f\x7a\xc7\x1e\xe9\x48\x9a\x33\x0f\x0e\xc7\x61\x01\x55\x60\x63\x44\x02\x1c\x00\xec\x8b\xed\xf9\x8e\xfd\xa0\xf3\x63\x27\xb3\xaa\x00\x14\x48\x10\x04\x48\xb6\xdc\xad\xd3\xf6\x4c\x58\x02\x81\xaa\xac\xac\xac\xbc\x55\x5e\xb2\x15\x4b\xc9\x2a\x79\xa5\x59\x53\x7d\x6a\x9c\x25\x69\x9c\xbd\x3a\xd3\xb4\x32\x29\x17\xec\x95\xf6\x13\x2b\x59\xce\x8a\x12\x9e\x50\x56\x44\x79\xb2\x2a\x93\x2c\x7d\xa5\xfd\x0e\x0f\x34\xed\xc3\xb7\x1f\x3f\xc5\xeb\x85\xf6\xfa\xfd\xa5\x56\x66\x1a\x89\x22\x56\x14\xe2\x9b\x69\x92\x9d\xf1\x77\x7e\x7e\x9f\x67\xff\x60\x51\xa9\xfd\x90\x2d\xd9\x2f\xcf\xaf\xca\x72\x55\xbc\xba\xb8\x98\x27\xe5\xd5\x3a\x9c\x46\xd9\xf2\x62\x89\xef\x27\xd9\x0b\x78\x7d\x91\x44\x2c\x2d\xd8\x2b\xfe\x65\x4a\x96\x00\xc1\x8f\xdf\xbf\xff\x11\x61\xe3\x8f\xd6\xf9\xe2\x95\x36\xa9\xc6\xb8\xb9\xb9\x99\xce\xd3\xf5\x34\xcb\xe7\x17\xf2\xcb\xe2\x62\x31\x5f\x2d\x5e\xe2\x5a\x58\x3a\xbd\x2a\x97\x8b\x09\x7c\x78\xcd\xf2\x82\x83\x6d\x4c\xcd\xa9\x79\x76\x56\xb0\x1c\x1f\xe1\x34\x2f\xe5\x98\x17\x13\x3e\x41\x6b\x91\x8b\x2c\x22\x0b\x8d\x83\xa7\xa5\x19\x65\x67\x67\x25\x99\xcb\xaf\x04\x70\xaf\xa3\x28\x5b\xa7\x65\xb1\xfd\xed\x6b\x81\x0b\x81\x15\x7c\x47\xcb\x42\x44\x43\xa1\x7c\xfd\x29\x27\x69\x41\x22\xfc\xa0\x77\x84\xb2\xfd\x5e\xfd\xf9\xed\xfb\x2c\x5b\x6c\x7f\x78\x99\x16\x2b\x44\x38\x49\xa9\xb6\x24\x29\x99\x33\xad\xbc\x62\xea\x28\xda\x4a\x7c\x58\x8d\xf4\x0d\xac\xf4\x73\x2f\x08\x61\xf5\x46\xf5\xc9\x8f\xd9\xbc\xf7\x03\x76\xcd\x60\xcd\xff\x53\xcc\x1a\x03\x02\x17\xe2\x83\xea\xfb\xbf\x22\x3e\x7b\xbe\x47\x7c\x6b\x45\x49\xca\x75\xa1\x21\x4d\x2a\x9f\x7e\x5c\x87\xf5\x27\x1d\x30\xc8\x9f\x43\x06\xdf\x09\xe2\x65\x54\x2b\xd6\x5b\xd8\x7f\xcb\xc2\xf5\x7c\xfb\x73\xfe\x58\x5b\x97\xc9\x22\x29\x13\xa6\x7e\xf0\xb1\x24\x9f\x93\x74\xde\x07\x75\x21\x5e\xd1\x28\x29\xc9\xd9\xd9\x8a\x94\x57\x9c\x5c\x2e\x24\x0d\x14\x17\xbf\x11\x4a\x01\xa4\xe2\x5f\x82\xc4\x57\x24\x27\x9c\xbe\x0a\xf1\x77\x9c\xec\x7f\xe4\x2c\x06\x82\xfc\xd3\x05\x9c\x8d\x55\x96\x32\xfc\xac\x79\xef\xe2\xb5\x18\xe0\x32\x7d\x0f\xa3\x4f\x86\x7e\xf5\x81\x5d\x27\x78\x04\x2e\xd3\x7f\x5b\xb3\xfc\x4e\x7c\x37\x67\x65\x35\x6d\x45\xd8\xd5\x70\x2d\xc2\xd6\x00\x7d\xcb\x25\xc9\xef\x5e\x69\x1f\x58\x99\x27\xb0\xb7\x35\x55\x53\x56\x92\x64\x21\x5f\xeb\x60\x11\xf8\x4f\x92\x46\x8b\x35\xfc\xa6\xcd\x42\xb2\x20\x69\xc4\x66\xe7\xda\x8c\xa5\x2c\x9f\xdf\xcd\x38\x95\xce\xae\x48\xf1\x06\x36\x1c\x9e\x87\x77\xf5\xd0\x33\x89\xab\xd9\x54\x7b\x9d\xd6\x4f\x6f\x80\x71\x34\x1f\x68\xb0\xcd\x7f\x2e\xf3\x35\xfb\xb3\x96\x14\x1a\xd1\xa2\x2c\x05\x8a\x8b\xca\xe9\x59\x3d\xfb\x0f\x49\x51\x66\x79\x82\x47\xb9\x0d\xb4\x16\x91\x14\xbf\xff\x27\x60\x24\x01\x1a\x81\xa9\xf1\xe0\x24\xf1\x1d\x6e\xe1\x2c\x97\x28\x9b\xf1\x17\xe0\x37\x58\x79\x3a\x9f\xca\x71\x01\x30\x40\x33\x30\x9c\x06\x6b\x13\x53\xd7\x27\xcd\x5f\x37\xd0\xf1\xee\x2f\xca\x2f\x08\x26\x6c\x91\xfa\xb2\xa6\x91\xd5\x0a\xb8\x18\xc1\xd7\x2f\xfe\x51\xc0\x37\xad\x5f\x61\x13\xa2\x2b\xb6\x24\x9b\x4f\xb5\xce\xad\x17\xef\x02\xb5\x88\x15\x4f\x04\x3a\x56\x59\x31\x7a\xc7\xbf\xbd\x65\xd1\xba\x6c\x36\x3c\xaa\x0e\xee\xce\xed\xc6\x73\x90\x2c\xd7\x0b\x02\x5f\x55\xfb\x81\x5c\xf4\x2a\xa3\x80\xf2\xc5\xe2\x9c\xef\x61\xb6\x2e\xb5\x82\xa5\x14\x71\xad\xb2\xa6\x8a\xd9\x68\xd1\x15\x49\x52\x65\x1f\x2f\xcb\x67\x85\xb6\x2e\x18\x0a\x1c\x64\x30\x45\x99\x2c\x71\x8a\x39\xc1\xc7\xc8\xe5\x90\x94\x18\x07\x17\x07\x82\x1d\x5a\x2f\x80\xed\xc6\x48\x16\x0b\x02\x5f\x36\x7b\x07\x3b\x5a\x94\xdf\x64\xf4\xae\xc1\x40\x6b\x31\x24\x9f\xaf\x97\x88\x48\x31\x66\x7a\x9d\xe4\x59\x8a\x0f\xea\xd7\x71\x8c\x24\x67\xf4\x95\x86\xd4\x77\xd6\xb3\xb1\xfd\xdb\xda\xbd\xa9\x7d\x5b\xfa\x06\x50\xf8\x16\xf8\xcb\xe4\x71\x51\x22\x82\xfd\x81\x6f\xc9\xa4\xc5\x11\xff\xfc\x6a\x8b\x34\xb7\xb9\xe2\xa1\x1c\xee\x00\x32\xd7\x42\x52\x46\x57\x48\x36\x48\xe9\xc5\x70\x52\x6f\x28\x8f\x93\x9c\x42\xd3\x5f\x07\xdd\x7d\x83\x78\x79\xa4\xc4\x57\xc3\x5e\x51\xa0\x4a\x82\x0f\x8b\x00\xc3\xbb\x92\x8d\xa4\xbc\x9a\xc9\x52\xb6\x5a\x64\x77\x48\x2f\xf7\xc9\x62\xbb\xa6\xeb\x62\xb6\xf5\xb0\x7f\xfa\xd3\x9f\xb4\x4f\x97\xef\x3f\xaa\x7b\xf6\x52\x9b\xa1\x92\x34\x03\xe5\xa0\x3a\x17\x5a\x08\x07\x03\xc5\x38\x6a\xab\x35\x1a\xe4\x98\x72\xce\x9d\x23\x08\x32\x6c\x0d\x91\x03\x9a\x93\xa5\x3a\x14\x29\x8a\x64\x9e\x82\xa8\x57\xd4\xf2\x9b\xab\x04\x8e\x3b\xbe\x5f\xaf\x0b\xf1\xc3\xe4\xea\x18\x7d\x12\x1a\x0f\x43\x68\x74\xeb\xd1\x17\xb8\xb3\x5f\x8b\x32\xbd\x5f\xb7\x4a\xe0\x30\xa4\x77\x53\xed\x07\x30\x6c\x24\xd1\x82\x9d\x04\x04\xbf\x45\xec\x8f\x4c\x51\x45\x6d\x7e\xe7\x1e\xa3\x02\x0f\xdc\xe7\xe2\xb7\xcf\xec\xee\x4b\x5b\x4e\x1f\xc5\xdc\x7f\x61\x77\x0f\x85\x4a\x24\x36\xb4\x6b\xb2\x58\xef\x21\x97\x38\xcb\xb5\x79\x02\xa6\xb8\x06\x98\x7b\x64\x14\x21\x11\xbf\x93\x28\x56\x79\x96\xc5\x7f\x18\x31\x14\x1b\x62\xfe\x8f\x23\x07\x14\x36\x15\x49\x2c\x59\xfe\x79\xc1\x34\x8e\x9a\x3d\x0a\x04\x99\x83\xec\x07\xa1\x8b\x4c\x04\xfd\x2c\x20\x2f\xb3\x8c\x4b\x70\x2e\x7e\xb9\x7e\x40\x50\xb0\x0b\xb0\xa7\xda\x27\x98\x16\xc9\x08\xc4\x5b\x8e\x2f\x90\xcf\xcc\x0c\x35\x30\xc4\xaf\x84\x90\xc6\xcf\xe4\xe6\xb4\x60\xc2\x4f\xa6\xf5\xb4\x1f\x38\xf1\xd1\x42\xb3\x0d\x1d\xb9\x59\x33\xbd\x32\x19\x8e\x0a\x22\x18\xc8\x76\x05\x7c\x8d\xd1\xc7\x69\x74\xbf\xc7\x5d\xd8\x49\xbe\xaa\x53\xef\x94\x54\x7c\x0c\x49\xa9\x30\x71\xbd\x4b\x7c\xd0\x4f\x48\x05\xea\x7f\xe1\x9d\xd8\x7f\xf1\xc1\xb9\x06\x7c\x87\x3b\x6a\x40\x58\x49\x7d\x90\x3b\x32\xd5\x57\x84\x57\x08\x75\xd3\x5f\x59\x9e\x09\x6e\xd6\xe8\x60\xe8\x28\x50\xc9\x86\xa4\x52\xfb\x5c\x91\x79\x92\xf2\xfd\xe2\x74\xc8\xe9\x07\xd0\x01\xba\x1d\x77\x31\x6a\x71\xb2\x40\xd4\x4c\x41\xa1\x4e\x40\x7f\x95\xce\xb8\x90\x00\xd5\x54\x0e\x9f\x24\xa5\x60\xac\x51\xe9\x4a\x5a\xd2\x50\x3c\x79\x29\x41\x7b\x59\xde\x16\xb3\x69\xaf\x29\x20\x1c\x82\xeb\x34\x51\x15\xd2\x04\xd0\xc2\x5d\x45\xbb\xa8\x11\xdf\x47\xb4\xe6\xb8\x98\x73\x6d\xc6\x0f\xd9\x0c\x71\x35\x43\xd9\x3d\x3b\x87\xd7\x63\x02\xca\x0e\xf7\x25\xca\x9f\xcf\xfa\x69\xb1\xbc\x5b\x01\x24\xc2\x37\xb5\x05\x60\x9c\x67\xcb\x3d\x00\xee\x1e\x13\xbd\xa7\x73\x96\x6f\x0d\x5a\x66\xa3\xd6\xbc\x5a\xb1\x1c\xd4\xf2\x35\x6c\x5d\xb3\xf4\x6c\x99\x94\xe8\x96\x05\x06\xb0\xe0\xae\xd3\x2b\xd8\x99\x19\x82\x3b\x3b\x0a\xb6\x2c\x8e\x0b\x56\x9e\x7c\xc9\x8b\x64\x39\x6e\xa7\xd5\x7d\x34\x74\xfd\x1c\x99\xdb\x12\x0c\x4c\xfc\x8b\x7e\xdc\x0a\x73\xaa\x3c\x1d\x00\xca\xef\xad\xc1\x67\xa4\x88\x04\xc5\xe1\x4b\x9b\x14\x87\x3f\x8e\xa7\xb7\x07\xc4\x95\x05\x74\x24\xcf\xc9\xdd\xd6\x6f\x49\xc9\x96\xc5\xf6\x27\x83\x58\xf9\xa7\x5b\xc1\xc7\x55\x06\x79\xf1\x5b\x42\x0f\x57\x46\x3f\xdd\x5e\xbe\x1d\xab\x50\x92\x9b\xb1\x4a\xc7\x0f\x8c\xd0\xa1\x0a\xc7\xd6\x6d\xd4\x1e\x09\xd1\x2f\x15\x40\x20\x5c\xbe\x7d\x64\x72\xfb\xd3\xed\xbb\x1c\x90\xfc\xe9\xf6\xef\x20\x18\x7e\x62\x68\x2d\x77\x6e\xfa\x45\xce\x22\x06\xa0\x7e\xc9\xcd\xbf\xcf\x9d\xd4\xe4\x7a\xbe\xbe\x1d\xfd\x20\x16\xb6\xbd\x8f\xaf\xf6\xde\x87\xf4\x21\xf1\x4d\xb6\x04\x81\x30\xfc\x30\xa0\x87\x8a\xdc\x20\xdb\x05\xc6\xb9\x8e\xca\x75\x0e\xb2\x0f\x6c\xb3\x25\x29\xa7\xda\x65\xac\xa5\xe8\xcc\x9b\x83\x56\x03\x3f\xe0\xcb\x5b\x6f\x9d\xd7\x43\xcd\xf0\x45\xe0\xbd\x3f\x80\xde\x3d\xe3\x86\x3f\x83\x17\xd1\xa7\xb5\xe9\x06\xeb\xf5\x3a\xff\x71\x9e\x28\x38\x60\xef\xf2\x8f\xdc\x0d\xf7\x2e\xff\x5b\x2a\x1c\x72\xc8\x5f\x1f\x15\x61\x5d\xbe\x15\x8b\x90\x3b\x21\x09\xec\x16\xef\xd5\x2f\x5a\x40\xf4\x1d\xd3\xe6\xfe\xbe\xf3\x80\xde\xe2\xcd\x77\x75\x55\xdf\x43\x5d\xf3\x3c\x5b\xaf\xc4\xfd\x65\x96\x27\xa0\x1d\x83\xad\x76\x2b\xac\xb4\xd9\x4a\xb8\x7f\x67\xa8\x69\x89\x0b\x0a\x12\x82\x81\x98\xa1\x1e\x8c\x1e\x57\x54\x31\x41\x1d\x03\x2d\x3a\xbf\x49\x80\x70\x66\x40\x2b\x6b\x46\x1b\x2d\x80\xeb\xc8\xdc\x9b\xca\x48\xc1\xcd\x40\xf8\x33\xc2\x84\xb4\x97\x88\x48\x03\x98\x3b\x4b\x23\x78\x8c\x9a\x9e\x32\x6c\x91\xf1\x45\x80\xc9\x83\x37\xf0\x09\xbe\x02\x23\xa5\x8d\x09\x0a\x10\x2e\x12\x7e\x3f\x4f\x62\x0c\xb2\xc0\x79\x52\x76\x2b\x07\x78\x64\xbc\x06\x37\xf3\x8d\x98\xac\x45\x0e\x22\x88\xe1\x58\x6a\x90\x31\x24\xf1\x70\xb2\x20\xed\xfd\x69\xef\x8c\xb4\x98\x24\x72\x1e\x21\xa6\x3f\x72\xac\xb6\x10\x2d\x57\x7b\x20\xa6\x3f\xf2\x3f\x24\xbf\x1e\x73\xf0\xb8\x81\x5a\xde\x3e\x3e\x39\x89\x08\x91\x61\x43\x2d\x94\x96\xb7\xa7\xd5\x72\x29\x5b\xc0\x0f\xe3\x36\xe6\xdb\xeb\x04\xef\x64\x6e\xb9\x35\x3b\x84\xee\xe9\x12\x76\xe2\xf5\xfb\xcb\xf3\x4a\xca\x15\xda\x15\x68\x4f\xc0\x5f\x66\xaf\xd7\xe5\x15\x6c\xd5\xaf\x44\x7c\xf4\x0d\x03\x16\x94\x6b\xff\xbb\xcc\x3e\xb3\xf4\xff\xcc\x1a\x66\xc7\x1f\xe0\x69\x9b\xbd\x7c\x49\x56\xc9\x4b\x3e\xe6\x4b\xfe\x74\xf6\xc8\xb6\x96\xa3\x4f\xbd\x43\x91\x5b\x2b\x9d\x1d\xf7\x13\x91\x74\xf8\x3e\x93\xc5\x82\x1f\x40\xf4\x3e\x35\x41\x3f\x4f\x5b\x7f\x92\xad\x17\x51\x7c\x17\x31\x63\x22\x28\xea\x6e\x2f\xaf\x54\x22\x03\xbb\xa4\x12\x8c\xa4\x5d\x89\xa1\xf6\x18\x0f\xa4\xe0\x6f\x9f\xcb\xbb\x64\xe0\x9a\x39\x2e\x56\xb8\xf4\xf2\x04\x76\xa6\xbc\xe3\xc3\x81\x12\x11\xc1\x2a\x92\x85\xf0\x2a\x0b\x90\xcf\x51\x58\xcd\x58\x79\xf5\x1f\x0d\xec\xb3\xc6\x3b\xf8\x5e\x1d\x40\x44\x5f\xdc\x56\xb7\xc0\x38\x1f\x4c\x00\xba\x07\x68\xec\x2b\x92\x80\xae\x11\x66\xd7\xc2\x71\x58\x41\x35\xc4\xd9\xc7\x01\x79\xa3\xb8\x43\x07\x79\x5f\xd2\xf5\x32\x04\x32\xab\x17\x82\x82\x85\x6b\x53\xc2\x0f\xd6\xb8\x85\x4c\x5b\x19\x62\x87\x76\x3e\xc6\x5f\xa4\x81\xba\x47\x96\x2b\x8c\xe1\x35\xf4\xad\xc5\xa4\xec\x06\x4d\x02\x04\x69\xd4\x6a\xf8\x67\xf2\x72\x40\xba\xfc\xe5\x4a\xa4\x6b\x55\xfc\x54\xad\x3a\x47\x49\xa8\x28\x1e\x3c\x46\xaf\x00\x32\x12\xae\x3f\xe9\x05\x9c\x1e\xe3\xe2\xcc\xd9\x0d\xc9\xe9\xfb\x86\x68\xc6\xac\x07\xce\xcc\x92\x68\x05\xc3\x7d\xe7\x5a\x68\x11\xc9\x70\x09\x95\x0a\xb9\xf5\x86\xee\xe7\x9f\xf5\x73\x74\xe0\xfd\x72\xae\xdd\xb0\x64\x7e\x55\x0a\xd1\x5f\x11\xf4\x21\xab\x50\x76\x69\x62\xe8\xe7\x8e\x7e\x1e\xe8\x8f\xcc\x12\xfa\xae\x3e\x90\x2d\x1e\x03\x58\x79\x8f\xa7\xee\x5d\x4e\xa2\x05\x3b\x90\xcf\x7c\x5c\xcf\xe7\x48\x3c\xf5\x19\xee\x67\x32\x2d\x3e\x02\xa4\x56\x70\xd4\x52\x21\x3d\x90\x56\x17\x19\xa7\x5f\xf5\x3d\xce\x64\xd0\xff\x91\x96\x0d\xaf\x49\x91\x3b\x25\xea\x9e\xb6\xcd\x27\x45\x43\x84\xc7\x11\xc3\x88\x12\xc9\x6a\xb8\xab\x18\xc7\x7c\xb4\x76\xcc\xf7\xad\x9d\x6b\x6d\xea\x6f\xd5\x05\xdd\xe1\xca\x42\x73\x0d\x3a\xe8\xca\x6a\x8f\xfc\x09\x15\x0e\xd6\xe7\xb6\x42\x56\x24\x98\x12\xbf\x9a\x7a\x86\x2c\xe9\x19\xbf\x1d\xc7\x00\xaa\xe2\xe1\x6e\x14\xa8\x43\xef\xe2\x2e\xa7\xf5\xcb\xfe\x78\x37\x5c\xce\xa4\xf3\x33\xc1\x87\x44\x32\x42\xc7\x0b\x78\x8a\x32\xe0\x7e\x18\xfb\xfe\xaa\xf3\x77\x38\x0c\xc5\xa7\x7c\x9d\x7e\xde\xf5\x73\xc5\xeb\x42\x38\x1e\x8c\xa4\x3b\xdf\x6a\xa1\xf0\xe6\x8a\xa1\x13\x42\xb9\x7c\x86\x03\x8c\xb1\x6a\x57\x28\x03\xd3\xcf\x9c\x0c\xf1\x82\xef\x82\x67\x16\xec\xf7\xdf\xd5\x09\x0a\x0a\xdd\x7c\xc7\xef\x06\x65\x6e\xc2\xa2\x79\x61\x07\xe9\x7c\x5b\xbf\xc7\x5d\x15\x80\x18\xba\x8e\x04\xd3\x9f\xbd\x7b\xff\x1f\x3f\xbe\xfb\x9e\x07\x9f\x7d\xfb\xef\x3f\x3d\x50\x5f\x1b\x5f\x80\x58\xf4\xe4\x2b\xb9\xac\xd9\x79\x20\xf6\x1d\x09\x8e\x8b\xc9\x8e\x0f\xf7\x1e\x8a\x21\xc7\x42\xc3\x20\x77\xb2\xfb\xd7\xfe\xbd\x02\x7a\x6d\xae\x1c\x38\xa1\x57\xa9\x33\x47\xd1\xfa\x66\xfe\x4d\x0f\xb9\x7f\x52\x5f\xe5\x14\x0f\x72\x11\xef\x1b\xb9\xfb\xe8\xa7\x4f\x1f\xbe\xaf\x47\x6b\x67\x42\x3c\x28\x9a\xaf\x56\xf1\x44\xf6\x2d\x74\x3c\x2a\xca\xe7\x0c\xba\xe3\x8e\x86\xb2\x15\x90\x24\xaa\xea\x2d\xba\x7a\x10\xac\xff\xa0\x98\x71\x01\xd5\x3b\xbc\xd3\xdf\xb8\xda\x1d\xfc\x71\xed\x7d\x69\x7d\xbe\x3f\x5a\x59\x60\x42\x44\xcb\x68\xf0\x18\x73\x30\xc9\xc3\x92\x59\x3f\xb2\x39\x89\xee\x9e\x24\xd7\xa3\x95\x5c\xf7\x72\x84\x4f\x29\xd1\x3a\x05\xda\x89\x4f\xf2\xfe\xa3\xa8\xae\xe8\x01\x9e\xc8\xb6\x44\x7d\x3a\x94\x8f\x52\xae\x7e\x41\x91\xfa\x24\x09\x9f\x24\xe1\x93\x24\xfc\xf2\x42\xf0\x49\x6e\x3d\xc9\xad\xaf\x4e\x6e\x61\x1d\x90\x8b\x94\x95\x37\x59\xfe\xf9\x62\xc5\x6a\xe2\xee\xf1\x19\xff\xb5\xc9\xa2\xeb\x8a\xa3\x49\x53\x11\x12\xc3\x07\x7b\x78\xe4\x70\x50\x98\xf2\x7b\x58\x0b\x46\xc4\x14\x0a\xd2\x22\x5c\x50\x5a\xac\x0b\xfc\x80\xdf\xb4\xb1\x23\x51\xb7\xce\x73\xc6\xb3\x14\xe5\x70\xb0\xcb\xe8\x52\x6f\x90\xf8\x38\x70\xc8\x51\x24\x2b\xb5\x5c\x84\xeb\xe8\x33\x2b\xf7\x13\x95\x5a\xfc\xa5\x0b\x39\x55\xe5\x17\x39\xde\x9e\x3b\x09\xf1\x12\xb7\x48\x78\x2d\x22\x79\x47\x45\x52\x91\x89\xa2\x2d\x49\x92\x96\xf0\x7f\x4e\xa6\x79\x15\xb5\x25\xef\x8e\x79\x0a\x40\x75\xfd\xda\x9e\x97\x87\xd3\x69\xeb\x94\x27\x55\xcc\x8a\x2c\x2f\x79\x8c\x28\x4f\x01\x1c\x62\xde\x3c\x1b\x98\xbd\xf6\x6c\x3b\x2f\xe1\x26\xdd\x9b\x97\x30\xfa\x72\x37\x22\x29\x4d\x28\xc8\xc9\x53\x0f\xcc\xc3\x39\xc6\xdc\x14\xeb\xfc\xb2\x08\x2c\xc6\x73\xcd\xa8\xfe\xf8\xfd\x51\x89\x1c\xeb\x94\x27\xc5\xb4\xee\x8d\xc7\xad\x6d\xf3\x7e\xa5\x1a\x79\xc9\xe3\x88\xc7\x25\x26\x49\x58\x6a\xca\xbc\xb9\xca\x0a\x26\x47\xd2\x78\x62\x31\xa6\xe4\xad\x48\x81\x91\x1b\xa4\x94\x11\xa1\x82\x26\x8e\x02\x16\x69\xf4\x88\x74\x96\x84\x8a\x6c\x96\xeb\xac\x64\xc5\x4c\x7b\x5e\x66\x25\x59\x68\xfc\x6f\x78\x5c\xf0\x53\x79\xa3\xcf\x4f\xcd\x0b\x7e\x88\xf8\x21\x11\xa7\xa8\x89\x42\x38\x86\x9c\x1e\x4a\xda\x91\x9a\x65\x74\xce\x43\xa9\x38\x27\x94\x9e\x8f\x2a\x4e\x7c\xdc\x92\xdb\xc0\x3d\x4a\x1e\x5f\xb3\x91\xd3\xb1\xf9\x66\xc8\x7b\xe5\xaa\x47\x9e\x8e\xa7\x53\xf1\x74\x2a\x76\x9e\x0a\xfc\x2f\xbb\xca\x16\x74\x88\x4e\x3d\xf4\x5c\x28\x83\x3e\x66\xdc\x60\xfc\xea\xfc\xa4\x0c\xa3\x1e\x91\x6b\x0f\xb5\x6e\xfe\x15\xa8\xce\x22\x25\xee\x4a\x0d\x28\xed\x8a\x0b\x12\x87\x37\x51\x42\xaa\x60\x1d\x58\x21\x71\x9f\xa7\x60\x1b\xd2\x2d\xae\x73\xc4\xfe\x48\xb8\x79\x84\x26\x5f\xcf\x9e\x64\xf7\x92\xef\x61\x55\x25\x41\x28\xdd\x22\x79\x85\x91\xe8\xaa\xde\xee\x6c\x25\x0b\x0b\x45\x57\x18\x3a\x08\xfc\xa5\x14\x71\x65\x42\x71\x5a\x66\xd7\xc0\x90\x81\xe7\x24\xa5\xc8\xe1\x82\x07\x6a\x20\xe4\xbb\x74\x01\x20\xad\x0a\x19\x74\xa6\xa6\xcf\x88\xca\x98\xf5\x3d\x46\x35\x61\xb5\x8e\x26\xa7\xe6\x29\x83\xfd\x29\x83\xfd\x29\x83\x7d\x60\x06\xfb\x57\x9b\xc0\x2e\xf9\xdf\x07\xce\x2f\x26\x3b\xd4\x62\xa5\x2c\xc9\x5e\x3e\x7e\xd2\x8a\x24\x23\xb8\xf3\xa6\x2f\x60\x10\x83\xae\x3f\xba\x07\x1e\xfd\x86\x7f\x55\x34\x92\xa3\xa8\x85\x3c\xad\x8a\x9d\x28\xf3\x37\xb9\x8e\xa0\x6b\x4b\xc1\x21\x97\xf7\xc4\xa9\x9f\x38\xf5\x13\xa7\x7e\xe2\xd4\xdb\x9c\x5a\xad\x74\x2e\x82\xf0\xf7\x1b\x24\x5b\xd5\xd1\x15\xc6\xfa\xfc\xef\x2c\x2c\x32\xe4\x3d\x2f\x94\x3a\xe9\x29\xbb\x69\x0a\xbc\x1f\x7c\x93\xf8\x3e\x2b\x92\x72\xbb\xfe\xe9\x57\x1d\x4c\xdf\xf7\xd9\x3b\xc0\x34\x66\x41\x76\x6d\xa5\x12\xc3\x7e\xfa\xad\x14\xa1\x1e\xfd\x62\x52\x08\xbe\x02\x70\x58\xc4\x77\xf5\xa5\x2d\x8a\x26\x7e\xc0\xeb\xe2\xad\xa7\xa4\x84\x86\xb1\xa0\xb6\x71\x2a\x97\xfe\xa6\xb3\x47\x56\xa0\x03\xfe\x2f\xe2\x3a\x18\x67\xff\x1d\xa2\x45\xbf\x27\x08\xca\x6c\x95\x44\x7a\x0d\xc0\xf6\xc4\xc6\x7d\x4e\x6c\xf4\x4c\x6c\xde\xe7\xc4\x66\xcf\xc4\xd6\x7d\x4e\x6c\xf5\x4c\x6c\xdf\xe7\xc4\xf6\xe6\xc4\x8f\x9f\xd5\xed\x0c\xb0\x19\xca\xea\xee\x29\xe3\xa8\x3f\x9c\x60\x70\x30\x41\x9b\x09\xb7\xf3\x2b\x4e\xcf\x87\xeb\x00\xa0\x23\x59\xf1\x7d\x72\xe2\xf2\xf6\x1d\xaf\x5c\x71\x4f\xe7\x84\x57\xf7\xc9\x55\xa6\x5c\xde\x56\x46\x57\xc6\x6f\xb8\x8b\xa6\x37\x4d\xdc\xc1\xa5\xb1\x7c\x39\xfb\x02\xb2\x42\x64\xfd\x6f\xcc\xd6\xe4\x18\x47\xc9\x2a\x61\x69\xf9\xa5\xe0\xd8\x9c\xf0\xf1\x33\x96\xbe\xb0\xa3\xaf\x91\xb7\x84\x8c\xdc\x8b\x7e\xa7\xd4\xed\x7f\x86\x25\x72\xc9\x30\x45\x4f\x1e\xb6\x6a\x74\x91\x9f\x7c\xd3\x4a\x78\x86\x3f\x67\xcb\xaa\x7a\x2a\x1a\xc9\x3c\xd0\x67\x85\x0c\xa4\x2a\x95\x4a\xe2\x58\x84\x4e\x49\x82\x6d\x8a\x8c\x3f\x19\x0c\x2d\x83\x01\xb6\xe5\x58\x7b\x81\x62\x1b\x2a\x14\x51\x51\x67\x04\xe9\x26\x29\x35\xcd\xac\xd4\x32\x75\x39\xe3\xae\x27\x4d\x0c\xd3\x45\x28\x78\x19\x55\x75\x6c\x78\xb0\xf9\x7c\x00\xfb\x3b\x0e\xef\xe4\xec\xa1\x86\x6f\x4a\x0e\xd4\xec\x9c\xac\x7e\xfd\x92\x3b\xa1\x0e\xdc\x3f\xe5\x12\x51\x94\xd2\xe6\x83\xf5\x9f\xf7\xaa\x10\xb7\xda\x2d\x4b\x54\x80\x97\x87\xf6\x61\xee\xb2\x2c\xac\xce\xcb\x3c\x57\x7b\xfd\xe0\xb6\x7a\xe8\x02\x26\x2d\x3a\x80\x23\xf8\x92\x26\x71\xbc\x25\x0e\xfa\xdc\xbd\x03\xbc\xa9\xad\x45\x0b\xb9\xb0\xab\xd0\x01\x00\x81\x25\xd7\x37\xeb\x1d\x1c\x57\x71\x66\x67\x68\xdd\xe3\x84\x7b\x90\x3b\x77\x47\x6d\x9f\xea\x02\xa1\x2a\xf3\xce\xbb\xf3\x7c\x4e\x56\x07\x56\xee\xd1\x2a\x4f\xe9\x2b\x4d\x3f\xde\x3f\xbc\x24\xb7\x7b\x40\x15\x41\x30\x27\x70\x1c\xb7\x40\x37\xea\x41\xc6\x32\x3b\x14\x5a\x78\x62\xf6\x04\xd1\xb2\xf2\x06\x5b\x04\x28\x37\x3e\x37\x55\xa3\xca\xd6\x52\xea\x67\x70\xe0\x5a\x75\xe7\xb7\x70\xa1\xb6\x2d\x28\x16\x59\xa9\x14\xa0\x7f\x5d\xbf\x83\xe9\xc0\x64\x2e\xee\x71\xea\x50\x5c\xde\x06\xa1\x56\x8c\x84\x46\xd5\x82\xa1\x9a\xab\x35\xbc\x7a\x2b\x84\x44\xce\xaf\xa7\x24\x34\xcd\xd4\x92\xb5\xe0\x34\x02\x11\x1a\x4b\xc1\x64\x63\x30\x3a\x5d\x2f\x18\xcc\x56\xac\xf1\xbb\xa2\x23\x3a\x4d\xa8\x76\xe2\x7a\xea\x1c\xaf\xb1\x28\xef\x0e\x43\x1f\x5d\x2f\x10\x58\xcc\x5b\x20\x09\x60\xad\xcd\xcf\x38\x86\x7c\x43\x0c\x27\x37\xa9\x66\xb0\x1d\xba\xbf\xec\x40\xd9\x1b\x4e\xd2\xc5\xa7\xc4\x67\xb8\xe7\xfc\x66\xed\xef\xdf\x5e\x9e\xc3\xf8\x0c\x9b\x1f\x54\xea\xf1\x15\xbb\xed\xab\xef\xa4\xdf\xda\x5e\x1c\x1b\x71\xa0\x5b\xa6\x47\x88\x1e\xfb\x8a\x4d\x23\xba\x61\x8e\x85\x4a\x7c\xc5\x81\x82\xa3\x78\x18\x50\x51\xec\x9a\xb6\xe1\xf8\xd4\x09\x0c\x2b\xf0\x1b\x90\x64\x8b\xcd\x6d\x98\xb6\xeb\xba\xec\xac\xe4\x52\xa9\x21\x57\xbc\xd6\x28\x65\x5d\x30\xc4\x64\x01\xba\x27\xff\x45\x9d\xaf\x6b\xf3\xa2\x4e\x78\x7a\x97\xe7\xea\xf8\xaf\xad\x3b\xa6\x0b\xc7\xd0\xd7\x63\xaa\xeb\xc4\x70\x1d\x17\xf6\x00\xfe\x35\x2d\xdd\xf1\x4d\x3d\x32\x2d\x6a\x11\x66\xd2\xc8\x77\x09\x35\xe0\xa1\x6b\x10\xd3\x37\x03\xea\x7b\x91\x17\x85\xbe\x6d\x39\x96\xeb\xd8\x81\x19\x52\xc3\xb1\x7d\x16\x7a\xcc\x8b\x23\x3d\xb6\x5c\xcb\x0c\x59\xa0\xeb\x66\x20\x95\x53\x79\x5a\xfb\x96\xc1\x5b\x5d\x8c\x5c\x87\x7e\xdc\x3f\x86\x84\x4e\x6d\x4d\xd2\x7b\x4c\x90\x65\x5e\xbe\x1d\x0f\xa4\x1d\xbb\x51\xe4\xfb\x61\x68\xbb\xa6\x4b\x02\x33\xd0\x3d\xcf\xf0\x99\x6f\xc6\xa6\xe3\x84\x7e\x4c\x1c\xc3\xb0\x1d\x8b\x78\xf0\xcc\x0b\x3c\x16\xfa\x11\x23\x96\x15\x58\xa1\x69\x38\x93\xf6\xfc\x7f\xe5\x52\x6b\x1b\x86\x6d\xb1\x23\xaa\x64\xbf\xe2\xc7\xc0\x32\xbb\xa0\xb3\x4c\xc7\x52\x6a\xec\x71\xa1\xf1\x21\xcb\xca\x91\x2b\xb4\x43\x8f\xe8\xcc\xa6\x76\x18\x46\xa1\xa3\x87\x66\xcc\x2c\x83\x38\x66\xa8\x3b\xa1\x41\x7c\xa2\xdb\x84\xb8\x3e\x0d\x43\x12\x50\x23\x82\xff\xb9\x51\xc0\xc2\x90\x02\xcd\x31\x9d\x99\xde\x44\xa9\x55\xc9\x45\xc5\xc8\xf9\x3d\xc7\xf5\xa8\x6f\x85\x5e\xe8\x53\x5f\x87\x31\xa2\xd0\xf4\x0d\xe2\x19\xd4\xb1\xe3\xc8\x0b\x2d\xcb\xb5\xc1\x4a\xa7\x93\x03\x18\xde\xa9\x59\xd5\x20\x2e\xc3\x6f\xeb\x0f\x83\x51\xdf\x18\xe5\x20\xc0\x94\x41\x40\x8c\x94\x5d\xe4\xd6\xfb\xfd\xa4\xc5\x9c\xb0\x16\xf8\xc1\x03\x48\xd5\xe0\x00\xaa\x54\xa8\xaa\xe3\x7c\xef\xbe\xad\x6e\xf1\xed\x7c\xb1\x82\xbd\xe5\xda\x81\x86\xaa\x18\x0f\x34\x2c\x9a\xe2\x79\x4a\x93\xa8\x76\x2b\x9f\xb3\xde\x8b\xef\x4e\xf0\xe5\x52\x07\x82\xb9\x73\xd4\x0e\xbf\xdf\x6e\x7f\xdf\x67\x76\xb7\xcb\x7a\xdf\x42\xee\x7d\xf0\xdf\xf6\xd8\x5b\x32\xe0\x0f\x86\x67\xb5\xb9\x15\xfb\xa3\x1c\x46\x52\x8f\x74\x61\x28\xf4\xa3\x36\xb0\x1b\x10\x3c\xd1\xc2\x0d\xff\xfd\xd3\xed\x4f\x8a\xf3\x76\x3b\x37\x59\xb6\xb7\x40\x0f\x6f\xd5\x44\xfe\x78\x81\xd7\x61\xbf\x26\x14\x6b\x85\xc6\x09\x68\x3d\xcf\xb1\x01\x63\x61\x99\x2f\x1e\x8d\x88\xec\x58\x8f\x34\x17\x9f\x5f\xf1\x32\xa7\x2f\x06\xc8\x53\xfe\xdd\xa7\x64\x09\x36\x3a\xbc\x30\x16\x1e\xd7\xee\x87\x07\x54\xee\x5b\x9e\x85\xc6\x47\xef\xac\x71\xeb\x58\x96\xe9\x7a\xa0\x88\x09\xca\x90\xae\xf9\x4e\xd2\x10\x71\x01\x59\x3b\x89\xfe\x89\x48\xfe\x5b\x11\x49\x3d\xf1\xed\xf8\xed\x54\x59\x4b\xb3\xa9\x3b\xb6\xd2\xf4\x41\x55\x24\x8e\xce\x62\xcf\xf3\x7c\x3f\x00\xb5\x8a\x58\xae\xc7\xa8\x1e\x5a\xa0\x0d\x31\x30\x44\x5c\xcf\xb0\x6d\xcf\x8b\x6c\x9d\x32\x78\xe6\x19\x11\xa3\xd4\x8d\x83\x98\xc0\xd3\x89\x02\xaa\xb8\xaa\x3d\x06\x5c\xd9\xa6\xe0\xb9\xb8\x97\xdd\x45\x7e\x34\xb4\x75\xd3\x83\xc9\x43\x93\xf8\x31\xb3\x23\xdf\x8a\x5c\x4a\x62\x30\x79\x7c\xd7\xf5\x80\x28\x8d\xd0\x27\x3e\x95\x36\xc5\x37\x4d\x5c\x5a\xf7\xb1\x49\x1f\x08\xfd\x25\x74\x00\xee\x2a\x10\xe4\x11\x1d\x7a\xa6\xef\xfd\x24\x17\xc9\xaf\xec\x74\x28\xfc\xf0\xe3\xfb\x5a\x5c\x8b\xa5\xe0\xf8\x3c\x34\xf9\xae\x6c\x15\xd1\x6e\x90\xe9\x35\x61\x3c\x2b\x82\x95\x00\x06\x1d\x9d\x81\xf8\x14\x23\xd6\xfe\xe0\x7e\x74\x86\x9e\xa5\xd3\x90\x06\x3a\x58\x3a\x3a\x18\x5a\xae\x13\xc6\x34\xb6\xac\x28\xd2\x19\xa3\xb6\xc7\x22\xdd\xf5\x03\xcb\x8f\x5d\xc6\xbc\xd0\x8b\x0c\x93\xd8\x8c\x04\xbe\x62\x16\x95\x0f\x8a\x0d\xcd\x49\xf1\x23\xfa\x75\x4f\x0d\x4c\x53\x91\xfa\x39\xba\x81\xc9\x02\x2b\x60\x73\x87\xe7\x9a\xf7\x8e\x4f\xae\xd5\xe6\xee\xe8\x42\x55\xba\x5e\x75\x1e\x29\xc3\x80\x33\xe5\x78\x81\xe2\x86\x4d\x59\x9c\x44\x09\xc9\xef\x4e\x47\x0d\x4a\x44\x44\xe5\x42\xe2\x7e\xea\x88\x25\x55\xd5\x67\x59\xf9\x7d\x07\xa1\x00\x07\x0b\xec\xc8\x74\x80\x61\x51\xd7\xf4\x63\x4a\x1d\xcf\x20\x31\xf0\x58\xcf\x8b\x75\xaa\x1b\x81\x4b\xe2\xd0\x56\x0c\x51\x40\xc3\xdf\x0a\x46\x4f\xb7\x03\xc3\x90\xdc\x05\xbf\xd9\x72\xc0\xf3\xe4\xdd\x8f\x51\x96\xb3\xd3\xc1\x56\xac\x97\x1c\xb7\x8b\x85\x86\x86\x37\x6c\x13\x59\x48\x37\xf9\x33\xad\xc0\xb9\xba\x1b\x09\x98\x41\xe0\xfb\x8a\x44\x2a\x06\x1a\xab\x03\xb7\x9d\x1b\x07\xe8\x4a\xdf\xc4\x52\x55\xfa\x62\xf3\xfa\x47\xdd\x72\x3f\xa0\x31\x0d\xe2\x88\x1a\x7a\x14\x30\xc7\xa2\xae\xef\x04\x66\x14\xfb\xa1\x63\xeb\xa1\xe9\xeb\xa1\x67\x52\xcb\x07\xd1\x05\x3f\x98\x96\x69\x5a\x41\x60\xc6\x16\xd3\x03\xe2\xeb\x6e\x18\x4e\x0e\x72\x0e\x1d\xb2\xb2\xfa\xbe\x81\x4f\xb4\x6b\x39\x6e\x18\x81\xd4\x35\x0d\x3b\x8c\x02\xea\x53\x50\x0e\x68\x48\x0c\x1d\x78\x99\x6b\x81\x44\x36\x3c\x6a\x04\x11\x0b\xbc\xd8\xd5\x23\x9f\x98\x2c\x76\x22\x27\x08\x43\x0a\x6a\x84\x6d\xba\x8a\x81\x27\xbb\xfb\x7d\xa1\xbd\xaa\xa7\xdb\xb1\x2e\xc3\xf1\x7c\x8f\x01\x13\xb1\x22\xdb\xd3\x99\x4f\x5c\xdf\x67\x2e\xec\x9a\x47\x0c\xc6\x0c\x93\xfa\xb6\x83\xaa\x12\x85\xb3\x6b\x52\x33\x32\xf4\x80\x99\x70\x86\x4d\x97\xfa\xcc\xb1\x99\x2a\x11\x51\x89\x19\xbb\x22\x53\xdf\xa9\x28\x61\x6d\x95\x94\x61\xed\x0b\x31\x36\x66\x02\x25\x45\x2f\xd1\x91\x10\x94\x24\x2f\x06\x82\xf3\xa8\x19\x80\xce\x66\x32\x27\xa4\x96\x6b\x80\xfa\x44\x1c\xc7\x70\xa8\x1e\x45\x26\x55\x76\x63\xbb\x0b\xe1\x60\x0f\x4d\xeb\x44\x5c\xbe\x2d\x0e\x70\xbc\xf4\x6f\x70\x8f\xe6\xd8\x12\xc9\xa7\x56\x71\x85\xf3\x9f\x07\xa1\xf4\xe9\x91\x65\x36\x56\xf7\x9d\xd4\x91\x74\xfc\xee\x93\xcf\x70\x0e\x9a\x23\xf0\x3d\x99\x86\x2d\xba\x4d\x53\xb6\x5a\x64\x77\x4b\x7c\xaf\xb6\xcd\x26\x3b\xb6\xdc\xd1\x2d\x9b\x10\x27\x80\x93\xe8\x84\x2e\x68\xca\x16\xd1\x4d\xd7\x04\xc1\x18\x82\x86\xe1\x99\x0c\x4e\x27\xb3\x75\x85\x50\x87\xfa\xfb\x5b\xa0\xe3\xc5\x0d\xee\x54\x13\x15\x08\x12\x30\x6c\x42\x25\x73\x46\x77\xfb\x6e\x69\x68\x45\x56\x6c\x3b\x6e\x84\xce\x9e\x06\x12\x6c\x85\x3d\x16\x90\x24\x5d\xad\x4b\xfe\xa5\xc4\xcd\x8b\x9d\x5e\x48\xe9\x94\x51\x83\x4a\x3a\xaf\x71\x30\x7c\xed\x13\x99\x8f\x95\x67\xfe\x2e\x10\x17\x04\x43\x03\x00\x36\x44\xd6\x1c\x14\x92\xa2\x3a\xb6\x3b\x54\x49\x2b\x68\x1b\xa5\x1f\x58\x3c\x16\x2d\xbe\x38\x3f\x78\xdf\x16\x83\xc6\x87\x97\xb3\xd9\x92\x8d\x55\x60\x15\xb7\xfa\xed\x2a\xc9\x45\x73\xaa\x93\x69\xf9\x93\x66\x50\x60\xcb\x52\x15\x29\xb3\x7a\xcd\xe7\xf5\x7d\x66\xb8\x99\xe3\x52\x03\xed\x29\x0c\x53\x1c\xa0\xe2\x20\x8f\x6d\xdf\xf5\xae\x38\xfa\x2d\x5d\x8c\xb7\x20\x79\x93\x75\xed\xcb\x81\x44\x12\xc1\x60\xa8\xa8\xe2\x21\xe7\x0d\xa8\x00\x11\x11\x59\x44\xa8\xa2\x89\xcc\xfa\x38\x49\x41\x0d\xda\x6c\x36\xd3\xc2\x46\x4b\x65\x3f\x9d\x3e\xc6\x95\xf3\x65\xd5\xe2\x11\x21\x90\xfd\x94\xb0\x50\x04\x6f\x97\x04\xc0\xca\x5a\x00\x42\x28\x6d\xf7\x9e\xed\x51\x21\x81\xbd\xb1\x94\x16\xef\xd2\xd3\x89\x7f\x0c\xd9\x89\x9b\xd8\xed\xca\xbf\x90\xca\x80\x53\x9e\xfc\x2a\xeb\xc5\xa9\x2f\x48\x48\x34\xec\x24\x2a\x97\x88\xdc\x78\xda\xb5\x06\xfc\xa1\xf1\x21\x64\xe3\x2f\x88\xcc\x00\x2c\x00\x8f\x59\x2e\x23\x2e\xf3\x4c\x52\xdd\xd0\xca\x96\xb3\xd5\x68\x1b\x51\x9e\x7b\x02\x99\x39\x77\x53\x03\xe9\x77\x5c\x45\xec\xba\x88\xa8\x1b\xfd\x6e\xba\xb8\x7b\x5c\xff\x5b\x31\xf5\xa2\x2e\x57\xe7\xdd\xfe\xd6\x05\xb8\x17\x51\xdf\x31\x42\x30\x96\x43\xdd\x70\x41\xb9\x0a\x43\x0b\x94\x92\x90\x12\x62\xd9\xba\x13\x5b\x34\x74\x5d\x8f\x12\x16\x06\x8e\xe9\xf8\xcc\x00\xb5\x39\x72\x6c\x27\x64\xf0\x9a\xa1\xc7\x86\xe7\xeb\xb6\xe7\xc6\x5e\xe4\x86\xc4\xb4\x23\xcf\xa1\xa6\x1b\xf9\x20\xe4\x41\xe1\x76\x82\x98\xf9\x41\x68\xe8\x4e\xe4\x82\xad\xe5\x81\x56\x67\x50\x27\x32\x22\xcf\x8e\x0d\x3b\xa2\x81\x59\x5f\x3d\x37\x6d\xb5\xff\x18\xc4\xb7\xbd\x3f\x63\x30\xae\x78\x6e\xb7\x69\xbe\x07\xf5\xa7\xf3\xfd\xf1\xc8\xce\x2d\xef\xdf\x98\x35\x74\x2a\xb7\x43\x17\x32\xdc\x21\xd8\xa6\xf4\x5f\x77\x10\x79\x57\xdc\x58\x8f\x4c\xdb\x76\x6e\xa0\xa8\xe7\x0e\xab\x0e\x1e\xc4\x43\xd7\x81\x43\x2a\x2e\xae\x5d\x4b\x33\x2c\xfd\x6c\x5f\x2a\x40\x3f\x4d\xd6\xf1\xff\x9a\xc6\x3b\xc7\xf7\xa9\x3d\x39\xb9\x39\x46\x09\x6c\x6e\xd7\x7a\x39\x3f\x6c\x17\x6c\x4a\x00\x76\x2e\x98\xb5\x3a\xa1\x84\x06\x81\x3d\xe4\x4a\xd0\xb3\xe1\x04\x9b\xa6\x67\xe8\xf0\x9d\xe1\x9b\x8e\xa9\xfb\xf8\xa7\x48\x0f\x7d\xdb\xb0\x3d\xb0\xa5\x03\xdb\x0a\x1c\x18\x2d\xf0\x2d\xb0\x9e\x75\x9d\xb9\x60\xc2\x79\xb6\x09\x1c\xc6\xf3\x58\x04\xf6\x4f\x00\x96\x74\x44\x74\xb0\x7c\x74\x66\x9b\x46\x6c\x01\xcf\xb1\x18\x35\x4d\xc3\x32\x6d\x06\x84\x0e\x16\x2c\xb5\x6c\xd7\x0d\x2d\x33\x34\x60\xf8\x08\x14\x66\x03\x26\x0d\x42\x78\x25\x36\xa8\x1d\x59\x9e\x6e\xe9\x0e\x18\xe7\x94\x9a\x1e\x89\x03\x38\x24\x26\xa8\xd9\xba\x8a\xe6\x4d\x4e\xf2\x84\xee\x7b\x40\xf7\xae\x53\x31\xf8\x44\x7c\x7b\xcd\xfa\x83\xf1\x86\xc7\xc0\x6c\xf1\x32\xc5\x43\x58\x5b\x71\x42\xf5\x90\xbd\x5a\x44\x62\x99\xb8\xeb\x7b\x2e\x2d\xff\x17\x27\x8b\xaa\xe1\x09\xa0\xc5\x11\xa1\x0b\x1d\x1c\xbb\x65\xc3\x51\xe6\x19\xb1\x49\x1d\xdf\x27\xc4\x27\x06\x23\xba\x0e\x92\xd6\x32\x4c\x10\xa9\x81\x0b\xcc\xd7\x36\x6d\x20\x35\x2b\xc0\xeb\x83\x18\x88\x86\xf9\x06\x73\x9d\x98\x50\xc7\x24\xb1\x3f\xda\xe4\x3b\xed\xe4\x42\xe0\xb7\xf2\x2b\xbb\x29\x40\x64\xdc\x8d\x25\x80\x6a\xf3\x39\xab\x2f\xb8\x42\xc9\x4d\xe4\xe2\xec\x54\xf2\xab\xf6\x1b\x1c\x05\x9a\x74\x58\xef\x81\x6e\xbc\x43\x41\x98\x0a\xa3\x41\xab\x0d\x8c\x5e\x70\x3a\xdc\x07\x82\xf1\x0a\xbf\x5e\xdf\x6e\x9e\xc2\x87\xbe\xc3\x84\x41\x93\x90\xdc\x1d\x4e\x2a\xca\x4d\x02\xaa\x40\xbc\x11\x30\xb7\x02\x61\xe0\x93\x51\x0d\x8e\x7a\x8c\xcc\x69\x76\x88\xc3\x27\x02\xda\x76\xf9\x51\x4d\xb0\x6b\xe2\x28\x8c\x40\x9d\xb7\xdb\x5e\x1e\x71\x33\x72\x1a\x40\x7a\x6f\x59\x1c\xcf\x05\x73\x21\x88\xd1\xa7\xb1\x09\xc2\x35\x10\x47\x17\x29\xec\x89\x1e\xc6\x34\x0e\x90\x38\x44\x4d\x0c\x96\x8a\xdd\x0d\x29\xea\x71\x77\x07\x12\xd7\xea\xf2\xba\x5c\xad\xcb\xc3\x58\xf4\xee\x20\xb2\x4a\xd6\xbc\xde\x96\x5c\x03\x02\xb8\x7a\x8a\xe5\xd4\x86\xfa\x22\xbb\x03\xaa\xac\x65\x5a\x9d\x51\x90\xc8\x8a\x56\x59\x2e\x62\xf6\x45\x06\x03\x77\x9c\xf0\xb6\xca\x1d\xa3\x75\xb9\x37\x5b\xa9\x7e\xfb\x8c\x6e\xf9\x9b\xd2\x3a\x66\x68\x6c\xd8\x41\x15\x70\x3a\xab\x0f\x6c\xb4\xd1\xb8\x57\x00\xb6\xb3\x94\xc7\xe8\x3e\x6a\x3a\xb0\xa6\xbd\x01\xeb\xf6\x2d\xe9\x57\x51\x0f\x72\x0c\x6f\xb0\xf1\x1e\xb7\xf0\x91\xde\xde\x96\x87\x3c\x22\x0a\x5d\x9c\xde\xf7\x25\x2f\xa6\xd1\xf3\xc5\x13\x61\xb8\xab\x4b\x55\xb9\x2b\x97\xe0\x68\x6c\x61\x56\x2d\x7a\xcd\xb6\xdd\x7a\xb8\xa4\xf1\x02\x45\x7c\x55\xcb\x95\xe7\xcb\x62\x3e\x15\x5a\x4c\xa5\x5d\x56\x67\x69\x63\x9b\xb9\x48\x61\x7a\x08\xba\x38\xf1\x5c\xbb\xc3\x31\xcf\x59\xaa\xeb\x3a\xb6\xe5\xfa\xae\xe1\x06\x2e\x33\x75\xc7\x86\x3f\xc7\x9e\xa9\x50\xd5\x07\x56\x60\x82\x55\x0f\x5d\x1d\xb2\xf1\xdc\x41\xc0\x79\x26\xff\x7c\x97\xd4\xd1\x2d\xc7\x71\x89\x67\x45\x60\x71\x58\x3e\x28\xc5\x66\x1c\xa1\xf6\xa2\xc7\x51\x40\x6d\x97\x50\xdd\xb0\xfd\x58\xf7\x18\x18\x11\x86\xc7\x0c\xc3\x0b\xa9\x01\x9a\x43\x40\x03\xdb\x0f\x95\x78\x96\x6d\xae\x72\x12\x57\xf2\x06\x0f\xe9\xe4\x1e\x27\x99\x68\x9b\x57\x9c\x3c\x82\xa0\xea\x2c\xaf\xd1\x35\xee\x5c\xc7\xa9\xd8\xa9\x2e\x8d\x91\xbf\x3b\x04\xe8\xf5\xf2\xdb\x3c\xcf\xc6\xc5\xc3\x57\x11\x61\xa4\x8c\xae\x86\x30\xc0\x2f\x78\xa1\xf0\xc4\xb0\x86\x33\xac\x8e\x6d\x79\x89\xb7\xaf\x87\x59\x2b\x03\x59\xe0\x18\x36\x58\x13\x58\x9b\x17\x6e\xd3\xce\x06\xdd\xf4\xd2\x4c\x3d\x9c\x9c\x44\xb6\xc6\x5a\xb5\xae\xea\xbb\xa8\x58\xa4\x0e\x0f\x21\xb0\x8e\x7b\xa4\x5e\xad\x50\x8c\x8c\xb7\x74\x4b\x5c\x31\x10\x9c\x28\xfc\xac\xa9\xa9\xca\x8b\xa1\x91\x63\x4a\x20\xcf\xb0\xe9\x45\xe8\x18\xb7\x02\x70\x56\x9e\x2c\x2c\x64\x44\x7f\x6a\x2e\xf6\x09\x41\x81\x52\x30\x25\xc9\x1d\x35\xd8\xbb\x6c\xad\xa5\x0c\xb3\x5d\x39\x6e\xf9\x7a\x0a\x5e\x12\x92\xa7\xd4\x4e\x35\x36\x9d\x4f\xb5\x7a\x9c\xd9\xac\x29\x11\xf9\x9b\x02\xd9\x24\x13\x9b\x32\x79\xd5\x7a\x8c\x3f\x70\x84\xc1\x73\xfd\xbc\xfd\x03\x5f\xca\x04\x97\x0e\x7f\x53\x7e\xfa\xd7\xd9\xf6\x9f\xd4\x69\xb9\xaf\x29\xcc\xae\xb1\x22\x6c\x5c\x57\x46\x59\x89\x48\x2e\xb1\x39\x85\xa6\x37\x75\x63\xf9\x2f\x22\x96\xb2\x80\xc9\xa6\x6d\x9c\x48\xb8\xb5\x19\xaa\xd9\xb3\x0a\x23\x34\x4b\x9f\x95\x02\x2f\x80\x60\x0a\xd4\x08\x83\xc1\x40\xbc\x27\xa9\x42\x8a\x1f\x9a\x5a\x12\xdd\x84\x88\x57\xb9\x43\xf8\x75\xba\x5e\xb6\x79\xe9\xcb\xad\x20\x17\x7e\xe2\x93\x25\x3b\xeb\xa2\x9f\xcd\x97\x7b\x48\x88\xb2\x38\x49\xa5\x33\xae\xaa\x49\x2b\x8a\xaf\x72\x94\xcd\xca\x6c\x36\x6d\x57\x0d\x95\x95\x6a\x85\x0d\xa8\x86\xfa\x9e\xcb\xd2\xb5\xad\x9f\xea\x48\xcb\xba\xbc\xa8\x52\xcf\x76\xda\x40\x8f\x53\x9e\xc6\x2f\xa1\x9f\xf5\x06\xa4\x1c\x32\xa4\xc1\x3d\xc2\x67\xfd\x87\x4a\xc5\x24\x2f\x04\x82\x0b\x95\x5d\xf7\x92\x54\x1c\x9d\xfd\x27\x87\x7f\xb9\x7d\x6e\x70\x6b\xe0\xe9\x44\xc4\x01\x6c\x9c\x1d\xc4\x1d\x3f\x3a\x1b\xcf\xcb\x6c\xf2\x6a\xb3\x58\xc0\xbe\xf3\x54\x9d\xa2\x4c\x59\x07\x4f\x12\x12\xdb\x09\xc7\xb3\x8a\x4f\xe0\x23\x2b\x2b\x12\x47\x46\x29\x09\xc1\xaf\xec\x31\x94\x87\x8f\x22\xf7\xfa\x13\x3a\x66\x3f\xb2\x52\xb4\xfb\xeb\x8f\x26\xc2\x3a\x8d\x7b\x8f\x8b\xa8\xaa\x38\xec\x35\x73\xd8\x6b\xd6\xb0\xd7\xec\x3d\xaf\xed\xa0\x13\x82\xc2\x41\x98\x87\xe8\xa3\xd6\xfe\x91\xf1\x3e\x62\x3c\x1b\x7c\x06\xc8\x9b\x69\x88\x0b\x52\x66\xf9\xb4\x42\xaa\x7c\x13\xab\x11\x24\xf3\x34\xcb\x47\x70\x62\x81\xc5\x89\x10\xed\x34\x36\x1d\x93\x50\x23\x64\x66\xe4\x07\xa1\x1b\x44\x66\xa8\xbb\x7e\x1c\x59\x9e\x4f\x09\x09\x1c\x33\x24\x5e\x6c\xb8\x16\x98\x0c\x86\x81\x71\xb9\x8e\x43\x6c\x1a\x3b\xa6\x15\x5a\x2c\x6e\xd1\x9d\x18\xd9\x98\x6c\xb8\x24\xba\xa9\x4a\x48\xc7\x42\x1a\x15\xb2\x7b\xd5\x4c\xc0\x36\xd3\xd8\x3f\xd7\xa0\xd9\x6a\xb3\xe3\x21\xac\x79\xd5\x96\xca\x24\xa9\xe9\x24\x68\x50\x6e\x4f\xd4\xde\x95\xfd\x97\x5d\x8a\x68\xd8\xa7\xe9\x28\xd2\xa4\x51\xbf\xb2\xd5\x56\x48\xe2\xfe\x31\xa4\x72\xb4\x71\x2f\xf2\x91\xdd\x83\x61\xd7\x3e\xd8\x55\x3a\xbb\x50\x6a\x87\x9d\xf7\xe1\xf9\x33\xaa\xc5\xcb\x1c\xb0\x6b\x3d\x87\x84\xcc\x0d\x9c\xc8\x8b\x5d\x8f\xf8\xc4\xb4\xf0\xb2\xcd\x22\xbe\xe3\x86\x7a\x68\x47\x9e\xa1\x78\x81\x07\xdf\x69\x1c\x37\xcd\x98\x2b\x8a\xc3\x2e\xbb\x5a\xb7\x38\x8f\x8d\x12\x49\x4d\x1a\xa7\xa7\xc5\x4d\xb2\x53\x4f\xec\x1b\x59\xc0\xf3\x1e\xee\x3d\xf7\xd6\x36\xfe\x5a\x45\x5a\x5d\x14\xb5\xd1\x78\xc0\x0c\x11\x48\x98\x6a\xaf\x31\x9a\x37\x61\x0b\x2a\x24\xd8\x00\x79\xc7\xdf\x3e\x48\xdc\xc9\x2d\x98\x8c\x3b\xb3\xe7\xf7\x26\x31\xc7\xc9\x45\x26\x5b\x21\x84\x77\x28\x0c\x87\x82\x2f\x34\x75\x81\xcf\x2f\x29\x52\xab\x53\x72\x18\x7b\xbc\x57\x81\xfc\x18\x18\x60\x75\x68\x3e\x76\xb9\x26\x4e\xe1\x65\xad\x38\x9d\x02\x78\xbe\x21\xf8\xfa\x5c\x1b\x55\xe9\x2c\x59\x72\xb4\xbf\x3d\xc4\x08\x4b\x16\xbe\xdc\x78\x82\x50\xb4\x85\xd9\x10\x26\xfd\xa4\x2f\x9c\x40\x5f\xf8\xef\x7e\x50\x36\x09\xee\x11\x9d\x95\xba\xa7\x78\x6f\x6a\x37\xd6\x24\x1c\x43\x4f\xdc\x17\x78\x71\x6d\x4c\xf5\xa9\xfe\xd2\x75\x7d\x3d\x0c\xfc\x97\x94\x5d\x5f\x2c\x92\x74\x7d\x7b\x31\xcf\x8c\xa9\xa1\x4f\x2d\xa5\x82\x01\x56\x29\x3d\xb4\x1a\x95\xee\x03\x7d\x02\x27\xb7\x23\x1a\x1b\x51\xe4\x98\x14\x4e\x46\xe0\xe9\x76\x6c\x47\x86\x1f\xeb\xa6\xce\x8c\xd0\xc6\x7a\x4d\xb1\x0d\xa7\x87\x1a\x8c\xd9\xb1\x11\x13\x27\x8e\x03\x7b\x72\x60\x0a\x66\x0d\x83\xeb\xdb\x81\xd7\x38\x00\x01\x9f\x23\xd7\xe0\x00\x78\xa6\x49\x1c\xdd\x61\x0c\x73\xc5\x6d\xcb\x32\x40\x6e\x91\x28\xa6\x3e\x06\xb6\x7b\x84\x3a\x7e\x6c\xbb\x16\xd1\x63\x12\x06\x84\xc4\xb1\x19\x19\xcc\x0e\x4d\x66\x52\xf8\x90\xc1\x21\x8d\x0c\x3b\xa6\x04\x33\xa1\x09\xf5\xec\x90\x5a\xb1\xab\x3b\x81\xed\xda\x36\x21\x96\x13\x39\xbe\x1f\x07\x11\x71\x43\x66\x59\xb6\x01\xf2\x91\x19\x3e\x1c\x71\xdb\xb0\x80\x97\x34\x18\x48\x19\x0f\x79\x18\x05\xbd\x61\xfa\x53\x63\x6a\x05\x53\xc3\xd4\x5f\x19\x86\x69\x29\xb7\x7f\x89\xe8\x38\x7d\xc4\xf5\x14\x5d\x0f\xcf\x96\x69\x2e\xc9\xfc\x2a\x16\xfd\x5d\xde\x19\x48\x0a\xc7\x77\x4c\x48\x7a\xf5\xf9\x64\xe0\x17\xad\x39\x27\xbb\x14\x9f\x84\x9e\x38\x06\xb0\x4e\xb8\xd2\x8c\xed\xbc\x27\xa5\x9a\x90\x51\x8d\xd3\x99\x96\xa4\x59\xdb\x99\x40\xda\xcf\xbf\x74\x67\xed\x68\xb0\xfb\xad\xeb\xb7\x8d\x0b\x4a\x19\xcd\x7e\x58\xf4\xa5\x48\x06\xe1\xaa\xdd\x06\x26\x26\x1d\x39\x2f\x6d\x07\x12\x8f\x4a\xd7\x0c\x5f\xdf\x19\xe3\x51\x55\x79\x51\x11\x13\xd9\x8e\x1f\xd8\x41\xe0\x3b\xc4\xa5\xbe\x1b\x7a\x86\x15\xb8\x81\x1e\xfa\xbe\x61\x50\x6a\x85\x70\x9e\xbc\x48\x37\x29\x30\x16\x23\x02\xe6\x1c\x7a\xd4\x02\x69\xdc\x0a\xe1\x57\x6b\xb1\x68\xc6\xe6\x0f\x4d\x5d\x14\xcd\x00\xbd\xd3\xc0\x0a\x85\x46\x1d\xf2\xfc\x2e\x17\x59\x2b\xef\xf2\xbf\xa5\xc5\x46\xfe\xca\x28\x9a\xe5\x14\x38\x94\x5c\xab\x4c\x99\xc9\x41\x39\x1a\x5b\x74\x8d\x11\xd9\x5f\x7d\x7c\xfa\xe5\x5b\xb1\x57\xc0\x15\xd5\x6a\x70\x5b\x9b\x74\x3f\xd9\x2b\x07\xa5\x23\x6d\x80\xda\x33\xc1\xfd\xb1\x2a\x3e\x62\x55\x02\xbf\xf7\xb2\x75\xe3\x9d\xc1\x91\x85\x6d\x95\x2a\x49\x29\x16\xa9\x65\x45\xab\x5e\xa9\x6c\xaf\x20\xba\x25\x60\xc8\x05\xcf\xb5\xe3\xd1\x50\x21\x8b\x78\x7e\x27\x28\x74\xd1\x95\xbc\x01\x13\x7a\x12\x4c\xdb\x9f\xf8\x31\x28\x28\x54\xd5\x5c\x02\x4c\xfd\x77\x4c\x97\x78\x2e\x61\x8e\xab\x9b\xb6\x1d\xc3\x91\xf1\x75\x27\x8a\x80\xe2\x03\xcf\x33\x6d\x37\x0a\x81\xe2\xcd\x10\xf4\x15\x66\x86\x1e\x31\x75\x9b\xd9\xb6\x03\xc4\xcf\x5a\x5a\xe6\x01\x26\xcc\x01\x45\x26\x87\x27\xd1\xf6\x15\xb1\x3b\x79\x36\xec\xb8\x2c\xd6\xed\x81\x37\x12\x48\xd7\x6a\x1e\x79\x67\xd8\xb0\x71\xf2\x1c\xd6\xee\xd4\xd3\x83\x42\x4b\xb2\x6b\x96\x63\xc9\x8f\x3a\xb2\x04\xf3\x35\xc3\xae\xb2\xea\xaa\x0a\xda\xb5\x3d\xc7\xc6\xd3\xec\x88\x5a\x3a\x24\xc9\xd3\x98\x1c\x94\xe6\xda\x1d\xf0\x8a\xb7\xc9\xa0\xe7\x9e\x32\x88\xab\x5d\x0f\x08\xb9\x8d\x28\x65\x82\x8d\x90\x60\x1f\x08\xa5\xe2\xda\x7f\x05\x6c\xa4\xbb\x5a\x90\xe9\x5a\xe2\x7e\xb8\x09\xcb\x88\x19\x8e\x78\xc2\x7a\x30\xc0\xdc\xf0\x5a\x75\xc1\x62\x20\x06\x06\x1f\xb1\x0a\x48\xe0\x7f\x37\xa2\xb8\x79\xb6\x2e\x3b\x73\xe9\x5b\x61\x67\xa4\xc8\x46\x57\x28\x6b\x73\xe6\x9b\xab\x3b\x65\xee\x34\x2b\x65\x70\x15\x09\x17\xec\x5c\xf8\x69\x67\x62\xa7\x59\x1a\xdd\xf1\x17\x62\xb4\x25\x66\x55\x97\x5b\xbc\x63\xc6\x90\x99\x78\x8d\x59\xb2\xb3\x0d\x4b\x1a\x7e\x29\xd6\x75\x22\x78\x4f\x88\x7d\xc7\x58\x93\x86\xe3\xbf\x69\x97\x3e\xef\x62\xfb\x08\x22\xac\x7a\x1b\x1b\x5b\xc2\x19\x88\x80\x77\xc2\x21\x8b\xf7\x3b\xc4\xf4\xae\x50\xe7\xce\x30\xe7\x3e\x15\x4d\x48\xab\xe6\xcc\xfc\x73\xcd\xd6\x5d\xe4\xfe\x10\x60\xac\x91\x8d\x6e\x88\x75\x71\x10\xae\x87\x45\x41\xed\xc2\x42\x9f\x29\xd9\x80\x77\x99\x16\x2b\x40\xd6\x97\xa5\x85\x8e\xda\xb7\xfb\x3f\xdd\xcb\x0e\xdb\x01\x1e\x23\x54\x81\xcd\x79\x4e\xa1\xc5\x8c\x8a\x37\x14\x91\x28\xda\x4f\x9f\x3e\x68\xff\x4b\x88\x15\x2e\xe7\xfe\xf3\xff\x6b\xaa\x08\xd3\x6e\x58\x72\x8f\xc4\x7f\x8a\x4d\x51\xeb\xb4\xf7\xeb\x95\xe5\xed\x25\x30\xc1\xdb\xd1\x8a\x4c\x82\x5f\xc9\x66\x7c\x58\xf1\x6f\x97\xf0\x3f\x69\x38\xf1\xe1\x99\x14\xad\xc6\xc8\xbd\xa6\xc1\x6a\xac\xe4\x41\xf7\x65\x95\x43\xde\xb4\x85\x07\x41\xf2\x7a\x5d\x66\x61\x42\x67\x3c\xd0\x48\xe4\x40\x81\xac\x16\x0f\xf1\xfa\x6c\x8e\xba\x14\x5a\x08\xe7\x75\x87\x35\x18\x89\xdf\x91\x71\x3b\x22\xa1\xe8\xb1\x15\x75\x3c\xaa\x0e\xf0\x32\x42\x10\x47\x90\x39\x1c\xf0\xfb\x66\x0d\xe4\x1a\x7b\x55\x2f\xf9\x46\xc7\xe6\x5d\x3c\x0e\xa8\x3e\x2b\x7a\xcd\x37\x45\x0c\x9a\x96\xe8\x3c\xc3\xa3\x06\x50\x06\x79\x36\xe8\xbc\x49\xc7\x47\x1b\xcb\xd9\xf8\xb7\x43\x27\xaa\x7f\x19\x1f\xda\x5c\x23\xb7\xd5\xdc\xea\xc0\xcc\x1a\x09\x3b\xff\x96\xb7\x21\xda\xd8\x2e\x4c\x8c\x93\x24\x20\x97\x30\x74\x85\xdc\x81\xfc\xef\x59\xc9\xf6\xdf\xf9\xcb\x19\xf6\x1f\xea\xb5\x70\xa1\x0e\x39\x94\x39\x5b\x02\xbd\xd2\xa3\x5a\x79\x54\x1b\xab\x2e\x33\x29\xaa\xa1\xf1\x4c\xf0\x0c\x92\xd5\x09\x0e\xfc\x77\x8c\xfd\x90\x60\xc1\xf0\xde\x40\x93\x6c\x41\xab\xab\x81\xd1\x1c\xb0\xe9\x87\xc4\x81\xe6\x23\x55\x25\x5d\xd3\x26\x32\x71\x47\xac\xa6\xd2\x5b\xa1\x60\x00\xec\x7b\x96\x7f\x4f\xc6\x16\x73\xc3\x6f\xb5\x98\x71\xf6\xc3\xfb\xfe\xf0\xe9\xcf\x81\x90\x64\x6a\x81\xc4\xa8\xfa\x5e\xe3\xa2\x48\xd9\x2d\x0f\x74\x4d\xd9\x0d\x80\x7e\x50\xc2\x79\xbd\xa2\x9f\xdb\x46\xde\xf9\x86\xd1\xf7\xcb\x66\x82\xcb\x07\xb4\xcb\x8f\x48\x7d\x17\xc8\xef\x84\x44\x9f\x3a\xbf\xec\x4d\x63\xed\x45\x2b\xd8\xb5\x59\x9e\x94\x77\x88\x32\xde\x06\x4a\x36\xba\x03\x84\x02\x05\x45\xc8\x03\x17\xa2\x5b\x54\x83\xf4\x21\x40\x0f\x54\x6b\xf7\xe2\xfa\xe7\x09\xc7\xb0\x11\xb8\xba\xe7\xdb\x96\x61\x4d\x7e\xf9\x45\x50\xfd\xf7\xd2\xaa\x7f\x97\x93\x68\xc1\xfa\x7b\x18\xf5\x92\x5d\xef\x85\x52\x97\x35\x5f\xe1\xec\xb0\x31\x95\xa5\x1c\xed\xa1\xa8\x89\x7d\xb5\x58\x17\xad\xbd\xdc\xb1\x9c\xae\xc9\x47\xd8\x02\xdd\xfe\x9d\xc6\xde\xc4\x12\xa7\xc8\x11\x76\x1a\xe7\x7a\x15\x1a\x92\x44\xe5\xfe\xbc\xbc\x9d\x7c\x78\x30\x64\x38\x0f\xfa\x0b\x6e\x77\x78\x9f\xaa\x2b\xf8\xba\x65\xe6\x09\x2e\x6f\x7b\x6c\xf5\x5a\x7f\xe2\x33\x4e\xb5\x6f\x97\x2b\xd8\x2e\xfe\x54\x09\xd4\xae\x02\xf3\x61\xe4\x75\x54\x62\xb5\xfd\x39\xcb\xab\x6f\xda\xc1\xff\x98\xac\x24\x33\x03\xb0\x91\x15\xd6\x47\x9d\xf1\x40\xad\x54\x16\xaf\x13\x0d\x51\x41\xee\xa2\x60\x12\x01\x5f\xff\x8f\x5c\x93\x8f\xa2\x31\x5a\xab\x5f\x6a\x3d\xa8\x8d\xe5\x3f\xb8\x7b\x20\x99\xe7\x64\x89\x7f\x62\xd7\x4b\x9a\x14\xf8\xa7\x34\xcb\x56\xf8\xdf\x6c\xc5\xb1\x8c\x7f\x04\x04\xf0\xf7\x04\x1c\xeb\x54\xfc\xad\x0d\xa9\x32\x29\xd6\x89\xe1\xba\x81\x16\xad\x41\x6a\x2d\x25\x14\x3c\x2b\x7b\x51\x64\x58\x77\x80\xad\xca\xa6\xe7\x9a\xf8\xe7\x3b\x40\x4c\xbb\x9d\x9b\x74\x2b\x6a\xcf\xa5\x26\x7a\x0e\xba\x80\xc8\x7c\xe7\xdd\xe4\x64\xf5\x02\x64\x58\x2f\xce\xab\x95\x0a\x3c\x60\x52\x4a\xbb\x7e\x93\x50\x68\x55\x74\xe7\x6c\x95\xe5\x25\xff\x41\xf6\x80\xab\xa6\x47\x9c\x0b\xd5\x35\x29\x8b\x26\x63\x92\xcf\x2a\x02\xc7\xa6\x3b\x92\xfd\x94\x44\xf3\x38\x19\x62\xdc\xf6\x90\x92\x18\x83\x77\x26\x56\xb7\x7a\xda\x22\x04\x8e\xc9\x42\x9b\xfd\x36\xc1\xfe\x80\x3f\xc1\x2a\x26\xa2\x64\xc0\xbf\x66\x4d\x23\xc3\xd6\xb0\x21\x20\x08\x6b\x34\xf2\xd5\x60\x07\x56\xd9\xc7\x06\xe6\x59\x66\x14\xf5\xe1\xa6\xff\x5f\xb3\xcc\x92\xe4\x73\x56\x1e\x77\x36\x64\xc6\x0b\x9f\x61\x45\x4a\x51\x27\x97\x8f\xdb\xa4\x6c\x47\x9b\x54\xf1\x46\xd4\xcc\x5b\xdc\x01\x85\xa7\x8b\x3b\x25\xc1\xbf\x58\xaf\x70\x03\x31\x56\xf1\x3b\xe1\xd3\xeb\x48\x9b\xb9\x7c\x7b\xf1\x5c\x5a\x85\xbf\xc3\x7f\xe9\x8b\x0b\x31\x00\x7f\x32\xdb\xed\x85\xa7\x24\x0c\x6d\xea\xc6\x3a\x41\x23\xdf\x83\xff\x45\x54\x67\xba\x47\x8c\xd8\xd4\x43\xc7\x76\x69\xa8\x63\xc9\x4a\xdf\x0d\x28\x58\xef\xa1\x4e\xa9\x49\x0c\x97\x79\x4e\xe0\x84\x17\xfa\x85\xde\x6e\x7e\xa6\xb4\x71\xbd\x87\x30\xd8\xdf\x37\xed\xeb\x8d\x02\x1f\xbb\x4a\xf5\xda\xae\xe9\xe9\x16\x66\x13\x06\x0e\x0b\x3d\x23\x32\x2d\xdb\xd0\x1d\x9b\x12\xe2\x5a\x8e\xe7\x45\xba\x6b\xda\x6a\x6f\xaa\xcf\xec\x0e\x6c\xcf\xbc\xfc\xb2\xad\xda\x5a\x1d\xa9\x6e\xdb\x42\x65\x88\x12\xa5\xb8\xb5\x06\x93\xf1\x06\xf8\x0c\x6f\xb0\x6d\x1b\x0b\x65\xc7\x41\xe4\x99\x71\x64\x86\x81\xed\x06\xbe\xce\x62\xc7\xa0\x3e\x35\x75\x3f\x0c\x09\xb1\xa9\x15\xd3\x28\xd6\x23\xc7\xa3\xb6\x6f\x7b\x24\x22\x26\xdb\x41\x0e\xbd\x82\x08\xb4\xd9\xbf\xb0\xbb\x83\xdd\xf6\x45\xbb\xe7\x5e\x0f\x03\xea\xf4\x73\xfd\x5f\x5c\xb6\x65\x31\xdb\xb4\x60\x89\x51\x10\x5a\x1e\xd5\x6d\x3f\xa4\x78\x3f\x19\x52\x9b\x98\xbc\x38\xa2\x01\x18\x30\x4d\x1d\xbd\x53\x0e\x90\x5a\x64\xc6\xb6\xeb\xc3\x31\x89\x03\xf4\x6a\xb5\x13\x59\x5f\xf1\x76\x53\xf7\xd7\x38\xea\xf8\x91\x23\x09\x71\xdd\x5a\xb3\x6f\x83\x36\xb3\xe7\xf6\xf6\xea\xfc\x00\x9a\x4a\xa1\x74\x71\xde\xcc\x95\x3b\x60\x80\x8a\x4b\x9f\x3e\x9a\x5f\x0c\x2c\xda\x8b\xaa\x24\x39\x5a\x4f\x93\x19\xa1\xd2\x52\xe3\x36\x1a\xe6\x83\x9e\x63\x52\x37\xde\x36\x88\x1a\x2e\x28\x8c\x50\xa3\xc9\x40\x02\xe5\x75\x17\xb5\xe2\xac\xd9\x8e\x6a\xf1\xf7\xdc\x54\xe5\x9e\xfa\xa4\xdc\x53\xff\x93\x93\x14\xe5\x6f\xdd\xb2\x5b\x94\xe9\x71\x1c\x62\xc8\x6c\x18\xe9\x24\xd6\x01\x8a\x30\x32\x89\xef\x45\x36\x89\x6d\xdb\x09\xec\xd8\xa1\x51\x68\x44\x21\x40\x46\xa9\x6f\x62\x46\x3a\x31\x1c\x6c\x2d\xe0\xe8\xed\x96\x9a\xfb\x8e\xd0\x70\x61\xb7\xbb\x5c\xfd\x71\x55\xc3\xc6\x1c\x62\xb9\x28\x4e\x8d\x87\x9c\xe1\xee\xef\x65\x13\xe4\x91\x26\x3d\x9a\x13\x85\xda\xbb\x5a\x24\x7b\xc8\x3a\xee\x49\xbb\xe1\x3d\x68\x4a\x51\xce\x08\x1a\x49\xdc\xf2\xc0\x50\x11\x7a\xac\x7f\x44\xf6\xa7\x44\xc3\x5d\x8a\x1a\xc5\x2b\xb2\x53\xf8\x1c\xc9\x96\xa4\xf0\x6c\xb3\x25\x39\xd9\xa7\x7c\x9d\x62\xf4\xca\x81\xf5\xb5\x92\x58\xb0\x9e\x76\x33\xea\x0a\xbd\x68\x4c\xc8\xae\xd4\x2d\x0a\xe7\x9b\xd9\xd9\x51\xad\x6b\x23\x68\xc6\xaa\xdb\xdb\xa4\xf6\x50\x1d\xd7\x91\xf9\xa9\x41\xe9\xe8\x06\xa5\x47\xb6\x27\x6d\xeb\x71\xfb\x58\xdc\x67\x76\x77\x4a\xc1\x71\x22\xb5\xa9\x3b\xf3\xbf\xdf\xc4\xc8\xc9\x4d\x75\x0d\xa0\xdf\x22\x65\x23\x21\xab\x35\x37\x36\x7a\xb5\x18\x91\x3f\x39\xa2\x4d\xc5\xd8\xe9\x4c\x60\xfa\x51\x7b\x85\x6f\x85\x4d\xbf\x33\xa1\xa0\xb2\xf9\xe5\x34\x12\xf9\xed\x1a\x5f\x92\x1d\xdc\xcb\x8e\xb4\x11\xf4\xc0\xa1\xe5\xd8\x15\x85\x66\x18\x29\x9f\x5a\xdb\xdd\xb3\x6a\xf7\xd4\x4d\x6e\xe7\x2e\x1c\xd4\x4d\x0e\x3e\x1d\xc4\xf2\x2a\x40\xae\xd8\xed\x70\x97\x16\x1f\xbc\xca\xc2\xe5\x6a\x4b\x91\x94\x55\xbe\x2d\x89\x63\xc6\x1d\xd4\x52\xdb\x65\xc5\x3d\xf9\x48\x9e\xfe\x79\xdc\xff\x28\x4e\xb6\xd3\x31\xcf\x6d\x62\x6d\xee\x79\x79\xa7\xb2\x18\xb4\x66\x51\x80\x09\x25\x89\x4a\xc9\x9d\x2c\x16\x45\x40\x53\xbf\xea\x95\x5a\x71\xe2\x32\x7d\x4f\xca\x5a\x97\xe2\x17\x29\x1b\x31\x08\x09\x67\x43\xe5\xd5\x59\x7f\x22\x7f\xdb\x67\x89\x97\x95\x49\x0e\x82\x91\xeb\xe9\xf2\xa1\x30\x08\x94\xb0\x9f\xae\xd3\xdc\x6d\x34\x1e\x66\x30\x56\x49\x27\x97\xe9\xbf\xad\x59\x73\x09\x2f\x56\x09\xba\x8a\xb2\xc2\x7f\xe2\x0b\x67\x3d\xa1\x03\x39\xc3\x6e\xe0\xd7\x60\x17\x70\x2d\x47\xa9\xe7\x3b\xdd\x5a\xb3\x1a\x30\xd4\xbd\xe8\xca\xb8\x91\x05\xa9\x85\x97\xa4\x1b\x4c\xf9\xe3\x10\x58\x65\x23\x9a\x96\xec\x05\xfa\xb8\x7c\x3b\x55\xa2\xa3\xf9\x75\x4e\x21\x9a\xf1\x80\x86\x26\xe3\x3e\xa6\x43\xf6\x68\x03\xda\x6d\xca\xe9\x00\x76\x17\xe9\xfc\xde\x8e\xb9\xe7\x7d\x78\xf2\xba\x40\x16\xfc\xf1\x19\x82\xfc\x4c\xbd\x7e\xc3\xfe\x46\x1b\x31\xde\x87\xd2\x59\x53\x01\x0c\xa3\x0e\xf8\xc3\x1f\x18\xa1\x9d\x3b\x70\x05\x3f\x0c\xc1\xbe\xe8\x24\x84\x6f\x0b\x10\xf7\x23\x7d\x30\xce\xa5\xdd\xf2\x17\x76\xd7\xc6\x7a\x1f\x82\x91\x6d\x80\x21\xf3\x9c\xcb\x35\x78\xf2\x02\x6f\x6b\xf0\xe2\x09\xce\x6b\x65\xdb\xb6\x55\xcf\x4e\x64\x0a\x1c\xc0\x40\x07\x20\xf7\x24\xe6\xcf\x06\x02\x8a\xce\x3d\x6a\xe0\xeb\xdd\x22\x71\x9b\x28\x7c\x05\x5b\xb8\x01\xb5\xf8\x1a\x74\x74\xc0\xcc\x82\xe2\x31\xca\xd9\x8a\xfb\x5f\x0e\x38\xde\x6d\x8f\xc9\x96\xbf\xa4\x03\x67\x45\x79\xc7\x43\x0d\x81\xfb\xd5\x58\x5c\x2d\xc0\x76\x90\x9b\xa1\x54\xcf\xab\x39\x77\x07\x1e\xb6\x59\xf7\x4e\x5c\x74\x16\x94\xc7\x08\xc0\x44\xe9\x38\x51\x6c\x94\x66\x19\x83\x84\x83\x68\xc2\x76\x5c\xe6\x3a\x1e\x28\x82\x5e\xd0\x2e\x5f\x89\xa9\xe3\x9d\x6b\xe6\x49\xe5\x43\x56\xfc\xfb\xd9\xf8\x3c\xf4\x83\x17\xbc\x9d\xa7\xbe\x99\xa5\x2e\x73\xd4\x37\xf0\x43\xea\x12\x0f\xb7\x97\x6f\x87\x9f\x76\xd9\xc6\x6c\xab\xc7\x4b\xcf\x99\x4e\xe8\x61\xdb\x77\x7c\x52\x97\x0c\x38\x17\x67\xaf\x73\x4f\xe1\x60\x8e\xdb\x51\xa2\x15\xe4\xba\xee\x01\x0e\xd8\x10\xf1\xae\xc0\x71\x45\xf6\x1b\x9c\xfb\x75\x58\x7f\xd9\x62\xcd\xf0\xf2\xe1\x22\xf1\xbf\x00\x34\x0b\x68\x89\x76\x04\x01\x00")
 
 func meterYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -91,8 +91,8 @@ func meterYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "meter.yaml", size: 44043, mode: os.FileMode(0644), modTime: time.Unix(1703268828, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0x50, 0xd3, 0xff, 0xf9, 0x17, 0x92, 0x77, 0xc7, 0x94, 0x3d, 0x7c, 0xf6, 0x3d, 0x40, 0x55, 0x42, 0x70, 0x14, 0x3e, 0x62, 0x6a, 0x4f, 0x59, 0xf4, 0x2d, 0x31, 0xaa, 0x7d, 0x4a, 0x94, 0x84}}
+	info := bindataFileInfo{name: "meter.yaml", size: 66678, mode: os.FileMode(0644), modTime: time.Unix(1792197438, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x97, 0x6f, 0xe1, 0xd, 0x9a, 0x6, 0xd5, 0x14, 0x87, 0x34, 0x8f, 0x2d, 0xe3, 0x9d, 0xf6, 0x15, 0x15, 0x8b, 0x67, 0xfe, 0xd5, 0x19, 0x8, 0xaf, 0x70, 0x18, 0x70, 0x14, 0xcf, 0x35, 0xb3, 0x9f}}
 	return a, nil
 }
 
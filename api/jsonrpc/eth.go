@@ -0,0 +1,623 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	goruntime "runtime"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/meterio/meter-pov/api/accounts"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/builtin"
+	"github.com/meterio/meter-pov/logdb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxLogs limits the number of logs returned by eth_getLogs
+	maxLogs = 10000
+	// maxLogCriteria limits the address x topics combinations of eth_getLogs
+	maxLogCriteria = 1000
+)
+
+var errHeaderNotFound = errors.New("header not found")
+
+func (r *JSONRPC) clientVersion(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	return fmt.Sprintf("meter-pov/%s-%s/%s", goruntime.GOOS, goruntime.GOARCH, goruntime.Version()), nil
+}
+
+func (r *JSONRPC) sha3(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var data hexutil.Bytes
+	if err := parseParams(params, 1, &data); err != nil {
+		return nil, err
+	}
+	return hexutil.Bytes(crypto.Keccak256(data)), nil
+}
+
+func (r *JSONRPC) netVersion(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	return strconv.FormatUint(chainID(), 10), nil
+}
+
+func (r *JSONRPC) netListening(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	return true, nil
+}
+
+func (r *JSONRPC) chainId(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	return hexutil.Uint64(chainID()), nil
+}
+
+func (r *JSONRPC) blockNumber(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	return hexutil.Uint64(r.chain.BestBlock().Number()), nil
+}
+
+func (r *JSONRPC) gasPrice(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	baseGasPrice, err := r.baseGasPrice(r.chain.BestBlock().Header())
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(baseGasPrice), nil
+}
+
+// getBalance returns the MTR balance, which is the native token of evm.
+func (r *JSONRPC) getBalance(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		addr meter.Address
+		tag  json.RawMessage
+	)
+	if err := parseParams(params, 1, &addr, &tag); err != nil {
+		return nil, err
+	}
+	h, err := r.resolveBlock(tag)
+	if err != nil {
+		return nil, err
+	}
+	st, err := r.stateCreator.NewState(h.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	energy := st.GetEnergy(addr)
+	if err := st.Err(); err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(energy), nil
+}
+
+func (r *JSONRPC) getCode(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		addr meter.Address
+		tag  json.RawMessage
+	)
+	if err := parseParams(params, 1, &addr, &tag); err != nil {
+		return nil, err
+	}
+	h, err := r.resolveBlock(tag)
+	if err != nil {
+		return nil, err
+	}
+	st, err := r.stateCreator.NewState(h.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	code := st.GetCode(addr)
+	if err := st.Err(); err != nil {
+		return nil, err
+	}
+	return hexutil.Bytes(code), nil
+}
+
+func (r *JSONRPC) getStorageAt(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		addr meter.Address
+		slot string
+		tag  json.RawMessage
+	)
+	if err := parseParams(params, 2, &addr, &slot, &tag); err != nil {
+		return nil, err
+	}
+	key, err := parseStorageKey(slot)
+	if err != nil {
+		return nil, invalidParams("invalid storage key: %v", err)
+	}
+	h, err := r.resolveBlock(tag)
+	if err != nil {
+		return nil, err
+	}
+	st, err := r.stateCreator.NewState(h.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	value := st.GetStorage(addr, key)
+	if err := st.Err(); err != nil {
+		return nil, err
+	}
+	return hexutil.Bytes(value.Bytes()), nil
+}
+
+func (r *JSONRPC) call(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		args CallArgs
+		tag  json.RawMessage
+	)
+	if err := parseParams(params, 1, &args, &tag); err != nil {
+		return nil, err
+	}
+	h, err := r.resolveBlock(tag)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.doCall(ctx, &args, h, 0)
+	if err != nil {
+		return nil, err
+	}
+	data, err := hexutil.Decode(result.Data)
+	if err != nil {
+		return nil, err
+	}
+	if result.Reverted {
+		return nil, revertError(result, data)
+	}
+	return hexutil.Bytes(data), nil
+}
+
+// estimateGas finds the lowest gas limit that lets the call succeed, the way go-ethereum does:
+// first try the gas used by an unbounded call, and binary search up to the cap only if it falls short.
+func (r *JSONRPC) estimateGas(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		args CallArgs
+		tag  json.RawMessage
+	)
+	if err := parseParams(params, 1, &args, &tag); err != nil {
+		return nil, err
+	}
+	h, err := r.resolveBlock(tag)
+	if err != nil {
+		return nil, err
+	}
+	clause := tx.NewClause(args.To).WithData(args.data())
+	isFork11 := meter.IsTeslaFork11(h.Number())
+	intrinsicGas, err := tx.IntrinsicGas(isFork11, isFork11, clause)
+	if err != nil {
+		return nil, err
+	}
+
+	gasCap := r.callGasLimit
+	if args.Gas != nil && uint64(*args.Gas) > intrinsicGas && uint64(*args.Gas)-intrinsicGas < gasCap {
+		gasCap = uint64(*args.Gas) - intrinsicGas
+	}
+	result, err := r.doCall(ctx, &args, h, gasCap)
+	if err != nil {
+		return nil, err
+	}
+	if result.Reverted {
+		data, _ := hexutil.Decode(result.Data)
+		return nil, revertError(result, data)
+	}
+
+	if result.GasUsed == 0 {
+		return hexutil.Uint64(intrinsicGas), nil
+	}
+
+	// the gas used may fall short as a gas limit, because of the 63/64 rule and refunds
+	lo, hi := result.GasUsed, result.GasUsed
+	for {
+		res, err := r.doCall(ctx, &args, h, hi)
+		if err != nil {
+			return nil, err
+		}
+		if !res.Reverted {
+			break
+		}
+		lo = hi
+		if hi >= gasCap {
+			return nil, fmt.Errorf("gas required exceeds allowance (%d)", gasCap+intrinsicGas)
+		}
+		hi *= 2
+		if hi > gasCap {
+			hi = gasCap
+		}
+	}
+	for lo+1 < hi {
+		mid := (lo + hi) / 2
+		res, err := r.doCall(ctx, &args, h, mid)
+		if err != nil {
+			return nil, err
+		}
+		if res.Reverted {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hexutil.Uint64(hi + intrinsicGas), nil
+}
+
+// doCall executes args against the state of header with the given gas.
+func (r *JSONRPC) doCall(ctx context.Context, args *CallArgs, header *block.Header, gas uint64) (*accounts.CallResult, error) {
+	callData := &accounts.CallData{
+		Data:   hexutil.Encode(args.data()),
+		Gas:    gas,
+		Caller: args.From,
+		Token:  meter.MTR,
+	}
+	if args.Value != nil {
+		callData.Value = (*math.HexOrDecimal256)(args.Value)
+	}
+	if args.GasPrice != nil {
+		callData.GasPrice = (*math.HexOrDecimal256)(args.GasPrice)
+	}
+	return r.accounts.CallContract(ctx, args.To, callData, header)
+}
+
+func (r *JSONRPC) sendRawTransaction(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var raw string
+	if err := parseParams(params, 1, &raw); err != nil {
+		return nil, err
+	}
+	t, err := r.transactions.SendEthRawTransaction(raw)
+	if err != nil {
+		return nil, err
+	}
+	return t.ID().String(), nil
+}
+
+func (r *JSONRPC) getTransactionByHash(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var txID meter.Bytes32
+	if err := parseParams(params, 1, &txID); err != nil {
+		return nil, err
+	}
+	t, meta, err := r.chain.GetTrunkTransaction(txID)
+	if err != nil {
+		if !r.chain.IsNotFound(err) {
+			return nil, err
+		}
+		if pending := r.txPool.Get(txID); pending != nil {
+			baseGasPrice, err := r.baseGasPrice(r.chain.BestBlock().Header())
+			if err != nil {
+				return nil, err
+			}
+			return convertTransaction(pending, nil, 0, baseGasPrice), nil
+		}
+		return nil, nil
+	}
+	h, err := r.chain.GetBlockHeader(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	baseGasPrice, err := r.baseGasPrice(h)
+	if err != nil {
+		return nil, err
+	}
+	return convertTransaction(t, h, meta.Index, baseGasPrice), nil
+}
+
+func (r *JSONRPC) getTransactionReceipt(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var txID meter.Bytes32
+	if err := parseParams(params, 1, &txID); err != nil {
+		return nil, err
+	}
+	meta, err := r.chain.GetTrunkTransactionMeta(txID)
+	if err != nil {
+		if r.chain.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	blk, err := r.chain.GetBlock(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := r.chain.GetBlockReceipts(meta.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	if meta.Index >= uint64(len(receipts)) || meta.Index >= uint64(len(blk.Txs)) {
+		return nil, errors.New("receipt index out of range")
+	}
+	baseGasPrice, err := r.baseGasPrice(blk.Header())
+	if err != nil {
+		return nil, err
+	}
+	var cumulativeGasUsed, logIndex uint64
+	for _, receipt := range receipts[:meta.Index] {
+		cumulativeGasUsed += receipt.GasUsed
+		for _, output := range receipt.Outputs {
+			logIndex += uint64(len(output.Events))
+		}
+	}
+	return convertReceipt(receipts[meta.Index], blk.Txs[meta.Index], blk.Header(), meta.Index, cumulativeGasUsed, logIndex, baseGasPrice), nil
+}
+
+func (r *JSONRPC) getBlockByNumber(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		tag    json.RawMessage
+		fullTx bool
+	)
+	if err := parseParams(params, 1, &tag, &fullTx); err != nil {
+		return nil, err
+	}
+	h, err := r.resolveBlock(tag)
+	if err != nil {
+		if err == errHeaderNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.getBlock(h.ID(), fullTx)
+}
+
+func (r *JSONRPC) getBlockByHash(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var (
+		blockID meter.Bytes32
+		fullTx  bool
+	)
+	if err := parseParams(params, 1, &blockID, &fullTx); err != nil {
+		return nil, err
+	}
+	h, err := r.headerByID(blockID)
+	if err != nil {
+		if err == errHeaderNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.getBlock(h.ID(), fullTx)
+}
+
+func (r *JSONRPC) getBlock(blockID meter.Bytes32, fullTx bool) (*RPCBlock, error) {
+	blk, err := r.chain.GetBlock(blockID)
+	if err != nil {
+		return nil, err
+	}
+	var receipts tx.Receipts
+	if blk.Number() > 0 {
+		receipts, err = r.chain.GetBlockReceipts(blockID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	baseGasPrice, err := r.baseGasPrice(blk.Header())
+	if err != nil {
+		return nil, err
+	}
+	return convertBlock(blk, receipts, baseGasPrice, fullTx), nil
+}
+
+func (r *JSONRPC) getLogs(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var query FilterQuery
+	if err := parseParams(params, 1, &query); err != nil {
+		return nil, err
+	}
+	if len(query.Topics) > 4 {
+		return nil, invalidParams("too many topics, want at most 4")
+	}
+
+	var from, to uint32
+	if query.BlockHash != nil {
+		if query.FromBlock != nil || query.ToBlock != nil {
+			return nil, invalidParams("cannot specify both blockHash and fromBlock/toBlock")
+		}
+		h, err := r.headerByID(*query.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		from, to = h.Number(), h.Number()
+	} else {
+		var fromTag, toTag json.RawMessage
+		if query.FromBlock != nil {
+			fromTag = *query.FromBlock
+		}
+		if query.ToBlock != nil {
+			toTag = *query.ToBlock
+		}
+		fromHeader, err := r.resolveBlock(fromTag)
+		if err != nil {
+			return nil, err
+		}
+		toHeader, err := r.resolveBlock(toTag)
+		if err != nil {
+			return nil, err
+		}
+		from, to = fromHeader.Number(), toHeader.Number()
+		if from > to {
+			return nil, invalidParams("invalid block range")
+		}
+	}
+
+	criteriaSet, err := buildEventCriteriaSet(query.Addresses, query.Topics)
+	if err != nil {
+		return nil, err
+	}
+	events, err := r.logDB.FilterEvents(ctx, &logdb.EventFilter{
+		CriteriaSet: criteriaSet,
+		Range: &logdb.Range{
+			Unit: logdb.Block,
+			From: uint64(from),
+			To:   uint64(to),
+		},
+		Options: &logdb.Options{Offset: 0, Limit: maxLogs + 1},
+		Order:   logdb.ASC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(events) > maxLogs {
+		return nil, fmt.Errorf("query returned more than %d results", maxLogs)
+	}
+
+	logs := make([]*RPCLog, 0, len(events))
+	txIndexes := make(map[meter.Bytes32]uint64)
+	for _, ev := range events {
+		index, ok := txIndexes[ev.TxID]
+		if !ok {
+			meta, err := r.chain.GetTransactionMeta(ev.TxID, ev.BlockID)
+			if err != nil {
+				return nil, err
+			}
+			index = meta.Index
+			txIndexes[ev.TxID] = index
+		}
+		logs = append(logs, convertLog(ev, index))
+	}
+	return logs, nil
+}
+
+// buildEventCriteriaSet expands the ethereum filter, where each position matches any of its values,
+// into the OR-ed exact criteria that logdb understands.
+func buildEventCriteriaSet(addresses []meter.Address, topics [][]meter.Bytes32) ([]*logdb.EventCriteria, error) {
+	criteriaSet := []*logdb.EventCriteria{{}}
+	if len(addresses) > 0 {
+		expanded := make([]*logdb.EventCriteria, 0, len(addresses))
+		for i := range addresses {
+			expanded = append(expanded, &logdb.EventCriteria{Address: &addresses[i]})
+		}
+		criteriaSet = expanded
+	}
+	for pos, alternatives := range topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		if len(criteriaSet)*len(alternatives) > maxLogCriteria {
+			return nil, invalidParams("too many address and topic combinations, want at most %d", maxLogCriteria)
+		}
+		expanded := make([]*logdb.EventCriteria, 0, len(criteriaSet)*len(alternatives))
+		for _, c := range criteriaSet {
+			for i := range alternatives {
+				nc := *c
+				nc.Topics[pos] = &alternatives[i]
+				expanded = append(expanded, &nc)
+			}
+		}
+		criteriaSet = expanded
+	}
+	if len(criteriaSet) == 1 && criteriaSet[0].Address == nil && criteriaSet[0].Topics == [5]*meter.Bytes32{} {
+		return nil, nil
+	}
+	return criteriaSet, nil
+}
+
+// resolveBlock resolves an ethereum block parameter to a trunk block header.
+// It accepts a tag (latest, earliest, pending, safe, finalized), a hex number, a block hash,
+// or an EIP-1898 object. An absent parameter means latest.
+func (r *JSONRPC) resolveBlock(raw json.RawMessage) (*block.Header, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return r.chain.BestBlock().Header(), nil
+	}
+	if raw[0] == '{' {
+		var obj struct {
+			BlockNumber *string        `json:"blockNumber"`
+			BlockHash   *meter.Bytes32 `json:"blockHash"`
+		}
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return nil, invalidParams("invalid block parameter: %v", err)
+		}
+		if obj.BlockHash != nil {
+			return r.headerByID(*obj.BlockHash)
+		}
+		if obj.BlockNumber != nil {
+			return r.headerByTag(*obj.BlockNumber)
+		}
+		return nil, invalidParams("invalid block parameter: neither blockNumber nor blockHash")
+	}
+	var tag string
+	if err := json.Unmarshal(raw, &tag); err != nil {
+		return nil, invalidParams("invalid block parameter: %v", err)
+	}
+	if len(tag) == 66 {
+		blockID, err := meter.ParseBytes32(tag)
+		if err != nil {
+			return nil, invalidParams("invalid block hash: %v", err)
+		}
+		return r.headerByID(blockID)
+	}
+	return r.headerByTag(tag)
+}
+
+func (r *JSONRPC) headerByTag(tag string) (*block.Header, error) {
+	switch strings.ToLower(tag) {
+	case "", "latest", "pending", "safe", "finalized":
+		return r.chain.BestBlock().Header(), nil
+	case "earliest":
+		return r.chain.GenesisBlock().Header(), nil
+	}
+	n, err := hexutil.DecodeUint64(tag)
+	if err != nil {
+		return nil, invalidParams("invalid block number: %v", err)
+	}
+	if n > uint64(r.chain.BestBlock().Number()) {
+		return nil, errHeaderNotFound
+	}
+	h, err := r.chain.GetTrunkBlockHeader(uint32(n))
+	if err != nil {
+		if r.chain.IsNotFound(err) {
+			return nil, errHeaderNotFound
+		}
+		return nil, err
+	}
+	return h, nil
+}
+
+func (r *JSONRPC) headerByID(blockID meter.Bytes32) (*block.Header, error) {
+	h, err := r.chain.GetBlockHeader(blockID)
+	if err != nil {
+		if r.chain.IsNotFound(err) {
+			return nil, errHeaderNotFound
+		}
+		return nil, err
+	}
+	if h.Number() > r.chain.BestBlock().Number() {
+		return nil, errHeaderNotFound
+	}
+	return h, nil
+}
+
+func (r *JSONRPC) baseGasPrice(header *block.Header) (*big.Int, error) {
+	st, err := r.stateCreator.NewState(header.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+	baseGasPrice := builtin.Params.Native(st).Get(meter.KeyBaseGasPrice)
+	if err := st.Err(); err != nil {
+		return nil, err
+	}
+	return baseGasPrice, nil
+}
+
+// parseStorageKey parses a storage slot, which could be a quantity shorter than 32 bytes.
+func parseStorageKey(slot string) (meter.Bytes32, error) {
+	s := strings.TrimPrefix(strings.TrimPrefix(slot, "0x"), "0X")
+	if len(s) > 64 {
+		return meter.Bytes32{}, errors.New("hex string too long")
+	}
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	b, err := hexutil.Decode("0x" + s)
+	if err != nil {
+		return meter.Bytes32{}, err
+	}
+	return meter.BytesToBytes32(b), nil
+}
+
+func revertError(result *accounts.CallResult, data []byte) error {
+	msg := "execution reverted"
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		msg += ": " + reason
+	} else if result.VMError != "" && result.VMError != msg {
+		msg = result.VMError
+	}
+	return &Error{Code: errCodeReverted, Message: msg, Data: hexutil.Bytes(data)}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/meterio/meter-pov/api/accounts"
+	"github.com/meterio/meter-pov/api/transactions"
+	"github.com/meterio/meter-pov/api/utils"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/logdb"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/txpool"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxRequestContentLength = 5 * 1024 * 1024
+	maxBatchSize            = 100
+)
+
+// methodFunc handles a single rpc method with positional params.
+type methodFunc func(ctx context.Context, params []json.RawMessage) (interface{}, error)
+
+// JSONRPC serves the ethereum JSON-RPC 2.0 API (eth_, net_ and web3_ namespaces).
+type JSONRPC struct {
+	chain        *chain.Chain
+	stateCreator *state.Creator
+	txPool       *txpool.TxPool
+	logDB        *logdb.LogDB
+	accounts     *accounts.Accounts
+	transactions *transactions.Transactions
+	callGasLimit uint64
+	methods      map[string]methodFunc
+	upgrader     *websocket.Upgrader
+	done         chan struct{}
+	wg           sync.WaitGroup
+	logger       *slog.Logger
+}
+
+func New(chain *chain.Chain, stateCreator *state.Creator, txPool *txpool.TxPool, logDB *logdb.LogDB, allowedOrigins []string, callGasLimit uint64) *JSONRPC {
+	r := &JSONRPC{
+		chain:        chain,
+		stateCreator: stateCreator,
+		txPool:       txPool,
+		logDB:        logDB,
+		accounts:     accounts.New(chain, stateCreator, logDB, callGasLimit),
+		transactions: transactions.New(chain, stateCreator, txPool),
+		callGasLimit: callGasLimit,
+		upgrader: &websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				if origin == "" {
+					return true
+				}
+				for _, allowedOrigin := range allowedOrigins {
+					if allowedOrigin == origin || allowedOrigin == "*" {
+						return true
+					}
+				}
+				return false
+			},
+		},
+		done:   make(chan struct{}),
+		logger: slog.With("api", "rpc"),
+	}
+	r.methods = map[string]methodFunc{
+		"web3_clientVersion":        r.clientVersion,
+		"web3_sha3":                 r.sha3,
+		"net_version":               r.netVersion,
+		"net_listening":             r.netListening,
+		"eth_chainId":               r.chainId,
+		"eth_blockNumber":           r.blockNumber,
+		"eth_gasPrice":              r.gasPrice,
+		"eth_getBalance":            r.getBalance,
+		"eth_getCode":               r.getCode,
+		"eth_getStorageAt":          r.getStorageAt,
+		"eth_call":                  r.call,
+		"eth_estimateGas":           r.estimateGas,
+		"eth_sendRawTransaction":    r.sendRawTransaction,
+		"eth_getTransactionByHash":  r.getTransactionByHash,
+		"eth_getTransactionReceipt": r.getTransactionReceipt,
+		"eth_getBlockByNumber":      r.getBlockByNumber,
+		"eth_getBlockByHash":        r.getBlockByHash,
+		"eth_getLogs":               r.getLogs,
+		"eth_subscribe":             r.subscribe,
+		"eth_unsubscribe":           r.unsubscribe,
+	}
+	return r
+}
+
+func (r *JSONRPC) handleRPC(w http.ResponseWriter, req *http.Request) error {
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxRequestContentLength))
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "body"))
+	}
+	if resp := r.handleBody(req.Context(), body); resp != nil {
+		return utils.WriteJSON(w, resp)
+	}
+	return nil
+}
+
+// handleBody processes a single or batch request, it returns nil if there is nothing to respond.
+func (r *JSONRPC) handleBody(ctx context.Context, body []byte) interface{} {
+	body = bytes.TrimLeft(body, " \t\r\n")
+
+	// batch request
+	if len(body) > 0 && body[0] == '[' {
+		var msgs []json.RawMessage
+		if err := json.Unmarshal(body, &msgs); err != nil {
+			return errorResponse(nil, &Error{Code: errCodeParse, Message: err.Error()})
+		}
+		if len(msgs) == 0 {
+			return errorResponse(nil, &Error{Code: errCodeInvalidRequest, Message: "empty batch"})
+		}
+		if len(msgs) > maxBatchSize {
+			return errorResponse(nil, &Error{Code: errCodeInvalidRequest, Message: "batch too large"})
+		}
+		resps := make([]*response, 0, len(msgs))
+		for _, msg := range msgs {
+			if resp := r.handleMessage(ctx, msg); resp != nil {
+				resps = append(resps, resp)
+			}
+		}
+		if len(resps) == 0 {
+			// all notifications
+			return nil
+		}
+		return resps
+	}
+
+	if resp := r.handleMessage(ctx, body); resp != nil {
+		return resp
+	}
+	return nil
+}
+
+// handleMessage processes a single request, it returns nil for notifications.
+func (r *JSONRPC) handleMessage(ctx context.Context, msg json.RawMessage) *response {
+	var rq request
+	if err := json.Unmarshal(msg, &rq); err != nil {
+		return errorResponse(nil, &Error{Code: errCodeParse, Message: err.Error()})
+	}
+	if rq.Version != jsonrpcVersion || rq.Method == "" {
+		return errorResponse(rq.ID, &Error{Code: errCodeInvalidRequest, Message: "invalid request"})
+	}
+
+	result, err := r.Call(ctx, rq.Method, rq.Params)
+	if rq.isNotification() {
+		return nil
+	}
+	if err != nil {
+		return errorResponse(rq.ID, err)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(rq.ID, &Error{Code: errCodeInternal, Message: err.Error()})
+	}
+	return &response{Version: jsonrpcVersion, ID: rq.ID, Result: data}
+}
+
+// Call invokes the named method with raw params, which must be a JSON array or absent.
+func (r *JSONRPC) Call(ctx context.Context, method string, rawParams json.RawMessage) (interface{}, error) {
+	fn, ok := r.methods[method]
+	if !ok {
+		return nil, &Error{Code: errCodeMethodNotFound, Message: "the method " + method + " does not exist/is not available"}
+	}
+	var params []json.RawMessage
+	if len(rawParams) > 0 && string(rawParams) != "null" {
+		if err := json.Unmarshal(rawParams, &params); err != nil {
+			return nil, invalidParams("non-array args")
+		}
+	}
+	result, err := fn(ctx, params)
+	if err != nil {
+		r.logger.Debug("rpc call failed", "method", method, "err", err)
+	}
+	return result, err
+}
+
+func errorResponse(id json.RawMessage, err error) *response {
+	rpcErr, ok := err.(*Error)
+	if !ok {
+		rpcErr = &Error{Code: errCodeServer, Message: err.Error()}
+	}
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &response{Version: jsonrpcVersion, ID: id, Error: rpcErr}
+}
+
+// parseParams decodes positional params into args, params beyond required ones are optional.
+func parseParams(params []json.RawMessage, required int, args ...interface{}) error {
+	if len(params) < required {
+		return invalidParams("missing value for required argument %d", len(params))
+	}
+	if len(params) > len(args) {
+		return invalidParams("too many arguments, want at most %d", len(args))
+	}
+	for i, p := range params {
+		if err := json.Unmarshal(p, args[i]); err != nil {
+			return invalidParams("invalid argument %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Close stops all websocket subscriptions and waits for the connections to be released.
+func (r *JSONRPC) Close() {
+	close(r.done)
+	r.wg.Wait()
+}
+
+func (r *JSONRPC) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("").Methods(http.MethodPost).HandlerFunc(utils.WrapHandlerFunc(r.handleRPC))
+	sub.Path("").Methods(http.MethodGet).HandlerFunc(utils.WrapHandlerFunc(r.handleWebSocket))
+}
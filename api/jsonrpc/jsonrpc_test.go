@@ -0,0 +1,326 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/meterio/meter-pov/api/jsonrpc"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/logdb"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/packer"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/meterio/meter-pov/txpool"
+	"github.com/stretchr/testify/assert"
+)
+
+var ts *httptest.Server
+var transaction *tx.Transaction
+var blk *block.Block
+var pool *txpool.TxPool
+var to = meter.BytesToAddress([]byte("to"))
+var value = big.NewInt(10000)
+
+type rpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *jsonrpc.Error  `json:"error"`
+}
+
+func TestJSONRPC(t *testing.T) {
+	initRPCServer(t)
+	defer ts.Close()
+	chainID(t)
+	blockNumber(t)
+	getBalance(t)
+	getBlock(t)
+	getTransaction(t)
+	getReceipt(t)
+	batch(t)
+	badRequests(t)
+	subscribe(t)
+}
+
+func chainID(t *testing.T) {
+	res := rpcCall(t, "eth_chainId")
+	var id hexutil.Uint64
+	decodeResult(t, res, &id)
+	assert.Equal(t, hexutil.Uint64(meter.TestnetChainID), id)
+
+	res = rpcCall(t, "net_version")
+	var version string
+	decodeResult(t, res, &version)
+	assert.Equal(t, "83", version)
+}
+
+func blockNumber(t *testing.T) {
+	res := rpcCall(t, "eth_blockNumber")
+	var num hexutil.Uint64
+	decodeResult(t, res, &num)
+	assert.Equal(t, hexutil.Uint64(blk.Number()), num)
+}
+
+func getBalance(t *testing.T) {
+	res := rpcCall(t, "eth_getBalance", to.String(), "latest")
+	var balance hexutil.Big
+	decodeResult(t, res, &balance)
+	assert.Equal(t, value, balance.ToInt())
+
+	res = rpcCall(t, "eth_getBalance", to.String(), "earliest")
+	decodeResult(t, res, &balance)
+	assert.Equal(t, 0, balance.ToInt().Sign())
+
+	res = rpcCall(t, "eth_getBalance", to.String(), "0xffff")
+	assert.NotNil(t, res.Error, "block beyond best")
+}
+
+func getBlock(t *testing.T) {
+	res := rpcCall(t, "eth_getBlockByNumber", "0x1", false)
+	var b jsonrpc.RPCBlock
+	decodeResult(t, res, &b)
+	assert.Equal(t, blk.ID(), b.Hash)
+	assert.Equal(t, blk.ParentID(), b.ParentHash)
+	assert.Equal(t, hexutil.Uint64(blk.GasUsed()), b.GasUsed)
+	assert.Equal(t, 1, len(b.Transactions))
+	assert.Equal(t, transaction.ID().String(), b.Transactions[0])
+
+	res = rpcCall(t, "eth_getBlockByHash", blk.ID().String(), true)
+	decodeResult(t, res, &b)
+	assert.Equal(t, hexutil.Uint64(blk.Number()), b.Number)
+	assert.Equal(t, transaction.ID().String(), b.Transactions[0].(map[string]interface{})["hash"])
+
+	res = rpcCall(t, "eth_getBlockByNumber", "0xffff", false)
+	assert.Nil(t, res.Error)
+	assert.Equal(t, "null", string(res.Result))
+}
+
+func getTransaction(t *testing.T) {
+	res := rpcCall(t, "eth_getTransactionByHash", transaction.ID().String())
+	var rtx jsonrpc.RPCTransaction
+	decodeResult(t, res, &rtx)
+	origin, _ := transaction.Signer()
+	assert.Equal(t, transaction.ID(), rtx.Hash)
+	assert.Equal(t, origin, rtx.From)
+	assert.Equal(t, &to, rtx.To)
+	assert.Equal(t, value, rtx.Value.ToInt())
+	assert.Equal(t, blk.ID(), *rtx.BlockHash)
+
+	res = rpcCall(t, "eth_getTransactionByHash", meter.Bytes32{}.String())
+	assert.Equal(t, "null", string(res.Result))
+}
+
+func getReceipt(t *testing.T) {
+	res := rpcCall(t, "eth_getTransactionReceipt", transaction.ID().String())
+	var receipt jsonrpc.RPCReceipt
+	decodeResult(t, res, &receipt)
+	assert.Equal(t, transaction.ID(), receipt.TransactionHash)
+	assert.Equal(t, blk.ID(), receipt.BlockHash)
+	assert.Equal(t, hexutil.Uint64(1), receipt.Status)
+	assert.Equal(t, receipt.GasUsed, receipt.CumulativeGasUsed)
+}
+
+func batch(t *testing.T) {
+	body := `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","method":"eth_blockNumber"},{"jsonrpc":"2.0","id":"b","method":"eth_blockNumber"}]`
+	var resps []rpcResponse
+	if err := json.Unmarshal(httpPost(t, body), &resps); err != nil {
+		t.Fatal(err)
+	}
+	// notification gets no response
+	assert.Equal(t, 2, len(resps))
+	assert.Equal(t, "1", string(resps[0].ID))
+	assert.Equal(t, `"b"`, string(resps[1].ID))
+}
+
+func badRequests(t *testing.T) {
+	res := rpcCall(t, "eth_notExist")
+	assert.Equal(t, -32601, res.Error.Code)
+
+	res = rpcCall(t, "eth_getBalance")
+	assert.Equal(t, -32602, res.Error.Code)
+
+	var resp rpcResponse
+	if err := json.Unmarshal(httpPost(t, `{"jsonrpc":"2.0","id":1,`), &resp); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, -32700, resp.Error.Code)
+}
+
+func subscribe(t *testing.T) {
+	res := rpcCall(t, "eth_subscribe", "newHeads")
+	assert.Equal(t, -32601, res.Error.Code, "not available over http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(ts.URL, "http", "ws", 1)+"/rpc", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var resp rpcResponse
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": "eth_blockNumber"}))
+	assert.Nil(t, conn.ReadJSON(&resp))
+	var num hexutil.Uint64
+	decodeResult(t, &resp, &num)
+	assert.Equal(t, hexutil.Uint64(blk.Number()), num)
+
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 2, "method": "eth_subscribe", "params": []interface{}{"newPendingTransactions"}}))
+	assert.Nil(t, conn.ReadJSON(&resp))
+	var subID string
+	decodeResult(t, &resp, &subID)
+
+	pending := new(tx.Builder).
+		ChainTag(transaction.ChainTag()).
+		Expiration(10).
+		Gas(21000).
+		Nonce(2).
+		Clause(tx.NewClause(&to).WithValue(value)).
+		BlockRef(tx.NewBlockRef(0)).
+		Build()
+	sig, err := crypto.Sign(pending.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending = pending.WithSignature(sig)
+	assert.Nil(t, pool.Add(pending))
+
+	var notification struct {
+		Method string `json:"method"`
+		Params struct {
+			Subscription string `json:"subscription"`
+			Result       string `json:"result"`
+		} `json:"params"`
+	}
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	assert.Nil(t, conn.ReadJSON(&notification))
+	assert.Equal(t, "eth_subscription", notification.Method)
+	assert.Equal(t, subID, notification.Params.Subscription)
+	assert.Equal(t, pending.ID().String(), notification.Params.Result)
+
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 3, "method": "eth_unsubscribe", "params": []interface{}{subID}}))
+	assert.Nil(t, conn.ReadJSON(&resp))
+	var ok bool
+	decodeResult(t, &resp, &ok)
+	assert.True(t, ok)
+}
+
+func initRPCServer(t *testing.T) {
+	meter.InitBlockChainConfig("test")
+	db, _ := lvldb.NewMem()
+	stateC := state.NewCreator(db)
+	gene := genesis.NewDevnet()
+
+	b, _, err := gene.Build(stateC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := chain.New(db, b, false)
+	cla := tx.NewClause(&to).WithValue(value)
+	transaction = new(tx.Builder).
+		ChainTag(c.Tag()).
+		Expiration(10).
+		Gas(21000).
+		Nonce(1).
+		Clause(cla).
+		BlockRef(tx.NewBlockRef(0)).
+		Build()
+	sig, err := crypto.Sign(transaction.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transaction = transaction.WithSignature(sig)
+
+	p := packer.New(c, stateC, genesis.DevAccounts()[0].Address, &genesis.DevAccounts()[0].Address)
+	flow, err := p.Mock(b.Header(), uint64(time.Now().Unix()), 2000000, &meter.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := flow.Adopt(transaction); err != nil {
+		t.Fatal(err)
+	}
+	var (
+		stage    *state.Stage
+		receipts tx.Receipts
+	)
+	blk, stage, receipts, err = flow.Pack(genesis.DevAccounts()[0].PrivateKey, block.MBlockType, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stage.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	blk.SetQC(&block.QuorumCert{QCHeight: 0, QCRound: 0, EpochID: 0})
+	escortQC := &block.QuorumCert{QCHeight: blk.Number(), QCRound: 1, EpochID: 0, VoterMsgHash: blk.VotingHash()}
+	if _, err := c.AddBlock(blk, escortQC, receipts); err != nil {
+		t.Fatal(err)
+	}
+	logDB, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	router := mux.NewRouter()
+	pool = txpool.New(c, stateC, txpool.Options{Limit: 10000, LimitPerAccount: 16, MaxLifetime: 10 * time.Minute})
+	jsonrpc.New(c, stateC, pool, logDB, []string{"*"}, 10000000).Mount(router, "/rpc")
+	ts = httptest.NewServer(router)
+}
+
+func rpcCall(t *testing.T, method string, params ...interface{}) *rpcResponse {
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp rpcResponse
+	if err := json.Unmarshal(httpPost(t, string(body)), &resp); err != nil {
+		t.Fatal(err)
+	}
+	return &resp
+}
+
+func decodeResult(t *testing.T, resp *rpcResponse, v interface{}) {
+	if resp.Error != nil {
+		t.Fatal(resp.Error.Message)
+	}
+	if err := json.Unmarshal(resp.Result, v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func httpPost(t *testing.T, body string) []byte {
+	res, err := http.Post(ts.URL+"/rpc", "application/json", bytes.NewReader([]byte(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
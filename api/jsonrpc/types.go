@@ -0,0 +1,498 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/meterio/meter-pov/api/subscriptions"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/logdb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/tx"
+)
+
+const jsonrpcVersion = "2.0"
+
+// standard JSON-RPC 2.0 error codes, plus the ones used by ethereum clients
+const (
+	errCodeParse          = -32700
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeInvalidParams  = -32602
+	errCodeInternal       = -32603
+	errCodeServer         = -32000
+	errCodeReverted       = 3
+)
+
+type request struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isNotification returns true if the request carries no id and expects no response.
+func (r *request) isNotification() bool {
+	return len(r.ID) == 0
+}
+
+type response struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// notification is a server-initiated message carrying a subscription result.
+type notification struct {
+	Version string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  notificationParams `json:"params"`
+}
+
+type notificationParams struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+// Error is a JSON-RPC error object.
+type Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func invalidParams(format string, args ...interface{}) error {
+	return &Error{Code: errCodeInvalidParams, Message: fmt.Sprintf(format, args...)}
+}
+
+// CallArgs represents the arguments of eth_call and eth_estimateGas.
+type CallArgs struct {
+	From     *meter.Address  `json:"from"`
+	To       *meter.Address  `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+	Input    *hexutil.Bytes  `json:"input"`
+}
+
+// data returns the call input, `input` takes precedence over `data` as in go-ethereum.
+func (args *CallArgs) data() []byte {
+	if args.Input != nil {
+		return *args.Input
+	}
+	if args.Data != nil {
+		return *args.Data
+	}
+	return nil
+}
+
+// FilterQuery represents the argument of eth_getLogs.
+type FilterQuery struct {
+	BlockHash *meter.Bytes32    `json:"blockHash"`
+	FromBlock *json.RawMessage  `json:"fromBlock"`
+	ToBlock   *json.RawMessage  `json:"toBlock"`
+	Addresses []meter.Address   `json:"-"`
+	Topics    [][]meter.Bytes32 `json:"-"`
+}
+
+func (q *FilterQuery) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		BlockHash *meter.Bytes32    `json:"blockHash"`
+		FromBlock *json.RawMessage  `json:"fromBlock"`
+		ToBlock   *json.RawMessage  `json:"toBlock"`
+		Address   json.RawMessage   `json:"address"`
+		Topics    []json.RawMessage `json:"topics"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	q.BlockHash = raw.BlockHash
+	q.FromBlock = raw.FromBlock
+	q.ToBlock = raw.ToBlock
+
+	addrs, err := decodeOneOrMany(raw.Address)
+	if err != nil {
+		return fmt.Errorf("address: %v", err)
+	}
+	for _, a := range addrs {
+		var addr meter.Address
+		if err := json.Unmarshal(a, &addr); err != nil {
+			return fmt.Errorf("address: %v", err)
+		}
+		q.Addresses = append(q.Addresses, addr)
+	}
+
+	q.Topics = make([][]meter.Bytes32, len(raw.Topics))
+	for i, t := range raw.Topics {
+		topics, err := decodeOneOrMany(t)
+		if err != nil {
+			return fmt.Errorf("topics[%d]: %v", i, err)
+		}
+		for _, tp := range topics {
+			var topic meter.Bytes32
+			if err := json.Unmarshal(tp, &topic); err != nil {
+				return fmt.Errorf("topics[%d]: %v", i, err)
+			}
+			q.Topics[i] = append(q.Topics[i], topic)
+		}
+	}
+	return nil
+}
+
+// Match returns whether the event matches the address and topic criteria of the query.
+func (q *FilterQuery) Match(ev *tx.Event) bool {
+	if len(q.Addresses) > 0 {
+		found := false
+		for _, addr := range q.Addresses {
+			if addr == ev.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(q.Topics) > len(ev.Topics) {
+		return false
+	}
+	for i, alternatives := range q.Topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range alternatives {
+			if topic == ev.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeOneOrMany splits null, a single value or an array of values into raw values.
+func decodeOneOrMany(data json.RawMessage) ([]json.RawMessage, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	if data[0] == '[' {
+		var many []json.RawMessage
+		if err := json.Unmarshal(data, &many); err != nil {
+			return nil, err
+		}
+		return many, nil
+	}
+	return []json.RawMessage{data}, nil
+}
+
+// RPCHeader is the ethereum representation of a block header.
+type RPCHeader struct {
+	Number           hexutil.Uint64 `json:"number"`
+	Hash             meter.Bytes32  `json:"hash"`
+	ParentHash       meter.Bytes32  `json:"parentHash"`
+	Nonce            hexutil.Bytes  `json:"nonce"`
+	MixHash          meter.Bytes32  `json:"mixHash"`
+	Sha3Uncles       meter.Bytes32  `json:"sha3Uncles"`
+	LogsBloom        hexutil.Bytes  `json:"logsBloom"`
+	TransactionsRoot meter.Bytes32  `json:"transactionsRoot"`
+	StateRoot        meter.Bytes32  `json:"stateRoot"`
+	ReceiptsRoot     meter.Bytes32  `json:"receiptsRoot"`
+	Miner            meter.Address  `json:"miner"`
+	Difficulty       hexutil.Uint64 `json:"difficulty"`
+	TotalDifficulty  hexutil.Uint64 `json:"totalDifficulty"`
+	ExtraData        hexutil.Bytes  `json:"extraData"`
+	GasLimit         hexutil.Uint64 `json:"gasLimit"`
+	GasUsed          hexutil.Uint64 `json:"gasUsed"`
+	Timestamp        hexutil.Uint64 `json:"timestamp"`
+	BaseFeePerGas    *hexutil.Big   `json:"baseFeePerGas"`
+}
+
+// RPCBlock is the ethereum representation of a block.
+type RPCBlock struct {
+	RPCHeader
+	Size         hexutil.Uint64  `json:"size"`
+	Transactions []interface{}   `json:"transactions"`
+	Uncles       []meter.Bytes32 `json:"uncles"`
+}
+
+// RPCTransaction is the ethereum representation of a transaction.
+type RPCTransaction struct {
+	BlockHash            *meter.Bytes32  `json:"blockHash"`
+	BlockNumber          *hexutil.Uint64 `json:"blockNumber"`
+	From                 meter.Address   `json:"from"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Hash                 meter.Bytes32   `json:"hash"`
+	Input                hexutil.Bytes   `json:"input"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	To                   *meter.Address  `json:"to"`
+	TransactionIndex     *hexutil.Uint64 `json:"transactionIndex"`
+	Value                *hexutil.Big    `json:"value"`
+	Type                 hexutil.Uint64  `json:"type"`
+	ChainID              *hexutil.Big    `json:"chainId,omitempty"`
+	V                    *hexutil.Big    `json:"v"`
+	R                    *hexutil.Big    `json:"r"`
+	S                    *hexutil.Big    `json:"s"`
+}
+
+// RPCReceipt is the ethereum representation of a transaction receipt.
+type RPCReceipt struct {
+	TransactionHash   meter.Bytes32  `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash         meter.Bytes32  `json:"blockHash"`
+	BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+	From              meter.Address  `json:"from"`
+	To                *meter.Address `json:"to"`
+	CumulativeGasUsed hexutil.Uint64 `json:"cumulativeGasUsed"`
+	GasUsed           hexutil.Uint64 `json:"gasUsed"`
+	EffectiveGasPrice *hexutil.Big   `json:"effectiveGasPrice"`
+	ContractAddress   *meter.Address `json:"contractAddress"`
+	Logs              []*RPCLog      `json:"logs"`
+	LogsBloom         hexutil.Bytes  `json:"logsBloom"`
+	Type              hexutil.Uint64 `json:"type"`
+	Status            hexutil.Uint64 `json:"status"`
+}
+
+// RPCLog is the ethereum representation of an event log.
+type RPCLog struct {
+	Address          meter.Address   `json:"address"`
+	Topics           []meter.Bytes32 `json:"topics"`
+	Data             hexutil.Bytes   `json:"data"`
+	BlockNumber      hexutil.Uint64  `json:"blockNumber"`
+	TransactionHash  meter.Bytes32   `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64  `json:"transactionIndex"`
+	BlockHash        meter.Bytes32   `json:"blockHash"`
+	LogIndex         hexutil.Uint64  `json:"logIndex"`
+	Removed          bool            `json:"removed"`
+}
+
+func convertHeader(header *block.Header, receipts tx.Receipts, baseGasPrice *big.Int) *RPCHeader {
+	bloom := tx.CreateEthBloom(receipts)
+	return &RPCHeader{
+		Number:           hexutil.Uint64(header.Number()),
+		Hash:             header.ID(),
+		ParentHash:       header.ParentID(),
+		Nonce:            make(hexutil.Bytes, 8),
+		Sha3Uncles:       meter.Bytes32(types.EmptyUncleHash),
+		LogsBloom:        bloom.Bytes(),
+		TransactionsRoot: header.TxsRoot(),
+		StateRoot:        header.StateRoot(),
+		ReceiptsRoot:     header.ReceiptsRoot(),
+		Miner:            header.Beneficiary(),
+		TotalDifficulty:  hexutil.Uint64(header.TotalScore()),
+		ExtraData:        hexutil.Bytes{},
+		GasLimit:         hexutil.Uint64(header.GasLimit()),
+		GasUsed:          hexutil.Uint64(header.GasUsed()),
+		Timestamp:        hexutil.Uint64(header.Timestamp()),
+		BaseFeePerGas:    (*hexutil.Big)(baseGasPrice),
+	}
+}
+
+func convertBlock(blk *block.Block, receipts tx.Receipts, baseGasPrice *big.Int, fullTx bool) *RPCBlock {
+	header := blk.Header()
+	b := &RPCBlock{
+		RPCHeader:    *convertHeader(header, receipts, baseGasPrice),
+		Size:         hexutil.Uint64(blk.Size()),
+		Transactions: make([]interface{}, 0, len(blk.Txs)),
+		Uncles:       make([]meter.Bytes32, 0),
+	}
+	for i, t := range blk.Txs {
+		if fullTx {
+			b.Transactions = append(b.Transactions, convertTransaction(t, header, uint64(i), baseGasPrice))
+		} else {
+			b.Transactions = append(b.Transactions, t.ID().String())
+		}
+	}
+	return b
+}
+
+// convertTransaction converts a native tx into its ethereum representation,
+// native multi-clause txs are represented by their first clause.
+// header is nil for pending txs.
+func convertTransaction(t *tx.Transaction, header *block.Header, index uint64, baseGasPrice *big.Int) *RPCTransaction {
+	origin, _ := t.Signer()
+	rt := &RPCTransaction{
+		From:  origin,
+		Gas:   hexutil.Uint64(t.Gas()),
+		Hash:  t.ID(),
+		Nonce: hexutil.Uint64(t.Nonce()),
+		Input: hexutil.Bytes{},
+		Value: (*hexutil.Big)(new(big.Int)),
+		Type:  hexutil.Uint64(t.Type()),
+	}
+	if header != nil {
+		blockID := header.ID()
+		number := hexutil.Uint64(header.Number())
+		txIndex := hexutil.Uint64(index)
+		rt.BlockHash = &blockID
+		rt.BlockNumber = &number
+		rt.TransactionIndex = &txIndex
+	}
+
+	if ethTx, err := t.GetEthTx(); err == nil {
+		v, r, s := ethTx.RawSignatureValues()
+		rt.Nonce = hexutil.Uint64(ethTx.Nonce())
+		rt.Input = ethTx.Data()
+		rt.Value = (*hexutil.Big)(ethTx.Value())
+		rt.GasPrice = (*hexutil.Big)(ethTx.GasPrice())
+		rt.ChainID = (*hexutil.Big)(ethTx.ChainId())
+		rt.V, rt.R, rt.S = (*hexutil.Big)(v), (*hexutil.Big)(r), (*hexutil.Big)(s)
+		if ethTx.To() != nil {
+			to := meter.Address(*ethTx.To())
+			rt.To = &to
+		}
+		if ethTx.Type() == types.DynamicFeeTxType {
+			rt.MaxFeePerGas = (*hexutil.Big)(ethTx.GasFeeCap())
+			rt.MaxPriorityFeePerGas = (*hexutil.Big)(ethTx.GasTipCap())
+		}
+		return rt
+	}
+
+	rt.GasPrice = (*hexutil.Big)(t.GasPrice(baseGasPrice))
+	rt.ChainID = (*hexutil.Big)(new(big.Int).SetUint64(chainID()))
+	if clauses := t.Clauses(); len(clauses) > 0 {
+		rt.To = clauses[0].To()
+		rt.Value = (*hexutil.Big)(clauses[0].Value())
+		rt.Input = clauses[0].Data()
+	}
+	v, r, s := new(big.Int), new(big.Int), new(big.Int)
+	if sig := t.Signature(); len(sig) >= 65 {
+		r.SetBytes(sig[:32])
+		s.SetBytes(sig[32:64])
+		v.SetBytes(sig[64:65])
+	}
+	rt.V, rt.R, rt.S = (*hexutil.Big)(v), (*hexutil.Big)(r), (*hexutil.Big)(s)
+	return rt
+}
+
+// convertReceipt converts a native receipt into its ethereum representation.
+// cumulativeGasUsed and logIndex are the totals of the txs before this one in the block.
+func convertReceipt(receipt *tx.Receipt, t *tx.Transaction, header *block.Header, index uint64, cumulativeGasUsed uint64, logIndex uint64, baseGasPrice *big.Int) *RPCReceipt {
+	origin, _ := t.Signer()
+	bloom := tx.CreateEthBloom(tx.Receipts{receipt})
+	r := &RPCReceipt{
+		TransactionHash:   t.ID(),
+		TransactionIndex:  hexutil.Uint64(index),
+		BlockHash:         header.ID(),
+		BlockNumber:       hexutil.Uint64(header.Number()),
+		From:              origin,
+		CumulativeGasUsed: hexutil.Uint64(cumulativeGasUsed + receipt.GasUsed),
+		GasUsed:           hexutil.Uint64(receipt.GasUsed),
+		EffectiveGasPrice: (*hexutil.Big)(t.GasPrice(baseGasPrice)),
+		Logs:              make([]*RPCLog, 0),
+		LogsBloom:         bloom.Bytes(),
+		Type:              hexutil.Uint64(t.Type()),
+		Status:            hexutil.Uint64(types.ReceiptStatusSuccessful),
+	}
+	if receipt.Reverted {
+		r.Status = hexutil.Uint64(types.ReceiptStatusFailed)
+	}
+	if ethTx, err := t.GetEthTx(); err == nil {
+		r.EffectiveGasPrice = (*hexutil.Big)(ethTx.GasPrice())
+	}
+
+	clauses := t.Clauses()
+	if len(clauses) > 0 {
+		r.To = clauses[0].To()
+		if r.To == nil && !receipt.Reverted {
+			var contractAddr meter.Address
+			if meter.IsTesla(t.BlockRef().Number()) {
+				contractAddr = meter.Address(meter.EthCreateContractAddress(common.Address(origin), uint32(t.Nonce())))
+			} else {
+				contractAddr = meter.CreateContractAddress(t.ID(), 0, 0)
+			}
+			r.ContractAddress = &contractAddr
+		}
+	}
+
+	for _, output := range receipt.Outputs {
+		for _, ev := range output.Events {
+			r.Logs = append(r.Logs, convertEvent(ev, header, t.ID(), index, logIndex))
+			logIndex++
+		}
+	}
+	return r
+}
+
+// convertEvent converts an event emitted in the block of header, logIndex is block-wide.
+func convertEvent(ev *tx.Event, header *block.Header, txID meter.Bytes32, txIndex uint64, logIndex uint64) *RPCLog {
+	l := &RPCLog{
+		Address:          ev.Address,
+		Topics:           append([]meter.Bytes32{}, ev.Topics...),
+		Data:             ev.Data,
+		BlockNumber:      hexutil.Uint64(header.Number()),
+		TransactionHash:  txID,
+		TransactionIndex: hexutil.Uint64(txIndex),
+		BlockHash:        header.ID(),
+		LogIndex:         hexutil.Uint64(logIndex),
+	}
+	if l.Data == nil {
+		l.Data = hexutil.Bytes{}
+	}
+	return l
+}
+
+func convertEventMessage(msg *subscriptions.EventMessage) (*RPCLog, error) {
+	data, err := hexutil.Decode(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &RPCLog{
+		Address:          msg.Address,
+		Topics:           append([]meter.Bytes32{}, msg.Topics...),
+		Data:             data,
+		BlockNumber:      hexutil.Uint64(msg.Meta.BlockNumber),
+		TransactionHash:  msg.Meta.TxID,
+		TransactionIndex: hexutil.Uint64(msg.TxIndex),
+		BlockHash:        msg.Meta.BlockID,
+		LogIndex:         hexutil.Uint64(msg.BlockLogIndex),
+		Removed:          msg.Obsolete,
+	}, nil
+}
+
+func convertLog(ev *logdb.Event, txIndex uint64) *RPCLog {
+	l := &RPCLog{
+		Address:          ev.Address,
+		Topics:           make([]meter.Bytes32, 0, len(ev.Topics)),
+		Data:             ev.Data,
+		BlockNumber:      hexutil.Uint64(ev.BlockNumber),
+		TransactionHash:  ev.TxID,
+		TransactionIndex: hexutil.Uint64(txIndex),
+		BlockHash:        ev.BlockID,
+		LogIndex:         hexutil.Uint64(ev.Index),
+	}
+	for _, topic := range ev.Topics {
+		if topic != nil {
+			l.Topics = append(l.Topics, *topic)
+		}
+	}
+	if l.Data == nil {
+		l.Data = hexutil.Bytes{}
+	}
+	return l
+}
+
+func chainID() uint64 {
+	return meter.ChainID()
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package jsonrpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/websocket"
+	"github.com/meterio/meter-pov/api/subscriptions"
+	"github.com/meterio/meter-pov/txpool"
+)
+
+const (
+	// maxSubscriptions limits the number of active subscriptions of a websocket connection
+	maxSubscriptions   = 128
+	subscriptionMethod = "eth_subscription"
+	// wsWriteTimeout limits the time to write a message, so a slow client can't hold the connection forever
+	wsWriteTimeout = 10 * time.Second
+)
+
+type wsConnKey struct{}
+
+type msgReader interface {
+	Read() (msgs []interface{}, hasMore bool, err error)
+}
+
+// wsConn serves JSON-RPC requests and subscription notifications over a websocket connection.
+type wsConn struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+	subsMu  sync.Mutex
+	subs    map[string]*subscription
+	pending []*subscription
+	closed  chan struct{}
+	wg      sync.WaitGroup
+}
+
+type subscription struct {
+	id     string
+	conn   *wsConn
+	ready  chan struct{}
+	cancel chan struct{}
+	done   <-chan struct{}
+}
+
+func (r *JSONRPC) handleWebSocket(w http.ResponseWriter, req *http.Request) error {
+	r.wg.Add(1)
+	defer r.wg.Done()
+
+	conn, err := r.upgrader.Upgrade(w, req, nil)
+	// since the conn is hijacked here, no error should be returned in lines below
+	if err != nil {
+		r.logger.Debug("upgrade to websocket", "err", err)
+		return nil
+	}
+	c := &wsConn{
+		conn:   conn,
+		subs:   make(map[string]*subscription),
+		closed: make(chan struct{}),
+	}
+	defer func() {
+		close(c.closed)
+		c.wg.Wait()
+		if err := conn.Close(); err != nil {
+			r.logger.Debug("close websocket", "err", err)
+		}
+	}()
+
+	// break the read loop on shutdown
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		select {
+		case <-r.done:
+			c.write(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+			conn.Close()
+		case <-c.closed:
+		}
+	}()
+
+	conn.SetReadLimit(maxRequestContentLength)
+	ctx := context.WithValue(req.Context(), wsConnKey{}, c)
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			r.logger.Debug("websocket read err", "err", err)
+			return nil
+		}
+		if resp := r.handleBody(ctx, msg); resp != nil {
+			if err := c.writeJSON(resp); err != nil {
+				r.logger.Debug("websocket write err", "err", err)
+				return nil
+			}
+		}
+		// subscriptions start notifying only after their ids are sent
+		c.activate()
+	}
+}
+
+func (c *wsConn) write(messageType int, data []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return c.conn.WriteMessage(messageType, data)
+}
+
+func (c *wsConn) writeJSON(v interface{}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return c.conn.WriteJSON(v)
+}
+
+// newSubscription registers a subscription, which is stopped by unsubscribing, closing the connection
+// or shutting down the server.
+func (c *wsConn) newSubscription(shutdown <-chan struct{}) (*subscription, error) {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+
+	if len(c.subs) >= maxSubscriptions {
+		return nil, &Error{Code: errCodeServer, Message: "too many subscriptions"}
+	}
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	done := make(chan struct{})
+	sub := &subscription{
+		id:     hexutil.Encode(b[:]),
+		conn:   c,
+		ready:  make(chan struct{}),
+		cancel: make(chan struct{}),
+		done:   done,
+	}
+	c.subs[sub.id] = sub
+	c.pending = append(c.pending, sub)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		select {
+		case <-sub.cancel:
+		case <-c.closed:
+		case <-shutdown:
+		}
+		close(done)
+	}()
+	return sub, nil
+}
+
+func (c *wsConn) activate() {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+
+	for _, sub := range c.pending {
+		close(sub.ready)
+	}
+	c.pending = nil
+}
+
+func (c *wsConn) unsubscribe(id string) bool {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+
+	sub, ok := c.subs[id]
+	if !ok {
+		return false
+	}
+	delete(c.subs, id)
+	close(sub.cancel)
+	return true
+}
+
+// run executes fn in background once the subscription id is delivered.
+func (sub *subscription) run(r *JSONRPC, fn func(sub *subscription) error) {
+	sub.conn.wg.Add(1)
+	go func() {
+		defer sub.conn.wg.Done()
+		select {
+		case <-sub.ready:
+		case <-sub.done:
+			return
+		}
+		if err := fn(sub); err != nil {
+			r.logger.Debug("subscription stopped", "id", sub.id, "err", err)
+			sub.conn.unsubscribe(sub.id)
+		}
+	}()
+}
+
+func (sub *subscription) notify(result interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return sub.conn.writeJSON(&notification{
+		Version: jsonrpcVersion,
+		Method:  subscriptionMethod,
+		Params:  notificationParams{Subscription: sub.id, Result: data},
+	})
+}
+
+// wait blocks until tick fires, it returns false if the subscription is stopped.
+func (sub *subscription) wait(tick <-chan bool) bool {
+	select {
+	case <-sub.done:
+		return false
+	case <-tick:
+		return true
+	}
+}
+
+func (sub *subscription) stopped() bool {
+	select {
+	case <-sub.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *JSONRPC) subscribe(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	c, ok := ctx.Value(wsConnKey{}).(*wsConn)
+	if !ok {
+		return nil, &Error{Code: errCodeMethodNotFound, Message: "notifications not supported"}
+	}
+	var (
+		kind  string
+		query FilterQuery
+	)
+	if err := parseParams(params, 1, &kind, &query); err != nil {
+		return nil, err
+	}
+
+	var fn func(sub *subscription) error
+	switch kind {
+	case "newHeads":
+		fn = r.pipeNewHeads
+	case "logs":
+		if len(query.Topics) > 4 {
+			return nil, invalidParams("too many topics, want at most 4")
+		}
+		fn = func(sub *subscription) error { return r.pipeLogs(sub, &query) }
+	case "newPendingTransactions":
+		fn = r.pipePendingTransactions
+	default:
+		return nil, invalidParams("unsupported subscription type %q", kind)
+	}
+
+	sub, err := c.newSubscription(r.done)
+	if err != nil {
+		return nil, err
+	}
+	sub.run(r, fn)
+	return sub.id, nil
+}
+
+func (r *JSONRPC) unsubscribe(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	c, ok := ctx.Value(wsConnKey{}).(*wsConn)
+	if !ok {
+		return nil, &Error{Code: errCodeMethodNotFound, Message: "notifications not supported"}
+	}
+	var id string
+	if err := parseParams(params, 1, &id); err != nil {
+		return nil, err
+	}
+	return c.unsubscribe(id), nil
+}
+
+// pipeNewHeads notifies headers of new trunk blocks.
+func (r *JSONRPC) pipeNewHeads(sub *subscription) error {
+	reader := subscriptions.NewBlockReader(r.chain, r.chain.BestBlock().ID())
+	return r.pipe(sub, reader, func(msg interface{}) error {
+		blk := msg.(*subscriptions.BlockMessage)
+		if blk.Obsolete {
+			return nil
+		}
+		header, err := r.chain.GetBlockHeader(blk.ID)
+		if err != nil {
+			return err
+		}
+		receipts, err := r.chain.GetBlockReceipts(blk.ID)
+		if err != nil {
+			return err
+		}
+		baseGasPrice, err := r.baseGasPrice(header)
+		if err != nil {
+			return err
+		}
+		return sub.notify(convertHeader(header, receipts, baseGasPrice))
+	})
+}
+
+// pipeLogs notifies logs matching query in new blocks, logs of blocks leaving the trunk are
+// notified again with removed set.
+func (r *JSONRPC) pipeLogs(sub *subscription, query *FilterQuery) error {
+	reader := subscriptions.NewEventReader(r.chain, r.chain.BestBlock().ID(), query)
+	return r.pipe(sub, reader, func(msg interface{}) error {
+		log, err := convertEventMessage(msg.(*subscriptions.EventMessage))
+		if err != nil {
+			return err
+		}
+		return sub.notify(log)
+	})
+}
+
+// pipe passes messages read by reader to notify until the subscription is stopped.
+func (r *JSONRPC) pipe(sub *subscription, reader msgReader, notify func(msg interface{}) error) error {
+	ticker := r.chain.NewTicker()
+	for {
+		msgs, hasMore, err := reader.Read()
+		if err != nil {
+			return err
+		}
+		for _, msg := range msgs {
+			if err := notify(msg); err != nil {
+				return err
+			}
+		}
+		if !hasMore {
+			if !sub.wait(ticker.C()) {
+				return nil
+			}
+		} else if sub.stopped() {
+			return nil
+		}
+	}
+}
+
+// pipePendingTransactions notifies hashes of txs becoming executable in the pool.
+func (r *JSONRPC) pipePendingTransactions(sub *subscription) error {
+	txEvCh := make(chan *txpool.TxEvent, 10)
+	txSub := r.txPool.SubscribeTxEvent(txEvCh)
+	defer txSub.Unsubscribe()
+
+	for {
+		select {
+		case <-sub.done:
+			return nil
+		case err := <-txSub.Err():
+			return err
+		case txEv := <-txEvCh:
+			if txEv.Executable != nil && *txEv.Executable {
+				if err := sub.notify(txEv.Tx.ID().String()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/meterio/meter-pov/comm"
 	"github.com/meterio/meter-pov/genesis"
 	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/state"
 	"github.com/meterio/meter-pov/txpool"
 	"github.com/stretchr/testify/assert"
@@ -46,7 +47,7 @@ func initCommServer(t *testing.T) {
 		t.Fatal(err)
 	}
 	chain, _ := chain.New(db, b, false)
-	comm := comm.New(context.Background(), chain, txpool.New(chain, stateC, txpool.Options{
+	comm := comm.New(context.Background(), chain, db, comm.FullSync, meter.Bytes32{}, txpool.New(chain, stateC, txpool.Options{
 		Limit:           10000,
 		LimitPerAccount: 16,
 		MaxLifetime:     10 * time.Minute,
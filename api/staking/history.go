@@ -0,0 +1,105 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package staking
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/meterio/meter-pov/api/utils"
+	"github.com/meterio/meter-pov/logdb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/pkg/errors"
+)
+
+const defaultHistoryLimit = 100
+
+// parseHistoryFilter parses range, options and order from query, as logs filters do.
+func parseHistoryFilter(query url.Values) (*logdb.StakingRecordFilter, error) {
+	filter := &logdb.StakingRecordFilter{Order: logdb.ASC}
+
+	unit := logdb.Block
+	switch query.Get("unit") {
+	case "", string(logdb.Block):
+	case string(logdb.Time):
+		unit = logdb.Time
+	default:
+		return nil, utils.BadRequest(errors.New("unit: should be block or time"))
+	}
+	from, err := parseUintQuery(query, "from", 0)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseUintQuery(query, "to", 0)
+	if err != nil {
+		return nil, err
+	}
+	// upper bound is omitted if to is less than from
+	if from > 0 || query.Get("to") != "" {
+		filter.Range = &logdb.Range{Unit: unit, From: from, To: to}
+	}
+
+	offset, err := parseUintQuery(query, "offset", 0)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := parseUintQuery(query, "limit", defaultHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+	if limit > maxStakingLimit {
+		return nil, utils.BadRequest(errors.Errorf("limit: should not exceed %v", maxStakingLimit))
+	}
+	filter.Options = &logdb.Options{Offset: offset, Limit: limit}
+
+	switch query.Get("order") {
+	case "", string(logdb.ASC):
+	case string(logdb.DESC):
+		filter.Order = logdb.DESC
+	default:
+		return nil, utils.BadRequest(errors.New("order: should be asc or desc"))
+	}
+	return filter, nil
+}
+
+func (st *Staking) writeHistory(w http.ResponseWriter, req *http.Request, filter *logdb.StakingRecordFilter) error {
+	records, err := st.logDB.FilterStakingRecords(req.Context(), filter)
+	if err != nil {
+		return err
+	}
+	result := make([]*StakingRecord, 0, len(records))
+	for _, r := range records {
+		result = append(result, convertStakingRecord(r))
+	}
+	return utils.WriteJSON(w, result)
+}
+
+func (st *Staking) handleGetBucketHistory(w http.ResponseWriter, req *http.Request) error {
+	bucketID, err := meter.ParseBytes32(mux.Vars(req)["id"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "id"))
+	}
+	filter, err := parseHistoryFilter(req.URL.Query())
+	if err != nil {
+		return err
+	}
+	filter.BucketID = &bucketID
+	return st.writeHistory(w, req, filter)
+}
+
+func (st *Staking) handleGetCandidateHistory(w http.ResponseWriter, req *http.Request) error {
+	addr, err := meter.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	filter, err := parseHistoryFilter(req.URL.Query())
+	if err != nil {
+		return err
+	}
+	filter.Candidate = &addr
+	return st.writeHistory(w, req, filter)
+}
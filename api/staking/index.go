@@ -0,0 +1,299 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package staking
+
+import (
+	"bytes"
+	"sort"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+)
+
+const (
+	indexCacheSize = 32
+	maxIndexSteps  = 32 // max blocks indexed one by one to catch up with best
+)
+
+// indexKey identifies the staking lists an index is built from before fork12. Storage of rlp lists
+// is read as the hash of raw data, so the key changes only if the script engine changes the lists.
+type indexKey struct {
+	buckets    meter.Bytes32
+	candidates meter.Bytes32
+}
+
+// bucketOrder is the order buckets are listed in.
+type bucketOrder int
+
+const (
+	listOrder  bucketOrder = iota // order of the staking bucket list
+	idOrder                       // by bucket id
+	votesOrder                    // by total votes in descending order
+)
+
+// stakingIndex indexes buckets and candidates of a staking state.
+type stakingIndex struct {
+	entryLayout bool          // buckets and candidates are stored per entry
+	stateRoot   meter.Bytes32 // state root of the block, set in per entry layout
+
+	buckets     []*meter.Bucket // in list order
+	byBucketID  []*meter.Bucket // sorted by bucket id
+	byVotes     []*meter.Bucket // sorted by total votes in descending order
+	byID        map[meter.Bytes32]*meter.Bucket
+	byOwner     map[meter.Address][]*meter.Bucket // in list order
+	byCandidate map[meter.Address][]*meter.Bucket // in list order
+	candidates  []*meter.Candidate                // sorted by total votes in descending order
+	listed      []*meter.Candidate                // candidates in list order
+}
+
+func newStakingIndex(buckets *meter.BucketList, candidates *meter.CandidateList) *stakingIndex {
+	idx := &stakingIndex{
+		buckets:     buckets.Buckets,
+		byBucketID:  make([]*meter.Bucket, len(buckets.Buckets)),
+		byVotes:     make([]*meter.Bucket, len(buckets.Buckets)),
+		byID:        make(map[meter.Bytes32]*meter.Bucket, len(buckets.Buckets)),
+		byOwner:     make(map[meter.Address][]*meter.Bucket),
+		byCandidate: make(map[meter.Address][]*meter.Bucket),
+		candidates:  append([]*meter.Candidate(nil), candidates.Candidates...),
+		listed:      candidates.Candidates,
+	}
+	copy(idx.byBucketID, idx.buckets)
+	copy(idx.byVotes, idx.buckets)
+	for _, b := range idx.buckets {
+		idx.byID[b.BucketID] = b
+		idx.byOwner[b.Owner] = append(idx.byOwner[b.Owner], b)
+		idx.byCandidate[b.Candidate] = append(idx.byCandidate[b.Candidate], b)
+	}
+	sortBuckets(idx.byBucketID, idOrder)
+	sortBuckets(idx.byVotes, votesOrder)
+	sort.SliceStable(idx.candidates, func(i, j int) bool {
+		return idx.candidates[i].TotalVotes.Cmp(idx.candidates[j].TotalVotes) > 0
+	})
+	return idx
+}
+
+// apply returns the index with changed entries applied. Lists are rebuilt in the order the state
+// reads them, so the result is the same as indexing the changed state, but no entry is decoded.
+func (idx *stakingIndex) apply(diff *state.StakingEntryDiff) *stakingIndex {
+	if len(diff.Buckets) == 0 && len(diff.Candidates) == 0 {
+		return idx
+	}
+	buckets := make([]*meter.Bucket, 0, len(idx.buckets)+len(diff.Buckets))
+	for _, b := range idx.buckets {
+		if changed, ok := diff.Buckets[b.BucketID]; !ok {
+			buckets = append(buckets, b)
+		} else if changed != nil {
+			buckets = append(buckets, changed)
+		}
+	}
+	for id, b := range diff.Buckets {
+		if _, ok := idx.byID[id]; !ok && b != nil {
+			buckets = append(buckets, b)
+		}
+	}
+
+	candidates := make([]*meter.Candidate, 0, len(idx.listed)+len(diff.Candidates))
+	existing := make(map[meter.Address]bool, len(idx.listed))
+	for _, c := range idx.listed {
+		existing[c.Addr] = true
+		if changed, ok := diff.Candidates[c.Addr]; !ok {
+			candidates = append(candidates, c)
+		} else if changed != nil {
+			candidates = append(candidates, changed)
+		}
+	}
+	for addr, c := range diff.Candidates {
+		if !existing[addr] && c != nil {
+			candidates = append(candidates, c)
+		}
+	}
+
+	next := newStakingIndex(meter.NewBucketList(buckets), meter.NewCandidateList(candidates))
+	next.entryLayout = true
+	return next
+}
+
+func sortBuckets(buckets []*meter.Bucket, order bucketOrder) {
+	switch order {
+	case idOrder:
+		sort.SliceStable(buckets, func(i, j int) bool {
+			return bytes.Compare(buckets[i].BucketID[:], buckets[j].BucketID[:]) < 0
+		})
+	case votesOrder:
+		sort.SliceStable(buckets, func(i, j int) bool {
+			return buckets[i].TotalVotes.Cmp(buckets[j].TotalVotes) > 0
+		})
+	}
+}
+
+// bucketFilter filters buckets of the staking index.
+type bucketFilter struct {
+	owner     *meter.Address
+	candidate *meter.Address
+	token     *uint8
+	unbounded *bool
+	mature    *bool  // unbounded and mature time passed
+	now       uint64 // timestamp to check maturity against
+	order     bucketOrder
+	offset    uint64
+	limit     uint64 // 0 means no limit
+}
+
+func (f *bucketFilter) match(b *meter.Bucket) bool {
+	if f.owner != nil && b.Owner != *f.owner {
+		return false
+	}
+	if f.candidate != nil && b.Candidate != *f.candidate {
+		return false
+	}
+	if f.token != nil && b.Token != *f.token {
+		return false
+	}
+	if f.unbounded != nil && b.Unbounded != *f.unbounded {
+		return false
+	}
+	if f.mature != nil && (b.Unbounded && b.MatureTime <= f.now) != *f.mature {
+		return false
+	}
+	return true
+}
+
+// filterBuckets returns buckets matching the filter, starting from the narrowest index.
+func (idx *stakingIndex) filterBuckets(f *bucketFilter) []*meter.Bucket {
+	var source []*meter.Bucket
+	switch f.order {
+	case idOrder:
+		source = idx.byBucketID
+	case votesOrder:
+		source = idx.byVotes
+	default:
+		source = idx.buckets
+	}
+	// buckets grouped by owner or candidate are in list order
+	grouped := true
+	switch {
+	case f.owner != nil:
+		source = idx.byOwner[*f.owner]
+	case f.candidate != nil:
+		source = idx.byCandidate[*f.candidate]
+	default:
+		grouped = false
+	}
+
+	result := make([]*meter.Bucket, 0)
+	for _, b := range source {
+		if f.match(b) {
+			result = append(result, b)
+		}
+	}
+	if grouped {
+		sortBuckets(result, f.order)
+	}
+	start, end := page(len(result), f.offset, f.limit)
+	return result[start:end]
+}
+
+// page returns the bounds of the page in a list of length n.
+func page(n int, offset, limit uint64) (int, int) {
+	if offset >= uint64(n) {
+		return n, n
+	}
+	end := uint64(n)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	return int(offset), int(end)
+}
+
+// indexCache caches staking indexes by block id. Since fork12 the index of a block is derived from
+// the index of its parent and the entries changed by the block, before that indexes are also keyed
+// by the staking lists they are built from, so that blocks which leave the lists untouched share it.
+type indexCache struct {
+	*lru.Cache
+	stateCreator *state.Creator
+}
+
+func newIndexCache(stateCreator *state.Creator) *indexCache {
+	c, err := lru.New(indexCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &indexCache{c, stateCreator}
+}
+
+// get returns the index of the block, and builds it if not cached.
+func (c *indexCache) get(h *block.Header) (*stakingIndex, error) {
+	if cached, ok := c.Get(h.ID()); ok {
+		return cached.(*stakingIndex), nil
+	}
+	s, err := c.stateCreator.NewState(h.StateRoot())
+	if err != nil {
+		return nil, err
+	}
+
+	var idx *stakingIndex
+	if s.IsStakingEntryLayout() {
+		idx, err = c.derive(h, s)
+	} else {
+		idx, err = c.build(s)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.Add(h.ID(), idx)
+	return idx, nil
+}
+
+// derive applies entries changed by the block to the index of its parent, if the parent is
+// indexed in per entry layout, otherwise it indexes the whole state.
+func (c *indexCache) derive(h *block.Header, s *state.State) (*stakingIndex, error) {
+	if cached, ok := c.Get(h.ParentID()); ok && h.Number() > 0 {
+		parent := cached.(*stakingIndex)
+		if parent.entryLayout {
+			diff, err := c.stateCreator.DiffStakingEntries(parent.stateRoot, h.StateRoot())
+			if err != nil {
+				return nil, err
+			}
+			idx := parent.apply(diff)
+			if idx == parent {
+				// shared with the parent, copy to hold the state root of the block
+				copied := *parent
+				idx = &copied
+			}
+			idx.stateRoot = h.StateRoot()
+			return idx, nil
+		}
+	}
+	idx := newStakingIndex(s.GetBucketList(), s.GetCandidateList())
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	idx.entryLayout = true
+	idx.stateRoot = h.StateRoot()
+	return idx, nil
+}
+
+func (c *indexCache) build(s *state.State) (*stakingIndex, error) {
+	key := indexKey{
+		buckets:    s.GetStorage(meter.StakingModuleAddr, meter.BucketListKey),
+		candidates: s.GetStorage(meter.StakingModuleAddr, meter.CandidateListKey),
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if cached, ok := c.Get(key); ok {
+		return cached.(*stakingIndex), nil
+	}
+
+	idx := newStakingIndex(s.GetBucketList(), s.GetCandidateList())
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	c.Add(key, idx)
+	return idx, nil
+}
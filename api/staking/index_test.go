@@ -0,0 +1,119 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package staking
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStakingIndex(t *testing.T) {
+	var (
+		alice = meter.BytesToAddress([]byte("alice"))
+		bob   = meter.BytesToAddress([]byte("bob"))
+		cand1 = meter.BytesToAddress([]byte("cand1"))
+		cand2 = meter.BytesToAddress([]byte("cand2"))
+	)
+	b1 := meter.NewBucket(alice, cand1, big.NewInt(100), meter.MTRG, 0, 0, 0, 1, 1)
+	b2 := meter.NewBucket(alice, cand2, big.NewInt(300), meter.MTRG, 0, 0, 0, 1, 2)
+	b3 := meter.NewBucket(bob, cand1, big.NewInt(200), meter.MTR, 0, 0, 0, 1, 3)
+	b3.Unbounded = true
+	b3.MatureTime = 50
+
+	db, _ := lvldb.NewMem()
+	st, _ := state.New(meter.Bytes32{}, db)
+	creator := state.NewCreator(db)
+	st.SetBucketList(meter.NewBucketList([]*meter.Bucket{b1, b2, b3}))
+	c1 := meter.NewCandidate(cand1, []byte("c1"), nil, nil, nil, 0, 0, 0)
+	c1.TotalVotes = big.NewInt(300)
+	c2 := meter.NewCandidate(cand2, []byte("c2"), nil, nil, nil, 0, 0, 0)
+	c2.TotalVotes = big.NewInt(400)
+	st.SetCandidateList(meter.NewCandidateList([]*meter.Candidate{c1, c2}))
+
+	root, _ := st.Stage().Commit()
+	h0 := new(block.Builder).StateRoot(root).Build().Header()
+	cache := newIndexCache(creator)
+	idx, err := cache.get(h0)
+	assert.Nil(t, err)
+	again, _ := cache.get(new(block.Builder).StateRoot(root).Timestamp(1).Build().Header())
+	assert.True(t, idx == again, "index should be reused if staking lists are unchanged")
+
+	assert.Equal(t, 2, len(idx.candidates))
+	assert.Equal(t, cand2, idx.candidates[0].Addr)
+	assert.Equal(t, cand1, idx.listed[0].Addr, "candidates are listed in list order by default")
+	assert.Equal(t, b2.BucketID, idx.byID[b2.BucketID].BucketID)
+
+	ids := func(buckets []*meter.Bucket) []meter.Bytes32 {
+		result := make([]meter.Bytes32, 0)
+		for _, b := range buckets {
+			result = append(result, b.BucketID)
+		}
+		return result
+	}
+	listed := []*meter.Bucket{b1, b2, b3}
+	sortBuckets(listed, idOrder)
+	assert.Equal(t, ids(st.GetBucketList().Buckets), ids(idx.buckets))
+
+	yes, mtr := true, meter.MTR
+	tests := []struct {
+		name   string
+		filter *bucketFilter
+		want   []*meter.Bucket
+	}{
+		{"list order", &bucketFilter{}, idx.buckets},
+		{"by id", &bucketFilter{order: idOrder}, listed},
+		{"by votes", &bucketFilter{order: votesOrder}, []*meter.Bucket{b2, b3, b1}},
+		{"by votes paged", &bucketFilter{order: votesOrder, offset: 1, limit: 1}, []*meter.Bucket{b3}},
+		{"out of range", &bucketFilter{offset: 3}, []*meter.Bucket{}},
+		{"owner by votes", &bucketFilter{owner: &alice, order: votesOrder}, []*meter.Bucket{b2, b1}},
+		{"candidate by votes", &bucketFilter{candidate: &cand1, order: votesOrder}, []*meter.Bucket{b3, b1}},
+		{"token", &bucketFilter{token: &mtr}, []*meter.Bucket{b3}},
+		{"unbounded", &bucketFilter{unbounded: &yes}, []*meter.Bucket{b3}},
+		{"immature", &bucketFilter{mature: &yes, now: 49}, []*meter.Bucket{}},
+		{"mature", &bucketFilter{mature: &yes, now: 50}, []*meter.Bucket{b3}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, ids(tt.want), ids(idx.filterBuckets(tt.filter)), tt.name)
+	}
+
+	// since fork12 the index of a block is derived from its parent
+	st.MigrateStakingStorage()
+	root, _ = st.Stage().Commit()
+	h1 := new(block.Builder).ParentID(h0.ID()).StateRoot(root).Build().Header()
+	idx, err = cache.get(h1)
+	assert.Nil(t, err)
+	assert.True(t, idx.entryLayout)
+
+	buckets := st.GetBucketList()
+	buckets.Remove(b1.BucketID)
+	buckets.Get(b2.BucketID).TotalVotes = big.NewInt(50)
+	b4 := meter.NewBucket(bob, cand2, big.NewInt(400), meter.MTRG, 0, 0, 0, 1, 4)
+	buckets.Add(b4)
+	st.SetBucketList(buckets)
+	candidates := st.GetCandidateList()
+	candidates.Get(cand1).TotalVotes = big.NewInt(500)
+	st.SetCandidateList(candidates)
+	root, _ = st.Stage().Commit()
+	h2 := new(block.Builder).ParentID(h1.ID()).StateRoot(root).Build().Header()
+
+	derived, err := cache.get(h2)
+	assert.Nil(t, err)
+	full := newStakingIndex(st.GetBucketList(), st.GetCandidateList())
+	assert.Equal(t, ids(full.buckets), ids(derived.buckets))
+	assert.Equal(t, ids(full.byVotes), ids(derived.byVotes))
+	assert.Equal(t, ids(full.byBucketID), ids(derived.byBucketID))
+	assert.Equal(t, ids(full.byCandidate[cand2]), ids(derived.byCandidate[cand2]))
+	assert.Equal(t, big.NewInt(50), derived.byID[b2.BucketID].TotalVotes)
+	assert.Nil(t, derived.byID[b1.BucketID])
+	assert.Equal(t, cand1, derived.candidates[0].Addr)
+	assert.Equal(t, h2.StateRoot(), derived.stateRoot)
+}
@@ -6,56 +6,223 @@
 package staking
 
 import (
+	"log/slog"
 	"math"
 	"net/http"
+	"net/url"
 	"strconv"
+	"sync"
 
 	"github.com/gorilla/mux"
 	"github.com/meterio/meter-pov/api/utils"
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/logdb"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/state"
 	"github.com/pkg/errors"
 )
 
+const maxStakingLimit = 1000
+
 type Staking struct {
 	chain        *chain.Chain
 	stateCreator *state.Creator
+	logDB        *logdb.LogDB
+	indexes      *indexCache
+	done         chan struct{}
+	wg           sync.WaitGroup
+	logger       *slog.Logger
 }
 
 func New(chain *chain.Chain,
-	stateCreator *state.Creator) *Staking {
-	return &Staking{chain: chain, stateCreator: stateCreator}
+	stateCreator *state.Creator, logDB *logdb.LogDB) *Staking {
+	st := &Staking{
+		chain:        chain,
+		stateCreator: stateCreator,
+		logDB:        logDB,
+		indexes:      newIndexCache(stateCreator),
+		done:         make(chan struct{}),
+		logger:       slog.With("api", "staking"),
+	}
+	st.wg.Add(1)
+	go st.indexLoop()
+	return st
+}
+
+// indexLoop keeps the staking index of best block up to date, so queries at best are served
+// without decoding the staking lists.
+func (st *Staking) indexLoop() {
+	defer st.wg.Done()
+
+	ticker := st.chain.NewTicker()
+	for {
+		best := st.chain.BestBlock().Header()
+		if err := st.indexTo(best); err != nil {
+			st.logger.Warn("failed to index staking", "block", best.Number(), "err", err)
+		}
+		select {
+		case <-st.done:
+			return
+		case <-ticker.C():
+		}
+	}
+}
+
+// indexTo indexes blocks after the last indexed one up to best in order, so the index of each
+// block is derived from its parent. Only best is indexed if it's too far from the last indexed one.
+func (st *Staking) indexTo(best *block.Header) error {
+	headers := []*block.Header{best}
+	for h := best; h.Number() > 0 && !st.indexes.Contains(h.ParentID()); {
+		if len(headers) == maxIndexSteps {
+			headers = headers[:1]
+			break
+		}
+		parent, err := st.chain.GetBlockHeader(h.ParentID())
+		if err != nil {
+			return err
+		}
+		headers = append(headers, parent)
+		h = parent
+	}
+	for i := len(headers) - 1; i >= 0; i-- {
+		if _, err := st.indexes.get(headers[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseUintQuery(query url.Values, key string, def uint64) (uint64, error) {
+	s := query.Get(key)
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseUint(s, 0, 63)
+	if err != nil {
+		return 0, utils.BadRequest(errors.WithMessage(err, key))
+	}
+	return n, nil
+}
+
+func parseBoolQuery(query url.Values, key string) (*bool, error) {
+	s := query.Get(key)
+	if s == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, key))
+	}
+	return &b, nil
+}
+
+func parseAddressQuery(query url.Values, key string) (*meter.Address, error) {
+	s := query.Get(key)
+	if s == "" {
+		return nil, nil
+	}
+	addr, err := meter.ParseAddress(s)
+	if err != nil {
+		return nil, utils.BadRequest(errors.WithMessage(err, key))
+	}
+	return &addr, nil
+}
+
+// parsePage parses offset and limit, all items are returned if limit is omitted.
+func parsePage(query url.Values) (offset, limit uint64, err error) {
+	if offset, err = parseUintQuery(query, "offset", 0); err != nil {
+		return
+	}
+	if limit, err = parseUintQuery(query, "limit", 0); err != nil {
+		return
+	}
+	if limit > maxStakingLimit {
+		err = utils.BadRequest(errors.Errorf("limit: should not exceed %v", maxStakingLimit))
+	}
+	return
+}
+
+func parseBucketFilter(query url.Values, h *block.Header) (*bucketFilter, error) {
+	f := &bucketFilter{now: h.Timestamp()}
+	var err error
+	if f.owner, err = parseAddressQuery(query, "owner"); err != nil {
+		return nil, err
+	}
+	if f.candidate, err = parseAddressQuery(query, "candidate"); err != nil {
+		return nil, err
+	}
+	if s := query.Get("token"); s != "" {
+		token, err := strconv.ParseUint(s, 0, 8)
+		if err != nil || (token != uint64(meter.MTR) && token != uint64(meter.MTRG)) {
+			return nil, utils.BadRequest(errors.New("token: should be 0 (MTR) or 1 (MTRG)"))
+		}
+		t := uint8(token)
+		f.token = &t
+	}
+	if f.unbounded, err = parseBoolQuery(query, "unbounded"); err != nil {
+		return nil, err
+	}
+	if f.mature, err = parseBoolQuery(query, "mature"); err != nil {
+		return nil, err
+	}
+	switch query.Get("sort") {
+	case "":
+	case "id":
+		f.order = idOrder
+	case "votes":
+		f.order = votesOrder
+	default:
+		return nil, utils.BadRequest(errors.New("sort: should be id or votes"))
+	}
+	if f.offset, f.limit, err = parsePage(query); err != nil {
+		return nil, err
+	}
+	return f, nil
 }
 
 func (st *Staking) handleGetCandidateList(w http.ResponseWriter, req *http.Request) error {
-	h, err := st.handleRevision(req.URL.Query().Get("revision"))
+	query := req.URL.Query()
+	h, err := st.handleRevision(query.Get("revision"))
 	if err != nil {
 		return err
 	}
-	state, err := st.stateCreator.NewState(h.StateRoot())
+	offset, limit, err := parsePage(query)
 	if err != nil {
 		return err
 	}
-	list := state.GetCandidateList()
-	candidateList := convertCandidateList(list)
-	return utils.WriteJSON(w, candidateList)
+	// candidates are in list order unless sorted by votes
+	order := query.Get("sort")
+	if order != "" && order != "votes" {
+		return utils.BadRequest(errors.New("sort: should be votes"))
+	}
+	idx, err := st.indexes.get(h)
+	if err != nil {
+		return err
+	}
+	list := idx.listed
+	if order == "votes" {
+		list = idx.candidates
+	}
+	start, end := page(len(list), offset, limit)
+	return utils.WriteJSON(w, convertCandidateList(list[start:end]))
 }
 
 func (st *Staking) handleGetBucketList(w http.ResponseWriter, req *http.Request) error {
-	h, err := st.handleRevision(req.URL.Query().Get("revision"))
+	query := req.URL.Query()
+	h, err := st.handleRevision(query.Get("revision"))
 	if err != nil {
 		return err
 	}
-	state, err := st.stateCreator.NewState(h.StateRoot())
+	filter, err := parseBucketFilter(query, h)
 	if err != nil {
 		return err
 	}
-	list := state.GetBucketList()
-	bucketList := convertBucketList(list)
-
-	return utils.WriteJSON(w, bucketList)
+	idx, err := st.indexes.get(h)
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(w, convertBucketList(idx.filterBuckets(filter)))
 }
 
 func (st *Staking) handleGetBucketByID(w http.ResponseWriter, req *http.Request) error {
@@ -63,44 +230,41 @@ func (st *Staking) handleGetBucketByID(w http.ResponseWriter, req *http.Request)
 	if err != nil {
 		return err
 	}
-	state, err := st.stateCreator.NewState(h.StateRoot())
+	bucketID, err := meter.ParseBytes32(mux.Vars(req)["id"])
 	if err != nil {
-		return err
+		return utils.BadRequest(errors.WithMessage(err, "id"))
 	}
-	list := state.GetBucketList()
-
-	id := mux.Vars(req)["id"]
-	bucketID, err := meter.ParseBytes32(id)
+	idx, err := st.indexes.get(h)
 	if err != nil {
 		return err
 	}
-	bucket := list.Get(bucketID)
-	converted := convertBucket(bucket)
-	return utils.WriteJSON(w, converted)
+	bucket, ok := idx.byID[bucketID]
+	if !ok {
+		return utils.WriteJSON(w, nil)
+	}
+	return utils.WriteJSON(w, convertBucket(bucket))
 }
 
 func (st *Staking) handleGetBucketsByOwner(w http.ResponseWriter, req *http.Request) error {
-	h, err := st.handleRevision(req.URL.Query().Get("revision"))
+	query := req.URL.Query()
+	h, err := st.handleRevision(query.Get("revision"))
 	if err != nil {
 		return err
 	}
-	state, err := st.stateCreator.NewState(h.StateRoot())
+	owner, err := meter.ParseAddress(mux.Vars(req)["owner"])
 	if err != nil {
-		return err
+		return utils.BadRequest(errors.WithMessage(err, "owner"))
 	}
-	list := state.GetBucketList()
-
-	ownerStr := mux.Vars(req)["owner"]
-	owner, err := meter.ParseAddress(ownerStr)
+	filter, err := parseBucketFilter(query, h)
 	if err != nil {
 		return err
 	}
-	buckets := list.GetByOwner(owner)
-	converted := make([]*Bucket, 0)
-	for _, b := range buckets {
-		converted = append(converted, convertBucket(b))
+	filter.owner = &owner
+	idx, err := st.indexes.get(h)
+	if err != nil {
+		return err
 	}
-	return utils.WriteJSON(w, converted)
+	return utils.WriteJSON(w, convertBucketList(idx.filterBuckets(filter)))
 }
 
 func (st *Staking) handleGetStakeholderList(w http.ResponseWriter, req *http.Request) error {
@@ -185,11 +349,18 @@ func (st *Staking) handleRevision(revision string) (*block.Header, error) {
 	return h, nil
 }
 
+func (st *Staking) Close() {
+	close(st.done)
+	st.wg.Wait()
+}
+
 func (st *Staking) Mount(root *mux.Router, pathPrefix string) {
 	sub := root.PathPrefix(pathPrefix).Subrouter()
 	sub.Path("/candidates").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(st.handleGetCandidateList))
 	sub.Path("/buckets").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(st.handleGetBucketList))
 	sub.Path("/buckets/{id}").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(st.handleGetBucketByID))
+	sub.Path("/buckets/{id}/history").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(st.handleGetBucketHistory))
+	sub.Path("/candidates/{address}/history").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(st.handleGetCandidateHistory))
 	sub.Path("/buckets/ownedby/{owner}").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(st.handleGetBucketsByOwner))
 	sub.Path("/stakeholders").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(st.handleGetStakeholderList))
 	sub.Path("/delegates").Methods("Get").HandlerFunc(utils.WrapHandlerFunc(st.handleGetDelegateList))
@@ -8,8 +8,9 @@ package staking
 import (
 	"bytes"
 	"math/big"
-	"sort"
 
+	"github.com/meterio/meter-pov/api/transactions"
+	"github.com/meterio/meter-pov/logdb"
 	"github.com/meterio/meter-pov/meter"
 )
 
@@ -25,19 +26,12 @@ type Candidate struct {
 	Buckets     []string      `json:"buckets"`    // all buckets voted for this candidate
 }
 
-func convertCandidateList(list *meter.CandidateList) []*Candidate {
-	candidateList := make([]*Candidate, 0)
-	for _, c := range list.ToList() {
-		candidateList = append(candidateList, convertCandidate(c))
+// convertCandidateList converts candidates, which are sorted by total votes in the staking index.
+func convertCandidateList(candidates []*meter.Candidate) []*Candidate {
+	candidateList := make([]*Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		candidateList = append(candidateList, convertCandidate(*c))
 	}
-	sort.SliceStable(candidateList, func(i, j int) bool {
-		voteI := new(big.Int)
-		voteJ := new(big.Int)
-		voteI.SetString(candidateList[i].TotalVotes, 10)
-		voteJ.SetString(candidateList[j].TotalVotes, 10)
-
-		return voteI.Cmp(voteJ) >= 0
-	})
 	return candidateList
 }
 
@@ -79,11 +73,10 @@ type Bucket struct {
 	CalcLastTime uint64        `json:"calcLastTime"`
 }
 
-func convertBucketList(list *meter.BucketList) []*Bucket {
-	bucketList := make([]*Bucket, 0)
-
-	for _, b := range list.ToList() {
-		bucketList = append(bucketList, convertBucket(&b))
+func convertBucketList(buckets []*meter.Bucket) []*Bucket {
+	bucketList := make([]*Bucket, 0, len(buckets))
+	for _, b := range buckets {
+		bucketList = append(bucketList, convertBucket(b))
 	}
 	return bucketList
 }
@@ -217,3 +210,44 @@ func convertValidatorReward(r meter.ValidatorReward) *ValidatorReward {
 		Rewards:     convertRewardInfo(r.Rewards),
 	}
 }
+
+// StakingRecord is a change of bucket or candidate made by a staking op.
+type StakingRecord struct {
+	Op         string               `json:"op"`
+	BucketID   *meter.Bytes32       `json:"bucketID,omitempty"` // omitted for candidate records
+	Owner      *meter.Address       `json:"owner,omitempty"`
+	Candidate  meter.Address        `json:"candidate"`
+	Value      string               `json:"value,omitempty"`
+	TotalVotes string               `json:"totalVotes"`
+	Autobid    uint8                `json:"autobid"`
+	Unbounded  bool                 `json:"unbounded"`
+	Removed    bool                 `json:"removed"`
+	Meta       transactions.LogMeta `json:"meta"`
+}
+
+func convertStakingRecord(r *logdb.StakingRecord) *StakingRecord {
+	record := &StakingRecord{
+		Op:         r.Op,
+		Candidate:  r.Candidate,
+		TotalVotes: r.TotalVotes.String(),
+		Autobid:    r.Autobid,
+		Unbounded:  r.Unbounded,
+		Removed:    r.Removed,
+		Meta: transactions.LogMeta{
+			BlockID:        r.BlockID,
+			BlockNumber:    r.BlockNumber,
+			BlockTimestamp: r.BlockTime,
+			TxID:           r.TxID,
+			TxOrigin:       r.TxOrigin,
+		},
+	}
+	if !r.BucketID.IsZero() {
+		bucketID, owner := r.BucketID, r.Owner
+		record.BucketID = &bucketID
+		record.Owner = &owner
+	}
+	if r.Value != nil {
+		record.Value = r.Value.String()
+	}
+	return record
+}
@@ -10,19 +10,22 @@ import (
 	"github.com/meterio/meter-pov/meter"
 )
 
-type blockReader struct {
+// BlockReader reads blocks of the trunk from a position, blocks leaving the trunk are read again as obsolete.
+type BlockReader struct {
 	chain       *chain.Chain
 	blockReader chain.BlockReader
 }
 
-func newBlockReader(chain *chain.Chain, position meter.Bytes32) *blockReader {
-	return &blockReader{
+// NewBlockReader creates a BlockReader reading blocks after position.
+func NewBlockReader(chain *chain.Chain, position meter.Bytes32) *BlockReader {
+	return &BlockReader{
 		chain:       chain,
 		blockReader: chain.NewBlockReader(position),
 	}
 }
 
-func (br *blockReader) Read() ([]interface{}, bool, error) {
+// Read returns a *BlockMessage for each block read, and whether more blocks were read.
+func (br *BlockReader) Read() ([]interface{}, bool, error) {
 	blocks, err := br.blockReader.Read()
 	if err != nil {
 		return nil, false, err
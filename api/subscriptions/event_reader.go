@@ -8,23 +8,33 @@ package subscriptions
 import (
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/tx"
 )
 
-type eventReader struct {
+// EventMatcher decides which events are read.
+type EventMatcher interface {
+	Match(event *tx.Event) bool
+}
+
+// EventReader reads matched events of trunk blocks from a position, events of blocks leaving the trunk
+// are read again as obsolete.
+type EventReader struct {
 	chain       *chain.Chain
-	filter      *EventFilter
+	filter      EventMatcher
 	blockReader chain.BlockReader
 }
 
-func newEventReader(chain *chain.Chain, position meter.Bytes32, filter *EventFilter) *eventReader {
-	return &eventReader{
+// NewEventReader creates an EventReader reading events matched by filter in blocks after position.
+func NewEventReader(chain *chain.Chain, position meter.Bytes32, filter EventMatcher) *EventReader {
+	return &EventReader{
 		chain:       chain,
 		filter:      filter,
 		blockReader: chain.NewBlockReader(position),
 	}
 }
 
-func (er *eventReader) Read() ([]interface{}, bool, error) {
+// Read returns an *EventMessage for each event read, and whether more blocks were read.
+func (er *EventReader) Read() ([]interface{}, bool, error) {
 	blocks, err := er.blockReader.Read()
 	if err != nil {
 		return nil, false, err
@@ -36,6 +46,7 @@ func (er *eventReader) Read() ([]interface{}, bool, error) {
 			return nil, false, err
 		}
 		txs := block.Transactions()
+		var blockLogIndex uint32
 		for i, receipt := range receipts {
 			for _, output := range receipt.Outputs {
 				for logIndex, event := range output.Events {
@@ -44,8 +55,11 @@ func (er *eventReader) Read() ([]interface{}, bool, error) {
 						if err != nil {
 							return nil, false, err
 						}
+						msg.TxIndex = uint32(i)
+						msg.BlockLogIndex = blockLogIndex
 						msgs = append(msgs, msg)
 					}
+					blockLogIndex++
 				}
 			}
 		}
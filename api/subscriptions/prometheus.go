@@ -0,0 +1,15 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package subscriptions
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	connectionsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "api_subscription_connections",
+		Help: "Count of open subscription websockets, by subject",
+	}, []string{"subject"})
+)
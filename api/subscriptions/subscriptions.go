@@ -17,6 +17,7 @@ import (
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type Subscriptions struct {
@@ -33,6 +34,8 @@ type msgReader interface {
 }
 
 func New(chain *chain.Chain, allowedOrigins []string, backtraceLimit uint32) *Subscriptions {
+	prometheus.Register(connectionsGauge)
+
 	return &Subscriptions{
 		logger:         slog.With("api", "sub"),
 		backtraceLimit: backtraceLimit,
@@ -55,15 +58,15 @@ func New(chain *chain.Chain, allowedOrigins []string, backtraceLimit uint32) *Su
 	}
 }
 
-func (s *Subscriptions) handleBlockReader(w http.ResponseWriter, req *http.Request) (*blockReader, error) {
+func (s *Subscriptions) handleBlockReader(w http.ResponseWriter, req *http.Request) (*BlockReader, error) {
 	position, err := s.parsePosition(req.URL.Query().Get("pos"))
 	if err != nil {
 		return nil, err
 	}
-	return newBlockReader(s.chain, position), nil
+	return NewBlockReader(s.chain, position), nil
 }
 
-func (s *Subscriptions) handleEventReader(w http.ResponseWriter, req *http.Request) (*eventReader, error) {
+func (s *Subscriptions) handleEventReader(w http.ResponseWriter, req *http.Request) (*EventReader, error) {
 	position, err := s.parsePosition(req.URL.Query().Get("pos"))
 	if err != nil {
 		return nil, err
@@ -100,7 +103,7 @@ func (s *Subscriptions) handleEventReader(w http.ResponseWriter, req *http.Reque
 		Topic3:  t3,
 		Topic4:  t4,
 	}
-	return newEventReader(s.chain, position, eventFilter), nil
+	return NewEventReader(s.chain, position, eventFilter), nil
 }
 
 func (s *Subscriptions) handleTransferReader(w http.ResponseWriter, req *http.Request) (*transferReader, error) {
@@ -173,7 +176,10 @@ func (s *Subscriptions) handleSubject(w http.ResponseWriter, req *http.Request)
 		return nil
 	}
 
+	subject := mux.Vars(req)["subject"]
+	connectionsGauge.WithLabelValues(subject).Inc()
 	defer func() {
+		connectionsGauge.WithLabelValues(subject).Dec()
 		if err := conn.Close(); err != nil {
 			s.logger.Debug("close websocket", "err", err)
 		}
@@ -114,6 +114,10 @@ type EventMessage struct {
 	Data     string          `json:"data"`
 	Meta     LogMeta         `json:"meta"`
 	Obsolete bool            `json:"obsolete"`
+
+	// position of the event in the block, not piped but used by the eth compatible subscriptions
+	TxIndex       uint32 `json:"-"`
+	BlockLogIndex uint32 `json:"-"`
 }
 
 func convertEvent(header *block.Header, tx *tx.Transaction, event *tx.Event, obsolete bool, logIndex int) (*EventMessage, error) {
@@ -168,49 +168,53 @@ func (t *Transactions) handleSendEthRawTransaction(w http.ResponseWriter, req *h
 		return utils.BadRequest(errors.New("body: empty body"))
 	}
 
-	var sendTx = func(tx *tx.Transaction) error {
-		signer, _ := tx.Signer()
-		if strings.ToLower(signer.String()) == "0x0e369a2e02912dba872e72d6c0b661e9617e0d9c" {
-			t.logger.Warn("tx from black listed address, skip adding this to txpool")
-			return errors.New("blacklisted address, not allowed in txpool")
-		}
-		if err := t.pool.Add(tx); err != nil {
-			t.logger.Warn("failed to add tx", "err", err)
-			if txpool.IsBadTx(err) {
-				return utils.BadRequest(err)
-			}
-			if txpool.IsTxRejected(err) {
-				return utils.Forbidden(err)
-			}
-			return utils.BadRequest(err)
+	if hasKey(m, "raw") {
+		tx, err := t.SendEthRawTransaction(m["raw"].(string))
+		if err != nil {
+			return err
 		}
 		return utils.WriteJSON(w, map[string]string{
 			"id": tx.ID().String(),
 		})
 	}
+	return utils.BadRequest(err)
+}
+
+// SendEthRawTransaction decodes a hex encoded ethereum transaction, converts it into a native tx and adds it to txpool.
+func (t *Transactions) SendEthRawTransaction(raw string) (*tx.Transaction, error) {
+	rawBytes, _ := hex.DecodeString(strings.Replace(raw, "0x", "", 1))
+	ethTx := types.Transaction{}
+	err := ethTx.UnmarshalBinary(rawBytes)
+	if err != nil {
+		t.logger.Error("unmarshal raw failed", "err", err)
+		return nil, utils.BadRequest(err)
+	}
+	bestBlock := t.chain.BestBlock()
+	genID, _ := t.chain.GetAncestorBlockID(bestBlock.BlockHeader.ID(), 0)
+	chainTag := genID[len(genID)-1]
+	bestBlockID := bestBlock.BlockHeader.ID()
+	blockRef := tx.NewBlockRefFromID(bestBlockID)
+	nativeTx, err := tx.NewTransactionFromEthTx(&ethTx, chainTag, blockRef, true)
+	if err != nil {
+		return nil, utils.BadRequest(err)
+	}
 
-	if hasKey(m, "raw") {
-		raw := strings.Replace(m["raw"].(string), "0x", "", 1)
-		rawBytes, _ := hex.DecodeString(raw)
-		ethTx := types.Transaction{}
-		err := ethTx.UnmarshalBinary(rawBytes)
-		if err != nil {
-			t.logger.Error("unmarshal raw failed", "err", err)
-			return utils.BadRequest(err)
+	signer, _ := nativeTx.Signer()
+	if strings.ToLower(signer.String()) == "0x0e369a2e02912dba872e72d6c0b661e9617e0d9c" {
+		t.logger.Warn("tx from black listed address, skip adding this to txpool")
+		return nil, errors.New("blacklisted address, not allowed in txpool")
+	}
+	if err := t.pool.AddLocal(nativeTx); err != nil {
+		t.logger.Warn("failed to add tx", "err", err)
+		if txpool.IsBadTx(err) {
+			return nil, utils.BadRequest(err)
 		}
-		bestBlock := t.chain.BestBlock()
-		genID, _ := t.chain.GetAncestorBlockID(bestBlock.BlockHeader.ID(), 0)
-		chainTag := genID[len(genID)-1]
-		bestBlockID := bestBlock.BlockHeader.ID()
-		blockRef := tx.NewBlockRefFromID(bestBlockID)
-		nativeTx, err := tx.NewTransactionFromEthTx(&ethTx, chainTag, blockRef, true)
-		if err != nil {
-			return utils.BadRequest(err)
-		} else {
-			return sendTx(nativeTx)
+		if txpool.IsTxRejected(err) {
+			return nil, utils.Forbidden(err)
 		}
+		return nil, utils.BadRequest(err)
 	}
-	return utils.BadRequest(err)
+	return nativeTx, nil
 }
 
 func (t *Transactions) handleSendTransaction(w http.ResponseWriter, req *http.Request) error {
@@ -226,7 +230,7 @@ func (t *Transactions) handleSendTransaction(w http.ResponseWriter, req *http.Re
 		return utils.BadRequest(errors.New("body: empty body"))
 	}
 	var sendTx = func(tx *tx.Transaction) error {
-		if err := t.pool.Add(tx); err != nil {
+		if err := t.pool.AddLocal(tx); err != nil {
 			if txpool.IsBadTx(err) {
 				return utils.BadRequest(err)
 			}
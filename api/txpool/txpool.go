@@ -0,0 +1,180 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/meterio/meter-pov/api/utils"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/txpool"
+	"github.com/pkg/errors"
+)
+
+type TxPool struct {
+	chain      *chain.Chain
+	pool       *txpool.TxPool
+	adminToken string
+	logger     *slog.Logger
+
+	// inspecting the pool buys gas of every tx, statuses are inspected once per best block
+	cacheLock     sync.Mutex
+	cachedBlockID meter.Bytes32
+	cached        []*txpool.TxStatus
+}
+
+// New creates txpool api, eviction is disabled if admin token is empty.
+func New(chain *chain.Chain, pool *txpool.TxPool, adminToken string) *TxPool {
+	return &TxPool{
+		chain:      chain,
+		pool:       pool,
+		adminToken: adminToken,
+		logger:     slog.With("api", "txpool"),
+	}
+}
+
+// inspect returns statuses of txs in the pool, as of the best block.
+func (p *TxPool) inspect() ([]*txpool.TxStatus, error) {
+	p.cacheLock.Lock()
+	defer p.cacheLock.Unlock()
+
+	bestID := p.chain.BestBlock().ID()
+	if p.cached != nil && p.cachedBlockID == bestID {
+		return p.cached, nil
+	}
+	statuses, err := p.pool.Inspect()
+	if err != nil {
+		return nil, err
+	}
+	p.cachedBlockID, p.cached = bestID, statuses
+	return statuses, nil
+}
+
+func (p *TxPool) resetCache() {
+	p.cacheLock.Lock()
+	defer p.cacheLock.Unlock()
+	p.cached = nil
+}
+
+func (p *TxPool) handleGetContent(w http.ResponseWriter, req *http.Request) error {
+	statuses, err := p.inspect()
+	if err != nil {
+		return err
+	}
+	content := &Content{
+		Pending: make(map[string][]*PoolTx),
+		Queued:  make(map[string][]*PoolTx),
+	}
+	for _, s := range statuses {
+		origin := s.Origin.String()
+		if s.Executable {
+			content.Pending[origin] = append(content.Pending[origin], convertStatus(s))
+		} else {
+			content.Queued[origin] = append(content.Queued[origin], convertStatus(s))
+		}
+	}
+	return utils.WriteJSON(w, content)
+}
+
+func (p *TxPool) handleGetStatus(w http.ResponseWriter, req *http.Request) error {
+	statuses, err := p.inspect()
+	if err != nil {
+		return err
+	}
+	var status Status
+	for _, s := range statuses {
+		if s.Executable {
+			status.Pending++
+		} else {
+			status.Queued++
+		}
+	}
+	return utils.WriteJSON(w, &status)
+}
+
+func (p *TxPool) handleGetInspect(w http.ResponseWriter, req *http.Request) error {
+	statuses, err := p.inspect()
+	if err != nil {
+		return err
+	}
+	inspect := &Inspect{
+		Pending: make(map[string]map[string]string),
+		Queued:  make(map[string]map[string]string),
+	}
+	for _, s := range statuses {
+		group := inspect.Queued
+		if s.Executable {
+			group = inspect.Pending
+		}
+		origin := s.Origin.String()
+		if group[origin] == nil {
+			group[origin] = make(map[string]string)
+		}
+		group[origin][s.Tx.ID().String()] = summarize(s)
+	}
+	return utils.WriteJSON(w, inspect)
+}
+
+// checkAdmin verifies the bearer token of admin request.
+func (p *TxPool) checkAdmin(req *http.Request) error {
+	if p.adminToken == "" {
+		return utils.Forbidden(errors.New("admin api disabled"))
+	}
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(p.adminToken)) != 1 {
+		return utils.HTTPError(errors.New("invalid admin token"), http.StatusUnauthorized)
+	}
+	return nil
+}
+
+func (p *TxPool) handleEvictTx(w http.ResponseWriter, req *http.Request) error {
+	if err := p.checkAdmin(req); err != nil {
+		return err
+	}
+	id, err := meter.ParseBytes32(mux.Vars(req)["id"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "id"))
+	}
+	result := &EvictResult{}
+	if p.pool.Remove(id) {
+		result.Removed = 1
+		p.resetCache()
+	}
+	p.logger.Info("tx evicted by admin", "id", id, "removed", result.Removed)
+	return utils.WriteJSON(w, result)
+}
+
+func (p *TxPool) handleEvictAccount(w http.ResponseWriter, req *http.Request) error {
+	if err := p.checkAdmin(req); err != nil {
+		return err
+	}
+	addr, err := meter.ParseAddress(mux.Vars(req)["address"])
+	if err != nil {
+		return utils.BadRequest(errors.WithMessage(err, "address"))
+	}
+	result := &EvictResult{Removed: p.pool.RemoveByOrigin(addr)}
+	if result.Removed > 0 {
+		p.resetCache()
+	}
+	p.logger.Info("account txs evicted by admin", "origin", addr, "removed", result.Removed)
+	return utils.WriteJSON(w, result)
+}
+
+func (p *TxPool) Mount(root *mux.Router, pathPrefix string) {
+	sub := root.PathPrefix(pathPrefix).Subrouter()
+
+	sub.Path("/content").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(p.handleGetContent))
+	sub.Path("/status").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(p.handleGetStatus))
+	sub.Path("/inspect").Methods("GET").HandlerFunc(utils.WrapHandlerFunc(p.handleGetInspect))
+	sub.Path("/tx/{id}").Methods("DELETE").HandlerFunc(utils.WrapHandlerFunc(p.handleEvictTx))
+	sub.Path("/account/{address}").Methods("DELETE").HandlerFunc(utils.WrapHandlerFunc(p.handleEvictAccount))
+}
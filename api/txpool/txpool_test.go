@@ -0,0 +1,154 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+	txpoolapi "github.com/meterio/meter-pov/api/txpool"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/meterio/meter-pov/txpool"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminToken = "secret"
+
+var ts *httptest.Server
+
+func TestTxPool(t *testing.T) {
+	pool, txs := initTxPoolServer(t)
+	defer ts.Close()
+	defer pool.Close()
+	origin := genesis.DevAccounts()[0].Address.String()
+
+	var content txpoolapi.Content
+	httpDo(t, http.MethodGet, "/txpool/content", "", http.StatusOK, &content)
+	assert.Equal(t, 2, len(content.Pending[origin])+len(content.Queued[origin]))
+
+	var status txpoolapi.Status
+	httpDo(t, http.MethodGet, "/txpool/status", "", http.StatusOK, &status)
+	assert.Equal(t, uint64(2), status.Pending+status.Queued)
+
+	var inspect txpoolapi.Inspect
+	httpDo(t, http.MethodGet, "/txpool/inspect", "", http.StatusOK, &inspect)
+	summaries := inspect.Pending[origin]
+	if summaries == nil {
+		summaries = inspect.Queued[origin]
+	}
+	assert.Contains(t, summaries[txs[0].ID().String()], "21000 gas")
+
+	// statuses are cached until the best block changes
+	pool.Remove(txs[1].ID())
+	httpDo(t, http.MethodGet, "/txpool/status", "", http.StatusOK, &status)
+	assert.Equal(t, uint64(2), status.Pending+status.Queued)
+
+	// admin token is required for eviction
+	httpDo(t, http.MethodDelete, "/txpool/tx/"+txs[0].ID().String(), "", http.StatusUnauthorized, nil)
+	httpDo(t, http.MethodDelete, "/txpool/tx/"+txs[0].ID().String(), "wrong", http.StatusUnauthorized, nil)
+
+	var result txpoolapi.EvictResult
+	httpDo(t, http.MethodDelete, "/txpool/tx/"+txs[0].ID().String(), adminToken, http.StatusOK, &result)
+	assert.Equal(t, 1, result.Removed)
+	assert.Equal(t, 0, pool.Len())
+
+	// eviction resets the cache
+	httpDo(t, http.MethodGet, "/txpool/status", "", http.StatusOK, &status)
+	assert.Equal(t, uint64(0), status.Pending+status.Queued)
+	httpDo(t, http.MethodDelete, "/txpool/account/"+origin, adminToken, http.StatusOK, &result)
+	assert.Equal(t, 0, result.Removed)
+}
+
+func TestAdminDisabled(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	b, _, _ := genesis.NewDevnet().Build(state.NewCreator(db))
+	c, _ := chain.New(db, b, false)
+	pool := txpool.New(c, state.NewCreator(db), txpool.Options{Limit: 10, LimitPerAccount: 2, MaxLifetime: time.Minute})
+	defer pool.Close()
+
+	router := mux.NewRouter()
+	txpoolapi.New(c, pool, "").Mount(router, "/txpool")
+	ts = httptest.NewServer(router)
+	defer ts.Close()
+	httpDo(t, http.MethodDelete, "/txpool/account/"+genesis.DevAccounts()[0].Address.String(), "", http.StatusForbidden, nil)
+}
+
+func initTxPoolServer(t *testing.T) (*txpool.TxPool, []*tx.Transaction) {
+	meter.InitBlockChainConfig("test")
+	db, _ := lvldb.NewMem()
+	stateC := state.NewCreator(db)
+	b, _, err := genesis.NewDevnet().Build(stateC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := chain.New(db, b, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := txpool.New(c, stateC, txpool.Options{Limit: 10, LimitPerAccount: 2, MaxLifetime: time.Minute})
+
+	to := meter.BytesToAddress([]byte("to"))
+	txs := make([]*tx.Transaction, 0)
+	for i := 0; i < 2; i++ {
+		trx := new(tx.Builder).
+			ChainTag(c.Tag()).
+			Expiration(100).
+			Gas(21000).
+			Nonce(uint64(i)).
+			Clause(tx.NewClause(&to)).
+			Build()
+		sig, err := crypto.Sign(trx.SigningHash().Bytes(), genesis.DevAccounts()[0].PrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		trx = trx.WithSignature(sig)
+		if err := pool.Add(trx); err != nil {
+			t.Fatal(err)
+		}
+		txs = append(txs, trx)
+	}
+
+	router := mux.NewRouter()
+	txpoolapi.New(c, pool, adminToken).Mount(router, "/txpool")
+	ts = httptest.NewServer(router)
+	return pool, txs
+}
+
+func httpDo(t *testing.T, method string, path string, token string, status int, v interface{}) {
+	req, err := http.NewRequest(method, ts.URL+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, status, res.StatusCode, string(r))
+	if v != nil {
+		if err := json.Unmarshal(r, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/txpool"
+)
+
+// PoolTx is a tx in the pool.
+type PoolTx struct {
+	ID           meter.Bytes32         `json:"id"`
+	Origin       meter.Address         `json:"origin"`
+	BlockRef     string                `json:"blockRef"`
+	Expiration   uint32                `json:"expiration"`
+	Clauses      int                   `json:"clauses"`
+	GasPriceCoef uint8                 `json:"gasPriceCoef"`
+	GasPrice     *math.HexOrDecimal256 `json:"gasPrice"`
+	Gas          uint64                `json:"gas"`
+	Nonce        math.HexOrDecimal64   `json:"nonce"`
+	DependsOn    *meter.Bytes32        `json:"dependsOn"`
+	TimeAdded    uint64                `json:"timeAdded"` // unix timestamp
+	Lifetime     uint64                `json:"lifetime"`  // seconds left before washed out
+	Reason       string                `json:"reason,omitempty"`
+}
+
+// Content lists txs in the pool, grouped by origin.
+type Content struct {
+	Pending map[string][]*PoolTx `json:"pending"`
+	Queued  map[string][]*PoolTx `json:"queued"`
+}
+
+type Status struct {
+	Pending uint64 `json:"pending"`
+	Queued  uint64 `json:"queued"`
+}
+
+// Inspect summarizes txs in the pool, grouped by origin and tx id.
+type Inspect struct {
+	Pending map[string]map[string]string `json:"pending"`
+	Queued  map[string]map[string]string `json:"queued"`
+}
+
+type EvictResult struct {
+	Removed int `json:"removed"`
+}
+
+func convertStatus(s *txpool.TxStatus) *PoolTx {
+	t := s.Tx
+	br := t.BlockRef()
+	return &PoolTx{
+		ID:           t.ID(),
+		Origin:       s.Origin,
+		BlockRef:     hexutil.Encode(br[:]),
+		Expiration:   t.Expiration(),
+		Clauses:      len(t.Clauses()),
+		GasPriceCoef: t.GasPriceCoef(),
+		GasPrice:     (*math.HexOrDecimal256)(s.GasPrice),
+		Gas:          t.Gas(),
+		Nonce:        math.HexOrDecimal64(t.Nonce()),
+		DependsOn:    t.DependsOn(),
+		TimeAdded:    uint64(s.TimeAdded.Unix()),
+		Lifetime:     uint64(s.Lifetime.Seconds()),
+		Reason:       s.Reason,
+	}
+}
+
+// summarize describes the tx in one line, e.g.
+// 0x7567d83b7b8d80addcb281a71d54fc7b3364ffed: 1000000000000000000 MTRG + 21000 gas × 500000000000 wei
+func summarize(s *txpool.TxStatus) string {
+	clauses := s.Tx.Clauses()
+	parts := make([]string, 0, len(clauses))
+	for _, c := range clauses {
+		to := "contract creation"
+		if c.To() != nil {
+			to = c.To().String()
+		}
+		token := "MTR"
+		if c.Token() == meter.MTRG {
+			token = "MTRG"
+		}
+		parts = append(parts, fmt.Sprintf("%v: %v %v", to, c.Value(), token))
+	}
+	summary := fmt.Sprintf("%v + %v gas × %v wei", strings.Join(parts, ", "), s.Tx.Gas(), s.GasPrice)
+	if s.Reason != "" {
+		summary += " (" + s.Reason + ")"
+	}
+	return summary
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"time"
 )
 
 type httpError struct {
@@ -50,9 +51,12 @@ func Forbidden(cause error) error {
 type HandlerFunc func(http.ResponseWriter, *http.Request) error
 
 // WrapHandlerFunc convert HandlerFunc to http.HandlerFunc.
+// The time taken by f is measured per route.
 func WrapHandlerFunc(f HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
 		err := f(w, r)
+		requestDurationHistogram.WithLabelValues(r.Method, routeOf(r), statusOf(err)).Observe(time.Since(start).Seconds())
 		if err != nil {
 			if he, ok := err.(*httpError); ok {
 				if he.cause != nil {
@@ -0,0 +1,49 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "api_request_duration_seconds",
+		Help:    "Time taken to serve api requests, by method, route and status",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+	}, []string{"method", "route", "status"})
+)
+
+// RegisterMetrics registers the metrics of api handlers.
+func RegisterMetrics() {
+	prometheus.Register(requestDurationHistogram)
+}
+
+// routeOf returns the path template of the route matched by r, so that paths with
+// variables are measured as one route.
+func routeOf(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return tpl
+		}
+	}
+	return "unknown"
+}
+
+// statusOf returns the status label of a request handled with the given error.
+func statusOf(err error) string {
+	if err == nil {
+		return strconv.Itoa(http.StatusOK)
+	}
+	if he, ok := err.(*httpError); ok {
+		return strconv.Itoa(he.status)
+	}
+	return strconv.Itoa(http.StatusInternalServerError)
+}
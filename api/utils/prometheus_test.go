@@ -0,0 +1,39 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestDurationLabels(t *testing.T) {
+	router := mux.NewRouter()
+	router.Path("/blocks/{revision}").Methods("GET").HandlerFunc(WrapHandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		if mux.Vars(r)["revision"] == "bad" {
+			return BadRequest(errors.New("revision: invalid"))
+		}
+		return WriteJSON(w, "ok")
+	}))
+
+	for _, path := range []string{"/blocks/1", "/blocks/2", "/blocks/bad"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", path, nil))
+	}
+
+	// paths of a route share one series per status
+	assert.Equal(t, 2, testutil.CollectAndCount(requestDurationHistogram))
+	assert.True(t, requestDurationHistogram.DeleteLabelValues("GET", "/blocks/{revision}", "200"))
+	assert.True(t, requestDurationHistogram.DeleteLabelValues("GET", "/blocks/{revision}", "400"))
+
+	assert.Equal(t, "unknown", routeOf(httptest.NewRequest("GET", "/blocks/1", nil)))
+	assert.Equal(t, "500", statusOf(errors.New("internal")))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/meterio/meter-pov/state"
 	"github.com/meterio/meter-pov/tx"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // definition for DraftBlock
@@ -28,6 +29,9 @@ type DraftBlock struct {
 
 	SuccessProcessed bool
 	ProcessError     error
+
+	// span of proposing or receiving this block, which the commit is traced under
+	SpanContext trace.SpanContext
 }
 
 func (pb *DraftBlock) ToString() string {
@@ -31,16 +31,6 @@ const (
 var ErrNotFound = errors.New("not found")
 var ErrBlockExist = errors.New("block already exists")
 var errParentNotFinalized = errors.New("parent is not finalized")
-var (
-	bestHeightGauge = prometheus.NewGauge(prometheus.GaugeOpts{
-		Name: "best_height",
-		Help: "BestBlock height",
-	})
-	bestQCHeightGauge = prometheus.NewGauge(prometheus.GaugeOpts{
-		Name: "best_qc_height",
-		Help: "BestQC height",
-	})
-)
 
 // Chain describes a persistent block chain.
 // It's thread-safe.
@@ -70,6 +60,8 @@ type caches struct {
 func New(kv kv.GetPutter, genesisBlock *block.Block, verbose bool) (*Chain, error) {
 	prometheus.Register(bestQCHeightGauge)
 	prometheus.Register(bestHeightGauge)
+	prometheus.Register(addBlockDurationHistogram)
+	prometheus.Register(blocksAddedCounter)
 
 	if genesisBlock.Number() != 0 {
 		return nil, errors.New("genesis number != 0")
@@ -300,6 +292,7 @@ func (c *Chain) AddBlock(newBlock *block.Block, escortQC *block.QuorumCert, rece
 	c.rw.Lock()
 	defer c.rw.Unlock()
 
+	start := time.Now()
 	newBlockID := newBlock.ID()
 
 	if header, err := c.getBlockHeader(newBlockID); err != nil {
@@ -408,10 +401,64 @@ func (c *Chain) AddBlock(newBlock *block.Block, escortQC *block.QuorumCert, rece
 	c.caches.rawBlocks.Add(newBlockID, newRawBlock(raw, newBlock))
 	c.caches.receipts.Add(newBlockID, receipts)
 
+	if isTrunk {
+		blocksAddedCounter.WithLabelValues("trunk").Inc()
+	} else {
+		blocksAddedCounter.WithLabelValues("fork").Inc()
+	}
+	addBlockDurationHistogram.Observe(time.Since(start).Seconds())
+
 	c.tick.Broadcast()
 	return fork, nil
 }
 
+// InitPivot makes the finalized pivot block the best block of a fresh chain, so that blocks
+// after it could be added without ancestors. It's used by snap sync once the pivot state is
+// downloaded, receipts of the pivot block are left empty.
+func (c *Chain) InitPivot(pivot *block.Block, escortQC *block.QuorumCert) error {
+	c.rw.Lock()
+	defer c.rw.Unlock()
+
+	if c.bestBlock.Number() != 0 {
+		return errors.New("chain is not fresh")
+	}
+	if escortQC == nil || escortQC.QCHeight != pivot.Number() {
+		return errors.New("escort QC mismatch")
+	}
+
+	pivotID := pivot.ID()
+	raw := block.BlockEncodeBytes(pivot)
+
+	batch := c.kv.NewBatch()
+	if err := saveBlockRaw(batch, pivotID, raw); err != nil {
+		return err
+	}
+	if err := saveBlockReceipts(batch, pivotID, tx.Receipts{}); err != nil {
+		return err
+	}
+	if err := c.ancestorTrie.Update(batch, pivot.Number(), pivotID, pivot.ParentID()); err != nil {
+		return err
+	}
+	if err := saveBestBlockID(batch, pivotID); err != nil {
+		return err
+	}
+	if err := saveBestQC(batch, escortQC); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	c.bestBlock = pivot
+	c.bestQC = escortQC
+	bestHeightGauge.Set(float64(pivot.Number()))
+	bestQCHeightGauge.Set(float64(escortQC.QCHeight))
+	c.caches.rawBlocks.Add(pivotID, newRawBlock(raw, pivot))
+
+	c.tick.Broadcast()
+	return nil
+}
+
 func (c *Chain) IsBlockFinalized(id meter.Bytes32) bool {
 	return block.Number(id) <= c.bestBlock.Number()
 }
@@ -861,6 +908,11 @@ func (c *Chain) UpdatePruneStateHead(num uint32) error {
 	return savePruneStateHead(c.kv, num)
 }
 
+// PutPruneStateHead puts the prune state head into w, so that it can be written along with the pruned nodes.
+func (c *Chain) PutPruneStateHead(w kv.Putter, num uint32) error {
+	return savePruneStateHead(w, num)
+}
+
 func (c *Chain) GetStateSnapshotNum() (uint32, error) {
 	return loadStateSnapshotNum(c.kv)
 }
@@ -918,6 +970,16 @@ func (c *Chain) DraftLen() int {
 	return 0
 }
 
+// GetDraftStateRoots returns the state roots of drafts, which are committed to db ahead of their blocks.
+func (c *Chain) GetDraftStateRoots() []meter.Bytes32 {
+	c.drw.RLock()
+	defer c.drw.RUnlock()
+	if c.proposalMap == nil {
+		return nil
+	}
+	return c.proposalMap.StateRoots()
+}
+
 func (c *Chain) PruneDraftsUpTo(lastCommitted *block.DraftBlock) {
 	c.drw.Lock()
 	defer c.drw.Unlock()
@@ -0,0 +1,28 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package chain
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	bestHeightGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "best_height",
+		Help: "BestBlock height",
+	})
+	bestQCHeightGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "best_qc_height",
+		Help: "BestQC height",
+	})
+	addBlockDurationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "chain_add_block_duration_seconds",
+		Help:    "Time taken to import a block into the chain",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+	})
+	blocksAddedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "chain_blocks_added_total",
+		Help: "Counter of blocks imported into the chain, by branch (trunk or fork)",
+	}, []string{"branch"})
+)
@@ -47,6 +47,14 @@ func (p *ProposalMap) GetProposalsUpTo(committedBlkID meter.Bytes32, qcHigh *blo
 	return make([]*block.DraftBlock, 0)
 }
 
+func (p *ProposalMap) StateRoots() []meter.Bytes32 {
+	roots := make([]meter.Bytes32, 0, len(p.proposals))
+	for _, prop := range p.proposals {
+		roots = append(roots, prop.ProposedBlock.StateRoot())
+	}
+	return roots
+}
+
 func (p *ProposalMap) Has(blkID meter.Bytes32) bool {
 	blk, ok := p.proposals[blkID]
 	if ok && blk != nil {
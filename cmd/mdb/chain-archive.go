@@ -0,0 +1,412 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// A chain archive is a gzip stream of frames, each frame is `kind(1) | len(4, big endian) | payload`.
+// It starts with a header frame, followed by block frames carrying rlp encoded escorted blocks.
+// A checksum frame follows every archiveChecksumInterval blocks and ends the archive, its payload is
+// the blake2b hash over all block payloads so far.
+const (
+	archiveVersion          = 1
+	archiveChecksumInterval = 1024
+	maxArchiveFrameSize     = 16 * 1024 * 1024
+
+	frameHeader   byte = 0
+	frameBlock    byte = 1
+	frameChecksum byte = 2
+)
+
+var (
+	archiveMagic = []byte("meter-chain")
+
+	errArchiveChecksum  = errors.New("archive checksum mismatch")
+	errArchiveTruncated = errors.New("archive truncated")
+)
+
+type archiveHeader struct {
+	Version   uint
+	GenesisID meter.Bytes32
+	From      uint32
+	To        uint32
+}
+
+type archiveWriter struct {
+	gz      *gzip.Writer
+	hasher  hash.Hash
+	count   uint32
+	pending bool // whether blocks are written since last checksum
+}
+
+func newArchiveWriter(w io.Writer, header *archiveHeader) (*archiveWriter, error) {
+	// gzip header fields are left empty to make the output deterministic
+	gz, err := gzip.NewWriterLevel(w, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	aw := &archiveWriter{gz: gz, hasher: meter.NewBlake2b()}
+	if _, err := gz.Write(archiveMagic); err != nil {
+		return nil, err
+	}
+	data, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return nil, err
+	}
+	if err := aw.writeFrame(frameHeader, data); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+func (w *archiveWriter) writeFrame(kind byte, payload []byte) error {
+	var prefix [5]byte
+	prefix[0] = kind
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(payload)))
+	if _, err := w.gz.Write(prefix[:]); err != nil {
+		return err
+	}
+	_, err := w.gz.Write(payload)
+	return err
+}
+
+func (w *archiveWriter) writeChecksum() error {
+	w.pending = false
+	return w.writeFrame(frameChecksum, w.hasher.Sum(nil))
+}
+
+func (w *archiveWriter) Write(eb *block.EscortedBlock) error {
+	data, err := rlp.EncodeToBytes(eb)
+	if err != nil {
+		return err
+	}
+	if err := w.writeFrame(frameBlock, data); err != nil {
+		return err
+	}
+	w.hasher.Write(data)
+	w.count++
+	w.pending = true
+	if w.count%archiveChecksumInterval == 0 {
+		return w.writeChecksum()
+	}
+	return nil
+}
+
+// Close ends the archive with a checksum, the underlying writer is not closed.
+func (w *archiveWriter) Close() error {
+	if w.pending || w.count == 0 {
+		if err := w.writeChecksum(); err != nil {
+			return err
+		}
+	}
+	return w.gz.Close()
+}
+
+type archiveReader struct {
+	gz     *gzip.Reader
+	header archiveHeader
+	hasher hash.Hash
+	count  uint32
+	blocks []*block.EscortedBlock // verified blocks not read yet
+}
+
+func newArchiveReader(r io.Reader) (*archiveReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	magic := make([]byte, len(archiveMagic))
+	if _, err := io.ReadFull(gz, magic); err != nil || !bytes.Equal(magic, archiveMagic) {
+		return nil, errors.New("not a chain archive")
+	}
+	ar := &archiveReader{gz: gz, hasher: meter.NewBlake2b()}
+	kind, payload, err := ar.readFrame()
+	if err != nil {
+		return nil, err
+	}
+	if kind != frameHeader {
+		return nil, fmt.Errorf("expected header frame, got %v", kind)
+	}
+	if err := rlp.DecodeBytes(payload, &ar.header); err != nil {
+		return nil, err
+	}
+	if ar.header.Version != archiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %v", ar.header.Version)
+	}
+	return ar, nil
+}
+
+func (r *archiveReader) Header() *archiveHeader {
+	return &r.header
+}
+
+func (r *archiveReader) readFrame() (byte, []byte, error) {
+	var prefix [5]byte
+	if _, err := io.ReadFull(r.gz, prefix[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, nil, errArchiveTruncated
+		}
+		return 0, nil, err
+	}
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if size > maxArchiveFrameSize {
+		return 0, nil, fmt.Errorf("frame too large: %v", size)
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r.gz, payload); err != nil {
+		return 0, nil, errArchiveTruncated
+	}
+	return prefix[0], payload, nil
+}
+
+// Read returns the next block, blocks are only returned after the checksum covering them is verified.
+// It returns io.EOF at the end of a complete archive.
+func (r *archiveReader) Read() (*block.EscortedBlock, error) {
+	if len(r.blocks) == 0 {
+		if err := r.readSegment(); err != nil {
+			return nil, err
+		}
+	}
+	eb := r.blocks[0]
+	r.blocks = r.blocks[1:]
+	return eb, nil
+}
+
+// readSegment reads blocks up to the next checksum.
+func (r *archiveReader) readSegment() error {
+	var blocks []*block.EscortedBlock
+	for {
+		kind, payload, err := r.readFrame()
+		if err == io.EOF {
+			if len(blocks) > 0 {
+				return errArchiveTruncated
+			}
+			if total := r.header.To - r.header.From + 1; r.count != total {
+				return fmt.Errorf("archive has %v blocks, expected %v", r.count, total)
+			}
+			return io.EOF
+		}
+		if err != nil {
+			return err
+		}
+		switch kind {
+		case frameBlock:
+			r.hasher.Write(payload)
+			var eb block.EscortedBlock
+			if err := rlp.DecodeBytes(payload, &eb); err != nil {
+				return err
+			}
+			blocks = append(blocks, &eb)
+		case frameChecksum:
+			if !bytes.Equal(payload, r.hasher.Sum(nil)) {
+				return errArchiveChecksum
+			}
+			r.count += uint32(len(blocks))
+			if len(blocks) > 0 {
+				r.blocks = blocks
+				return nil
+			}
+		default:
+			return fmt.Errorf("unexpected frame %v", kind)
+		}
+	}
+}
+
+func exportChainAction(ctx *cli.Context) error {
+	mainDB, gene := openMainDB(ctx)
+	defer func() { slog.Info("closing main database..."); mainDB.Close() }()
+
+	meterChain := initChain(ctx, gene, mainDB)
+	best := meterChain.BestBlock().Number()
+	from := uint32(ctx.Int64(fromFlag.Name))
+	to := uint32(ctx.Int64(toFlag.Name))
+	if from == 0 {
+		// genesis is built locally
+		from = 1
+	}
+	if to == 0 || to > best {
+		to = best
+	}
+	if from > to {
+		return fmt.Errorf("invalid range [%v, %v], best is %v", from, to, best)
+	}
+
+	f, err := os.Create(ctx.String(fileFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	bw := bufio.NewWriter(f)
+	w, err := newArchiveWriter(bw, &archiveHeader{
+		Version:   archiveVersion,
+		GenesisID: meterChain.GenesisBlock().ID(),
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		start      = time.Now()
+		lastReport = start
+	)
+	slog.Info("Start to export chain", "from", from, "to", to, "file", ctx.String(fileFlag.Name))
+	for num := from; num <= to; num++ {
+		blk, err := meterChain.GetTrunkBlock(num)
+		if err != nil {
+			return err
+		}
+		var escortQC *block.QuorumCert
+		if num == best {
+			escortQC = meterChain.BestQC()
+		} else {
+			child, err := meterChain.GetTrunkBlock(num + 1)
+			if err != nil {
+				return err
+			}
+			escortQC = child.QC
+		}
+		if err := w.Write(&block.EscortedBlock{Block: blk, EscortQC: escortQC}); err != nil {
+			return err
+		}
+		if time.Since(lastReport) > time.Second*8 {
+			slog.Info("Still exporting", "num", num, "elapsed", meter.PrettyDuration(time.Since(start)))
+			lastReport = time.Now()
+		}
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	slog.Info("Export chain completed", "from", from, "to", to, "elapsed", meter.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+func importChainAction(ctx *cli.Context) error {
+	mainDB, gene := openMainDB(ctx)
+	defer func() { slog.Info("closing main database..."); mainDB.Close() }()
+
+	logDB := openLogDB(ctx)
+	defer func() { slog.Info("closing log database..."); logDB.Close() }()
+
+	f, err := os.Open(ctx.String(fileFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r, err := newArchiveReader(bufio.NewReader(f))
+	if err != nil {
+		return err
+	}
+
+	meterChain := initChain(ctx, gene, mainDB)
+	header := r.Header()
+	if header.GenesisID != meterChain.GenesisBlock().ID() {
+		return fmt.Errorf("archive is for genesis %v, not %v", header.GenesisID, meterChain.GenesisBlock().ID())
+	}
+	if best := meterChain.BestBlock().Number(); header.From > best+1 {
+		return fmt.Errorf("archive starts from %v, beyond best %v", header.From, best)
+	}
+
+	stateCreator := state.NewCreator(mainDB)
+	cons, closeValidator := newValidator(ctx, meterChain, mainDB, logDB)
+	defer closeValidator()
+
+	var (
+		start      = time.Now()
+		lastReport = start
+		imported   = 0
+	)
+	slog.Info("Start to import chain", "from", header.From, "to", header.To, "file", ctx.String(fileFlag.Name))
+	for {
+		eb, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		blk := eb.Block
+		best := meterChain.BestBlock()
+		if blk.Number() <= best.Number() {
+			id, err := meterChain.GetTrunkBlockID(blk.Number())
+			if err != nil {
+				return err
+			}
+			if id != blk.ID() {
+				return fmt.Errorf("block %v conflicts with local chain", blk.ID())
+			}
+			continue
+		}
+		if blk.ParentID() != best.ID() {
+			return fmt.Errorf("block %v does not extend best block %v", blk.ID(), best.ID())
+		}
+		if eb.EscortQC == nil || eb.EscortQC.QCHeight != blk.Number() {
+			return fmt.Errorf("invalid escort qc for block %v", blk.ID())
+		}
+		// the archive is not trusted, verify the finality proof with the committee of the epoch
+		if !cons.ValidateQC(blk, eb.EscortQC) {
+			return fmt.Errorf("escort qc of block %v fails verification", blk.ID())
+		}
+
+		parentState, err := stateCreator.NewState(best.StateRoot())
+		if err != nil {
+			return err
+		}
+		stage, receipts, err := cons.Validate(parentState, blk, best, uint64(time.Now().Unix()), false)
+		if err != nil {
+			return fmt.Errorf("validate block %v: %w", blk.ID(), err)
+		}
+		if _, err := stage.Commit(); err != nil {
+			return err
+		}
+		if _, err := meterChain.AddBlock(blk, eb.EscortQC, receipts); err != nil {
+			return err
+		}
+		// committees change after kblocks
+		if _, err := cons.UpdateCurEpoch(); err != nil {
+			return err
+		}
+		if len(blk.Transactions()) > 0 {
+			batch := logDB.Prepare(blk.Header())
+			for i, tx := range blk.Transactions() {
+				origin, _ := tx.Signer()
+				batch.InsertTx(uint32(i), tx, origin, receipts[i].Reverted)
+				txBatch := batch.ForTransaction(tx.ID(), origin)
+				for _, output := range receipts[i].Outputs {
+					txBatch.Insert(output.Events, output.Transfers)
+					batch.InsertStakingRecords(tx.ID(), origin, output.StakingRecords)
+				}
+			}
+			if err := batch.Commit(); err != nil {
+				return err
+			}
+		}
+		imported++
+		if time.Since(lastReport) > time.Second*8 {
+			slog.Info("Still importing", "num", blk.Number(), "imported", imported, "elapsed", meter.PrettyDuration(time.Since(start)))
+			lastReport = time.Now()
+		}
+	}
+	slog.Info("Import chain completed", "best", meterChain.BestBlock().Number(), "imported", imported, "elapsed", meter.PrettyDuration(time.Since(start)))
+	return nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeArchive(t *testing.T, blocks []*block.EscortedBlock) []byte {
+	var buf bytes.Buffer
+	w, err := newArchiveWriter(&buf, &archiveHeader{Version: archiveVersion, From: 1, To: uint32(len(blocks))})
+	assert.Nil(t, err)
+	for _, eb := range blocks {
+		assert.Nil(t, w.Write(eb))
+	}
+	assert.Nil(t, w.Close())
+	return buf.Bytes()
+}
+
+func readArchive(data []byte) ([]*block.EscortedBlock, error) {
+	r, err := newArchiveReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	var blocks []*block.EscortedBlock
+	for {
+		eb, err := r.Read()
+		if err == io.EOF {
+			return blocks, nil
+		}
+		if err != nil {
+			return blocks, err
+		}
+		blocks = append(blocks, eb)
+	}
+}
+
+// rewrite modifies the uncompressed content of archive
+func rewrite(t *testing.T, data []byte, modify func([]byte) []byte) []byte {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	assert.Nil(t, err)
+	raw, err := io.ReadAll(gz)
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(modify(raw))
+	w.Close()
+	return buf.Bytes()
+}
+
+func TestChainArchive(t *testing.T) {
+	var (
+		blocks   []*block.EscortedBlock
+		parentID meter.Bytes32
+	)
+	for i := 1; i <= archiveChecksumInterval*2+10; i++ {
+		blk := new(block.Builder).ParentID(parentID).Timestamp(uint64(i)).Build()
+		blk.SetQC(&block.QuorumCert{QCHeight: blk.Number() - 1})
+		blocks = append(blocks, &block.EscortedBlock{Block: blk, EscortQC: &block.QuorumCert{QCHeight: blk.Number()}})
+		parentID = blk.ID()
+	}
+
+	data := writeArchive(t, blocks)
+	assert.Equal(t, data, writeArchive(t, blocks), "archive should be deterministic")
+
+	read, err := readArchive(data)
+	assert.Nil(t, err)
+	assert.Equal(t, len(blocks), len(read))
+	for i, eb := range read {
+		assert.Equal(t, blocks[i].Block.ID(), eb.Block.ID())
+		assert.Equal(t, blocks[i].EscortQC.QCHeight, eb.EscortQC.QCHeight)
+	}
+
+	// tampered checksum
+	_, err = readArchive(rewrite(t, data, func(raw []byte) []byte {
+		raw[len(raw)-1] ^= 1
+		return raw
+	}))
+	assert.Equal(t, errArchiveChecksum, err)
+
+	// last checksum dropped
+	read, err = readArchive(rewrite(t, data, func(raw []byte) []byte {
+		return raw[:len(raw)-37]
+	}))
+	assert.Equal(t, errArchiveTruncated, err)
+	assert.Equal(t, archiveChecksumInterval*2, len(read), "only verified blocks are returned")
+
+	// fewer blocks than declared
+	var buf bytes.Buffer
+	w, err := newArchiveWriter(&buf, &archiveHeader{Version: archiveVersion, From: 1, To: 20})
+	assert.Nil(t, err)
+	for _, eb := range blocks[:10] {
+		assert.Nil(t, w.Write(eb))
+	}
+	assert.Nil(t, w.Close())
+	_, err = readArchive(buf.Bytes())
+	assert.NotNil(t, err)
+}
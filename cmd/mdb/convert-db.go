@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/kvstore"
+	"github.com/meterio/meter-pov/meter"
+	"gopkg.in/urfave/cli.v1"
+)
+
+const convertBatch = 16384
+
+// convertDBAction copies the main database into a new store of the target engine, then swaps it in.
+// The original store is kept as main.db.<engine>.bak.
+func convertDBAction(ctx *cli.Context) error {
+	dir, _ := mainDBPath(ctx)
+	to := ctx.String(toEngineFlag.Name)
+	from, err := kvstore.Detect(dir)
+	if err != nil {
+		return err
+	}
+	if from == "" {
+		return fmt.Errorf("no database at %v", dir)
+	}
+	if from == to {
+		return fmt.Errorf("database at %v is already %v", dir, to)
+	}
+
+	tmpDir := dir + "." + to + ".tmp"
+	bakDir := dir + "." + from + ".bak"
+	if _, err := os.Stat(bakDir); err == nil {
+		return fmt.Errorf("backup %v already exists", bakDir)
+	}
+	// leftover of an interrupted conversion
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+
+	src, err := openStore(from, dir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if src != nil {
+			src.Close()
+		}
+	}()
+	dst, err := openStore(to, tmpDir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if dst != nil {
+			dst.Close()
+		}
+	}()
+
+	var (
+		start      = time.Now()
+		lastReport = start
+	)
+	slog.Info("Start to convert database", "dir", dir, "from", from, "to", to)
+	n, err := kvstore.Copy(dst, src, convertBatch, func(n int) {
+		if time.Since(lastReport) > time.Second*8 {
+			slog.Info("Still converting", "entries", n, "elapsed", meter.PrettyDuration(time.Since(start)))
+			lastReport = time.Now()
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if copied := countEntries(dst); copied != n {
+		return fmt.Errorf("converted database has %v entries, expected %v", copied, n)
+	}
+
+	err = src.Close()
+	src = nil
+	if err != nil {
+		return err
+	}
+	err = dst.Close()
+	dst = nil
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(dir, bakDir); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return err
+	}
+	slog.Info("Convert database completed", "entries", n, "elapsed", meter.PrettyDuration(time.Since(start)))
+	slog.Info(fmt.Sprintf("Start the node with --db-engine %v, the original database is kept at %v and can be removed", to, bakDir))
+	return nil
+}
+
+func countEntries(db kv.Getter) int {
+	it := db.NewIterator(kv.Range{})
+	defer it.Release()
+	n := 0
+	for it.Next() {
+		n++
+	}
+	return n
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"runtime"
 
+	"github.com/meterio/meter-pov/kvstore"
 	"gopkg.in/urfave/cli.v1"
 )
 
@@ -16,8 +17,14 @@ var (
 		Usage:  "directory for block-chain databases",
 		EnvVar: "MDB_DATA_DIR",
 	}
+	dbEngineFlag = cli.StringFlag{
+		Name:   "db-engine",
+		Value:  kvstore.LevelDB,
+		Usage:  "storage engine of the main database (leveldb|pebble)",
+		EnvVar: "MDB_DB_ENGINE",
+	}
 
-	networkFlag  = cli.StringFlag{Name: "network", Usage: "the network to join (main|test)", EnvVar: "MDB_NETWORK"}
+	networkFlag  = cli.StringFlag{Name: "network", Usage: "the network to join (main|test), or path to a custom network file (*.json)", EnvVar: "MDB_NETWORK"}
 	heightFlag   = cli.Int64Flag{Name: "height", Usage: "the height for target block"}
 	revisionFlag = cli.StringFlag{Name: "revision", Usage: "the revision for target block", Value: "best"}
 	rawFlag      = cli.StringFlag{Name: "raw", Usage: "raw hex for block", Value: ""}
@@ -34,6 +41,10 @@ var (
 	parentFlag   = cli.StringFlag{Name: "parent", Usage: "the revision for parent block", Value: "best"}
 	ntxsFlag     = cli.Int64Flag{Name: "ntxs", Usage: "the txs to include in proposed block", Value: 200}
 	pkFileFlag   = cli.StringFlag{Name: "pkFile", Usage: "private key file", Value: "/tmp/accounts.txt"}
+	fileFlag     = cli.StringFlag{Name: "file", Usage: "path of the chain archive", Value: "chain.archive"}
+	formatFlag   = cli.StringFlag{Name: "format", Usage: "output format (csv|json)", Value: "csv"}
+	outFlag      = cli.StringFlag{Name: "out", Usage: "path of the output file, stdout if not set"}
+	toEngineFlag = cli.StringFlag{Name: "to-engine", Usage: "storage engine to convert the main database to (leveldb|pebble)", Value: kvstore.Pebble}
 )
 
 // copy from go-ethereum
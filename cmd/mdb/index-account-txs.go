@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/logdb"
+	"github.com/meterio/meter-pov/meter"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// indexAccountTxs writes account txs of trunk blocks in range into log db, blocks without txs are skipped.
+// It returns count of indexed txs.
+func indexAccountTxs(c *chain.Chain, logDB *logdb.LogDB, from, to uint32) (int, error) {
+	var (
+		indexed    = 0
+		start      = time.Now()
+		lastReport = start
+	)
+	for num := from; num <= to; num++ {
+		blk, err := c.GetTrunkBlock(num)
+		if err != nil {
+			return indexed, err
+		}
+		txs := blk.Transactions()
+		if len(txs) == 0 {
+			continue
+		}
+		receipts, err := c.GetBlockReceipts(blk.ID())
+		if err != nil {
+			return indexed, fmt.Errorf("receipts of block %v: %w", num, err)
+		}
+		batch := logDB.Prepare(blk.Header())
+		for i, tx := range txs {
+			origin, _ := tx.Signer()
+			batch.InsertTx(uint32(i), tx, origin, receipts[i].Reverted)
+		}
+		if err := batch.Commit(); err != nil {
+			return indexed, err
+		}
+		indexed += len(txs)
+		if time.Since(lastReport) > time.Second*8 {
+			slog.Info("Still indexing", "num", num, "txs", indexed, "elapsed", meter.PrettyDuration(time.Since(start)))
+			lastReport = time.Now()
+		}
+	}
+	return indexed, nil
+}
+
+func indexAccountTxsAction(ctx *cli.Context) error {
+	mainDB, gene := openMainDB(ctx)
+	defer func() { slog.Info("closing main database..."); mainDB.Close() }()
+
+	logDB := openLogDB(ctx)
+	defer func() { slog.Info("closing log database..."); logDB.Close() }()
+
+	meterChain := initChain(ctx, gene, mainDB)
+	best := meterChain.BestBlock().Number()
+	from := uint32(ctx.Int64(fromFlag.Name))
+	to := uint32(ctx.Int64(toFlag.Name))
+	if to == 0 || to > best {
+		to = best
+	}
+	if from > to {
+		return fmt.Errorf("invalid range [%v, %v], best is %v", from, to, best)
+	}
+
+	start := time.Now()
+	slog.Info("Start to index account txs", "from", from, "to", to)
+	indexed, err := indexAccountTxs(meterChain, logDB, from, to)
+	if err != nil {
+		return err
+	}
+	slog.Info("Index account txs completed", "from", from, "to", to, "txs", indexed, "elapsed", meter.PrettyDuration(time.Since(start)))
+	return nil
+}
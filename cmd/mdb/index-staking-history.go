@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/logdb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/runtime"
+	"github.com/meterio/meter-pov/script"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/meterio/meter-pov/xenv"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// stakingRecordsOf re-executes the block against its parent state to recompute staking records made by
+// script engine, which are not kept in receipts. Writes of the execution are discarded.
+func stakingRecordsOf(c *chain.Chain, db kv.GetPutter, blk *block.Block) ([]tx.StakingRecords, error) {
+	header := blk.Header()
+	parent, err := c.GetBlockHeader(header.ParentID())
+	if err != nil {
+		return nil, err
+	}
+	st, err := state.New(parent.StateRoot(), &profileKV{GetPutter: db})
+	if err != nil {
+		return nil, err
+	}
+	signer, _ := header.Signer()
+	rt := runtime.New(c.NewSeeker(header.ParentID()), st, &xenv.BlockContext{
+		Beneficiary: header.Beneficiary(),
+		Signer:      signer,
+		Number:      header.Number(),
+		Time:        header.Timestamp(),
+		GasLimit:    header.GasLimit(),
+		TotalScore:  header.TotalScore(),
+	})
+	records := make([]tx.StakingRecords, 0, len(blk.Transactions()))
+	for _, trx := range blk.Transactions() {
+		receipt, err := rt.ExecuteTransaction(trx)
+		if err != nil {
+			return nil, fmt.Errorf("execute tx %v: %w", trx.ID(), err)
+		}
+		var txRecords tx.StakingRecords
+		for _, output := range receipt.Outputs {
+			txRecords = append(txRecords, output.StakingRecords...)
+		}
+		records = append(records, txRecords)
+	}
+	root, err := st.Stage().Hash()
+	if err != nil {
+		return nil, err
+	}
+	if root != header.StateRoot() {
+		return nil, fmt.Errorf("state root mismatch, want %v, got %v", header.StateRoot(), root)
+	}
+	return records, nil
+}
+
+// indexStakingHistory writes staking records of trunk blocks in range into log db, blocks without txs are
+// skipped. Parent states of blocks in range are required. It returns count of indexed records.
+func indexStakingHistory(c *chain.Chain, db kv.GetPutter, logDB *logdb.LogDB, from, to uint32) (int, error) {
+	var (
+		indexed    = 0
+		start      = time.Now()
+		lastReport = start
+	)
+	if from == 0 {
+		from = 1
+	}
+	for num := from; num <= to; num++ {
+		blk, err := c.GetTrunkBlock(num)
+		if err != nil {
+			return indexed, err
+		}
+		if len(blk.Transactions()) == 0 {
+			continue
+		}
+		records, err := stakingRecordsOf(c, db, blk)
+		if err != nil {
+			return indexed, fmt.Errorf("block %v: %w", num, err)
+		}
+		batch := logDB.Prepare(blk.Header())
+		for i, trx := range blk.Transactions() {
+			origin, _ := trx.Signer()
+			batch.InsertStakingRecords(trx.ID(), origin, records[i])
+			indexed += len(records[i])
+		}
+		if err := batch.Commit(); err != nil {
+			return indexed, err
+		}
+		if time.Since(lastReport) > time.Second*8 {
+			slog.Info("Still indexing", "num", num, "records", indexed, "elapsed", meter.PrettyDuration(time.Since(start)))
+			lastReport = time.Now()
+		}
+	}
+	return indexed, nil
+}
+
+func indexStakingHistoryAction(ctx *cli.Context) error {
+	mainDB, gene := openMainDB(ctx)
+	defer func() { slog.Info("closing main database..."); mainDB.Close() }()
+
+	logDB := openLogDB(ctx)
+	defer func() { slog.Info("closing log database..."); logDB.Close() }()
+
+	meterChain := initChain(ctx, gene, mainDB)
+	best := meterChain.BestBlock().Number()
+	from := uint32(ctx.Int64(fromFlag.Name))
+	to := uint32(ctx.Int64(toFlag.Name))
+	if to == 0 || to > best {
+		to = best
+	}
+	if from > to {
+		return fmt.Errorf("invalid range [%v, %v], best is %v", from, to, best)
+	}
+	// script engine is needed to execute staking txs
+	script.NewScriptEngine(meterChain, state.NewCreator(&profileKV{GetPutter: mainDB}))
+
+	start := time.Now()
+	slog.Info("Start to index staking history", "from", from, "to", to)
+	indexed, err := indexStakingHistory(meterChain, mainDB, logDB, from, to)
+	if err != nil {
+		return err
+	}
+	slog.Info("Index staking history completed", "from", from, "to", to, "records", indexed, "elapsed", meter.PrettyDuration(time.Since(start)))
+	return nil
+}
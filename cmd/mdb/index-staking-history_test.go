@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/logdb"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/packer"
+	"github.com/meterio/meter-pov/script"
+	"github.com/meterio/meter-pov/script/staking"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexStakingHistory(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	defer db.Close()
+	logDB, _ := logdb.NewMem()
+	defer logDB.Close()
+
+	b0, _, err := genesis.NewDevnet().Build(state.NewCreator(db))
+	assert.Nil(t, err)
+	c, err := chain.New(db, b0, false)
+	assert.Nil(t, err)
+	script.NewScriptEngine(c, state.NewCreator(db))
+	script.EnterTeslaForkInit()
+
+	accs := genesis.DevAccounts()
+	data, err := script.EncodeScriptData(&staking.StakingBody{
+		Opcode:     staking.OP_BOUND,
+		HolderAddr: accs[0].Address,
+		Amount:     meter.MIN_BOUND_BALANCE,
+		Token:      meter.MTRG,
+	})
+	assert.Nil(t, err)
+	trx := new(tx.Builder).
+		ChainTag(c.Tag()).
+		Clause(tx.NewClause(&meter.StakingModuleAddr).WithToken(meter.MTRG).WithValue(big.NewInt(0)).WithData(data)).
+		Gas(300000).Nonce(1).Expiration(math.MaxUint32).Build()
+	sig, _ := crypto.Sign(trx.SigningHash().Bytes(), accs[0].PrivateKey)
+	trx = trx.WithSignature(sig)
+
+	p := packer.New(c, state.NewCreator(db), accs[0].Address, &accs[0].Address)
+	flow, err := p.Mock(b0.Header(), uint64(time.Now().Unix()), b0.Header().GasLimit(), &accs[0].Address)
+	assert.Nil(t, err)
+	assert.Nil(t, flow.Adopt(trx))
+	blk, stage, receipts, err := flow.Pack(accs[0].PrivateKey, block.MBlockType, 0)
+	assert.Nil(t, err)
+	assert.False(t, receipts[0].Reverted)
+	assert.Equal(t, 1, len(receipts[0].Outputs[0].StakingRecords))
+	_, err = stage.Commit()
+	assert.Nil(t, err)
+	blk.SetQC(&block.QuorumCert{})
+	_, err = c.AddBlock(blk, &block.QuorumCert{QCHeight: 1}, receipts)
+	assert.Nil(t, err)
+
+	// records are not kept in receipts, and recomputed by re-executing the block
+	indexed, err := indexStakingHistory(c, db, logDB, 0, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, indexed)
+
+	record := receipts[0].Outputs[0].StakingRecords[0]
+	records, err := logDB.FilterStakingRecords(context.Background(), &logdb.StakingRecordFilter{BucketID: &record.BucketID})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, "Bound", records[0].Op)
+	assert.Equal(t, accs[0].Address, records[0].Owner)
+	assert.Equal(t, trx.ID(), records[0].TxID)
+}
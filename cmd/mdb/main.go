@@ -24,6 +24,8 @@ import (
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/consensus"
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/logdb"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/packer"
 	"github.com/meterio/meter-pov/powpool"
@@ -53,12 +55,13 @@ var (
 	version   string
 	gitCommit string
 	gitTag    string
-	flags     = []cli.Flag{dataDirFlag, networkFlag, revisionFlag}
+	flags     = []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, revisionFlag}
 
 	defaultTxPoolOptions = txpool.Options{
 		Limit:           200000,
 		LimitPerAccount: 1024, /*16,*/ //XXX: increase to 1024 from 16 during the testing
 		MaxLifetime:     20 * time.Minute,
+		PriceBump:       10,
 	}
 )
 
@@ -82,43 +85,43 @@ func main() {
 		Action:    defaultAction,
 		Commands: []cli.Command{
 			// Read-only info
-			{Name: "raw", Usage: "Load raw value from database with key", Flags: []cli.Flag{dataDirFlag, networkFlag, keyFlag}, Action: loadRawAction},
-			{Name: "account", Usage: "Load account from database on revision", Flags: []cli.Flag{dataDirFlag, networkFlag, revisionFlag, addressFlag}, Action: loadAccountAction},
-			{Name: "block", Usage: "Load block from database on revision", Flags: []cli.Flag{dataDirFlag, networkFlag, revisionFlag}, Action: loadBlockAction},
-			{Name: "index-trie", Usage: "Load index trie root from database on revision", Flags: []cli.Flag{dataDirFlag, networkFlag, revisionFlag}, Action: loadIndexTrieRootAction},
-			{Name: "hash", Usage: "Load block hash with block number", Flags: []cli.Flag{dataDirFlag, networkFlag, heightFlag}, Action: loadHashAction},
-			{Name: "storage", Usage: "Load storage value from database with account address and key", Flags: []cli.Flag{dataDirFlag, networkFlag, addressFlag, keyFlag, revisionFlag}, Action: loadStorageAction},
-			{Name: "peek", Usage: "Load pointers like best-qc, best-block from database", Flags: []cli.Flag{networkFlag, dataDirFlag}, Action: peekAction},
-			{Name: "stash", Usage: "Load all txs from tx.stash", Flags: []cli.Flag{dataDirFlag, networkFlag}, Action: loadStashAction},
+			{Name: "raw", Usage: "Load raw value from database with key", Flags: []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, keyFlag}, Action: loadRawAction},
+			{Name: "account", Usage: "Load account from database on revision", Flags: []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, revisionFlag, addressFlag}, Action: loadAccountAction},
+			{Name: "block", Usage: "Load block from database on revision", Flags: []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, revisionFlag}, Action: loadBlockAction},
+			{Name: "index-trie", Usage: "Load index trie root from database on revision", Flags: []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, revisionFlag}, Action: loadIndexTrieRootAction},
+			{Name: "hash", Usage: "Load block hash with block number", Flags: []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, heightFlag}, Action: loadHashAction},
+			{Name: "storage", Usage: "Load storage value from database with account address and key", Flags: []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, addressFlag, keyFlag, revisionFlag}, Action: loadStorageAction},
+			{Name: "peek", Usage: "Load pointers like best-qc, best-block from database", Flags: []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag}, Action: peekAction},
+			{Name: "stash", Usage: "Load all txs from tx.stash", Flags: []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag}, Action: loadStashAction},
 			{
 				Name:   "report-state",
 				Usage:  "Scan all state trie and report major metrics such as total size",
-				Flags:  []cli.Flag{dataDirFlag, networkFlag},
+				Flags:  []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag},
 				Action: reportStateAction,
 			},
 			{
 				Name:   "report-index",
 				Usage:  "Scan all index trie and report major metrics such as total size ",
-				Flags:  []cli.Flag{dataDirFlag, networkFlag},
+				Flags:  []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag},
 				Action: reportIndexAction,
 			},
 
 			// LevelDB operations
-			{Name: "stat", Usage: "Print leveldb stats", Flags: []cli.Flag{dataDirFlag, networkFlag}, Action: statAction},
-			{Name: "compact", Usage: "Compact leveldb stats", Flags: []cli.Flag{dataDirFlag, networkFlag}, Action: compactAction},
+			{Name: "stat", Usage: "Print database stats", Flags: []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag}, Action: statAction},
+			{Name: "compact", Usage: "Compact database", Flags: []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag}, Action: compactAction},
 
 			// Pruning
 			// TODO: add auto restart feature
 			{
 				Name:   "prune-state",
 				Usage:  "Prune state trie before given block",
-				Flags:  []cli.Flag{dataDirFlag, networkFlag, beforeFlag},
+				Flags:  []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, beforeFlag},
 				Action: pruneStateAction,
 			},
 			{
 				Name:   "prune-index",
 				Usage:  "Prune index trie before given block",
-				Flags:  []cli.Flag{dataDirFlag, networkFlag, beforeFlag},
+				Flags:  []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, beforeFlag},
 				Action: pruneIndexAction,
 			},
 
@@ -126,13 +129,13 @@ func main() {
 			{
 				Name:   "traverse-storage",
 				Usage:  "Traverse the storage trie with given root",
-				Flags:  []cli.Flag{networkFlag, dataDirFlag, rootFlag},
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, rootFlag},
 				Action: traverseStorageAction,
 			},
 			{
 				Name:   "traverse-index",
 				Usage:  "Traverse index trie with given root",
-				Flags:  []cli.Flag{networkFlag, dataDirFlag, rootFlag},
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, rootFlag},
 				Action: traverseIndexAction,
 			},
 
@@ -167,7 +170,7 @@ func main() {
 			{
 				Name:   "import-snapshot",
 				Usage:  "Import a state snapshot on revision block",
-				Flags:  []cli.Flag{dataDirFlag, networkFlag, revisionFlag, commitFlag},
+				Flags:  []cli.Flag{dataDirFlag, dbEngineFlag, networkFlag, revisionFlag, commitFlag},
 				Action: importSnapshotAction,
 			},
 
@@ -176,47 +179,89 @@ func main() {
 			{
 				Name:   "unsafe-reset",
 				Usage:  "Reset chain to any block in history",
-				Flags:  []cli.Flag{networkFlag, dataDirFlag, heightFlag, forceFlag},
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, heightFlag, forceFlag},
 				Action: unsafeResetAction,
 			},
 			{
 				Name:   "unsafe-delete-raw",
 				Usage:  "Delete raw key from local db",
-				Flags:  []cli.Flag{networkFlag, dataDirFlag, keyFlag},
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, keyFlag},
 				Action: unsafeDeleteRawAction,
 			},
 			{
 				Name:   "unsafe-set-raw",
 				Usage:  "Set raw key/value to local db",
-				Flags:  []cli.Flag{networkFlag, dataDirFlag, keyFlag, valueFlag},
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, keyFlag, valueFlag},
 				Action: unsafeSetRawAction,
 			},
 			{
 				Name:   "local-reset",
 				Usage:  "Reset chain with local highest block",
-				Flags:  []cli.Flag{networkFlag, dataDirFlag},
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag},
 				Action: safeResetAction,
 			},
 			{
 				Name:   "sync-verify",
 				Usage:  "Revisit local blocks and validate with current code",
-				Flags:  []cli.Flag{networkFlag, dataDirFlag, fromFlag, toFlag},
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, fromFlag, toFlag},
 				Action: syncVerifyAction,
 			},
 			{
 				Name:   "verify-block",
 				Usage:  "Verify local block",
-				Flags:  []cli.Flag{networkFlag, dataDirFlag, revisionFlag, rawFlag},
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, revisionFlag, rawFlag},
 				Action: verifyBlockAction,
 			},
 			{
 				Name:   "run-block",
 				Usage:  "Run local block again",
-				Flags:  []cli.Flag{networkFlag, dataDirFlag, revisionFlag, rawFlag},
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, revisionFlag, rawFlag},
 				Action: runLocalBlockAction,
 			},
-			{Name: "delete-block", Usage: "delete blocks", Flags: []cli.Flag{networkFlag, dataDirFlag, fromFlag, toFlag}, Action: runDeleteBlockAction},
-			{Name: "propose-block", Usage: "Local propose block", Flags: []cli.Flag{networkFlag, dataDirFlag, parentFlag, ntxsFlag, pkFileFlag}, Action: runProposeBlockAction},
+			{
+				Name:   "profile-blocks",
+				Usage:  "Re-execute trunk blocks in range and report time, gas and state reads of each tx and block, json output has a block per line",
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, fromFlag, toFlag, formatFlag, outFlag},
+				Action: profileBlocksAction,
+			},
+			{
+				Name:   "index-account-txs",
+				Usage:  "Backfill the account txs index of log database with trunk blocks in range, to defaults to best",
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, fromFlag, toFlag},
+				Action: indexAccountTxsAction,
+			},
+			{
+				Name:   "index-staking-history",
+				Usage:  "Backfill the staking history of log database by re-executing trunk blocks in range, to defaults to best",
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, fromFlag, toFlag},
+				Action: indexStakingHistoryAction,
+			},
+			{
+				Name:   "convert-db",
+				Usage:  "Convert the main database to another storage engine, the original one is kept as backup",
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, toEngineFlag},
+				Action: convertDBAction,
+			},
+			{
+				Name:   "state-diff",
+				Usage:  "Print changed accounts and storage between states of two trunk blocks in JSON, to defaults to best",
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, fromFlag, toFlag},
+				Action: stateDiffAction,
+			},
+			{
+				Name:   "export-chain",
+				Usage:  "Export trunk blocks with their escort QCs in range to a chain archive",
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, fromFlag, toFlag, fileFlag},
+				Action: exportChainAction,
+			},
+			{
+				Name:   "import-chain",
+				Usage:  "Import blocks from a chain archive, blocks are validated as sync-verify does",
+				Flags:  []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, fileFlag},
+				Action: importChainAction,
+			},
+			{Name: "delete-block", Usage: "delete blocks", Flags: []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, fromFlag, toFlag}, Action: runDeleteBlockAction},
+			{Name: "propose-block", Usage: "Local propose block", Flags: []cli.Flag{networkFlag, dataDirFlag, dbEngineFlag, parentFlag, ntxsFlag, pkFileFlag}, Action: runProposeBlockAction},
 		},
 	}
 
@@ -983,27 +1028,9 @@ func syncVerifyAction(ctx *cli.Context) error {
 	if fromNum <= 0 {
 		fromNum = 1
 	}
-	ecdsaPubKey, ecdsaPrivKey, _ := GenECDSAKeys()
-	blsCommon := types.NewBlsCommon()
-	defaultPowPoolOptions := powpool.Options{
-		Node:            "localhost",
-		Port:            8332,
-		Limit:           10000,
-		LimitPerAccount: 16,
-		MaxLifetime:     20 * time.Minute,
-	}
-	// init powpool for kblock query
-	powpool.New(defaultPowPoolOptions, meterChain, stateCreator)
-	// init scriptengine
-	script.NewScriptEngine(meterChain, stateCreator)
-
 	start := time.Now()
-	initDelegates := types.LoadDelegatesFile(ctx, blsCommon)
-	pker := packer.New(meterChain, stateCreator, meter.Address{}, &meter.Address{})
-	txPool := txpool.New(meterChain, state.NewCreator(mainDB), defaultTxPoolOptions)
-	defer func() { slog.Info("closing tx pool..."); txPool.Close() }()
-
-	cons := consensus.NewConsensusReactor(ctx, meterChain, logDB, nil /* empty communicator */, txPool, pker, stateCreator, ecdsaPrivKey, ecdsaPubKey, [4]byte{0x0, 0x0, 0x0, 0x0}, blsCommon, initDelegates)
+	cons, closeValidator := newValidator(ctx, meterChain, mainDB, logDB)
+	defer closeValidator()
 
 	for i := uint32(fromNum); i < uint32(toNum); i++ {
 		b, _ := meterChain.GetTrunkBlock(i)
@@ -1042,6 +1069,31 @@ func syncVerifyAction(ctx *cli.Context) error {
 	return nil
 }
 
+// newValidator creates a consensus reactor without communicator, which validates local blocks.
+func newValidator(ctx *cli.Context, meterChain *chain.Chain, mainDB kv.Store, logDB *logdb.LogDB) (*consensus.Reactor, func()) {
+	stateCreator := state.NewCreator(mainDB)
+	ecdsaPubKey, ecdsaPrivKey, _ := GenECDSAKeys()
+	blsCommon := types.NewBlsCommon()
+	defaultPowPoolOptions := powpool.Options{
+		Node:            "localhost",
+		Port:            8332,
+		Limit:           10000,
+		LimitPerAccount: 16,
+		MaxLifetime:     20 * time.Minute,
+	}
+	// init powpool for kblock query
+	powpool.New(defaultPowPoolOptions, meterChain, stateCreator)
+	// init scriptengine
+	script.NewScriptEngine(meterChain, stateCreator)
+
+	initDelegates := types.LoadDelegatesFile(ctx, blsCommon)
+	pker := packer.New(meterChain, stateCreator, meter.Address{}, &meter.Address{})
+	txPool := txpool.New(meterChain, stateCreator, defaultTxPoolOptions)
+
+	cons := consensus.NewConsensusReactor(ctx, meterChain, logDB, nil /* empty communicator */, txPool, pker, stateCreator, ecdsaPrivKey, ecdsaPubKey, [4]byte{0x0, 0x0, 0x0, 0x0}, blsCommon, initDelegates)
+	return cons, func() { slog.Info("closing tx pool..."); txPool.Close() }
+}
+
 func verifyBlockAction(ctx *cli.Context) error {
 	mainDB, gene := openMainDB(ctx)
 	defer func() { slog.Info("closing main database..."); mainDB.Close() }()
@@ -13,8 +13,9 @@ import (
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/kvstore"
 	"github.com/meterio/meter-pov/logdb"
-	"github.com/meterio/meter-pov/lvldb"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/state"
 	"gopkg.in/urfave/cli.v1"
@@ -37,12 +38,26 @@ func openLogDB(ctx *cli.Context) *logdb.LogDB {
 	return db
 }
 
-func openMainDB(ctx *cli.Context) (*lvldb.LevelDB, *genesis.Genesis) {
+// mainDBPath returns path of the main database of the network.
+func mainDBPath(ctx *cli.Context) (string, *genesis.Genesis) {
 	meter.InitBlockChainConfig(ctx.String(networkFlag.Name))
 	gene := selectGenesis(ctx)
 	// init block chain config
 	dbFilePath := ctx.String(dataDirFlag.Name)
 	instanceDir := filepath.Join(dbFilePath, fmt.Sprintf("instance-%x", gene.ID().Bytes()[24:]))
+	return filepath.Join(instanceDir, "main.db"), gene
+}
+
+func openMainDB(ctx *cli.Context) (kv.Store, *genesis.Genesis) {
+	dir, gene := mainDBPath(ctx)
+	db, err := openStore(ctx.String(dbEngineFlag.Name), dir)
+	if err != nil {
+		panic(fmt.Sprintf("open chain database [%v]: %v", dir, err))
+	}
+	return db, gene
+}
+
+func openStore(engine string, dir string) (kv.Store, error) {
 	if _, err := fdlimit.Raise(5120 * 4); err != nil {
 		panic(fmt.Sprintf("failed to increase fd limit due to %v", err))
 	}
@@ -57,15 +72,10 @@ func openMainDB(ctx *cli.Context) (*lvldb.LevelDB, *genesis.Genesis) {
 	}
 	fileCache := 1024
 
-	dir := filepath.Join(instanceDir, "main.db")
-	db, err := lvldb.New(dir, lvldb.Options{
+	return kvstore.Open(engine, dir, kvstore.Options{
 		CacheSize:              128,
 		OpenFilesCacheCapacity: fileCache,
 	})
-	if err != nil {
-		panic(fmt.Sprintf("open chain database [%v]: %v", dir, err))
-	}
-	return db, gene
 }
 
 func fullVersion() string {
@@ -78,6 +88,18 @@ func fullVersion() string {
 
 func selectGenesis(ctx *cli.Context) *genesis.Genesis {
 	network := ctx.String(networkFlag.Name)
+	if genesis.IsNetworkFile(network) {
+		spec, err := genesis.LoadNetworkFile(network)
+		if err != nil {
+			fatal(fmt.Sprintf("load network file [%v]: %v", network, err))
+		}
+		meter.InitCustomBlockChainConfig(spec.Name, spec.ChainID, spec.Forks)
+		gene, err := genesis.NewCustomNetwork(spec)
+		if err != nil {
+			fatal("build custom network genesis:", err)
+		}
+		return gene
+	}
 	switch network {
 	case "warringstakes":
 		fallthrough
@@ -99,7 +121,7 @@ func selectGenesis(ctx *cli.Context) *genesis.Genesis {
 	}
 }
 
-func initChain(ctx *cli.Context, gene *genesis.Genesis, mainDB *lvldb.LevelDB) *chain.Chain {
+func initChain(ctx *cli.Context, gene *genesis.Genesis, mainDB kv.Store) *chain.Chain {
 	genesisBlock, _, err := gene.Build(state.NewCreator(mainDB))
 	if err != nil {
 		fatal("build genesis block: ", err)
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/runtime"
+	"github.com/meterio/meter-pov/script"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/xenv"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// profileKV counts reads of the underlying store, and discards all writes,
+// so that blocks can be re-executed without touching the database.
+type profileKV struct {
+	kv.GetPutter
+	reads uint64
+}
+
+func (p *profileKV) Get(key []byte) ([]byte, error) {
+	atomic.AddUint64(&p.reads, 1)
+	return p.GetPutter.Get(key)
+}
+
+func (p *profileKV) Has(key []byte) (bool, error) {
+	atomic.AddUint64(&p.reads, 1)
+	return p.GetPutter.Has(key)
+}
+
+func (p *profileKV) Put(key, value []byte) error { return nil }
+func (p *profileKV) Delete(key []byte) error     { return nil }
+func (p *profileKV) NewBatch() kv.Batch          { return &discardBatch{} }
+
+func (p *profileKV) Reads() uint64 {
+	return atomic.LoadUint64(&p.reads)
+}
+
+type discardBatch struct {
+	n int
+}
+
+func (b *discardBatch) Put(key, value []byte) error { b.n++; return nil }
+func (b *discardBatch) Delete(key []byte) error     { b.n++; return nil }
+func (b *discardBatch) NewBatch() kv.Batch          { return &discardBatch{} }
+func (b *discardBatch) Len() int                    { return b.n }
+func (b *discardBatch) Write() error                { return nil }
+
+type txProfile struct {
+	Index          int    `json:"index"`
+	ID             string `json:"id"`
+	Clauses        int    `json:"clauses"`
+	GasUsed        uint64 `json:"gasUsed"`
+	Reverted       bool   `json:"reverted"`
+	WallTimeUs     int64  `json:"wallTimeUs"`
+	StateReads     uint64 `json:"stateReads"`
+	ScriptEngineUs int64  `json:"scriptEngineUs"`
+}
+
+type blockProfile struct {
+	Number         uint32       `json:"number"`
+	ID             string       `json:"id"`
+	GasUsed        uint64       `json:"gasUsed"`
+	ExecUs         int64        `json:"execUs"`
+	CommitUs       int64        `json:"commitUs"`
+	StateReads     uint64       `json:"stateReads"`
+	ScriptEngineUs int64        `json:"scriptEngineUs"`
+	RootMatch      bool         `json:"rootMatch"`
+	Txs            []*txProfile `json:"txs"`
+}
+
+// profileBlock re-executes the block against its parent state, as the block is verified.
+func profileBlock(c *chain.Chain, db *profileKV, blk *block.Block) (*blockProfile, error) {
+	header := blk.Header()
+	parent, err := c.GetBlockHeader(header.ParentID())
+	if err != nil {
+		return nil, err
+	}
+	prof := &blockProfile{
+		Number: header.Number(),
+		ID:     header.ID().String(),
+		Txs:    make([]*txProfile, 0, len(blk.Transactions())),
+	}
+
+	start := time.Now()
+	startReads := db.Reads()
+	st, err := state.New(parent.StateRoot(), db)
+	if err != nil {
+		return nil, err
+	}
+	signer, _ := header.Signer()
+	rt := runtime.New(c.NewSeeker(header.ParentID()), st, &xenv.BlockContext{
+		Beneficiary: header.Beneficiary(),
+		Signer:      signer,
+		Number:      header.Number(),
+		Time:        header.Timestamp(),
+		GasLimit:    header.GasLimit(),
+		TotalScore:  header.TotalScore(),
+	})
+	for i, tx := range blk.Transactions() {
+		var (
+			txStart = time.Now()
+			reads   = db.Reads()
+			seTime  = rt.ScriptEngineTime()
+		)
+		receipt, err := rt.ExecuteTransaction(tx)
+		if err != nil {
+			return nil, fmt.Errorf("execute tx %v: %w", tx.ID(), err)
+		}
+		prof.Txs = append(prof.Txs, &txProfile{
+			Index:          i,
+			ID:             tx.ID().String(),
+			Clauses:        len(tx.Clauses()),
+			GasUsed:        receipt.GasUsed,
+			Reverted:       receipt.Reverted,
+			WallTimeUs:     time.Since(txStart).Microseconds(),
+			StateReads:     db.Reads() - reads,
+			ScriptEngineUs: (rt.ScriptEngineTime() - seTime).Microseconds(),
+		})
+		prof.GasUsed += receipt.GasUsed
+	}
+	prof.ExecUs = time.Since(start).Microseconds()
+	prof.ScriptEngineUs = rt.ScriptEngineTime().Microseconds()
+
+	commitStart := time.Now()
+	root, err := st.Stage().Commit()
+	if err != nil {
+		return nil, err
+	}
+	prof.CommitUs = time.Since(commitStart).Microseconds()
+	prof.StateReads = db.Reads() - startReads
+	prof.RootMatch = root == header.StateRoot()
+	return prof, nil
+}
+
+var profileCSVHeader = []string{"kind", "block", "index", "id", "clauses", "gasUsed", "reverted", "wallTimeUs", "stateReads", "scriptEngineUs", "commitUs", "rootMatch"}
+
+// writeProfileCSV writes a row for each tx, followed by a row of the block.
+func writeProfileCSV(w *csv.Writer, prof *blockProfile) error {
+	num := strconv.FormatUint(uint64(prof.Number), 10)
+	for _, tx := range prof.Txs {
+		if err := w.Write([]string{
+			"tx", num, strconv.Itoa(tx.Index), tx.ID, strconv.Itoa(tx.Clauses),
+			strconv.FormatUint(tx.GasUsed, 10), strconv.FormatBool(tx.Reverted),
+			strconv.FormatInt(tx.WallTimeUs, 10), strconv.FormatUint(tx.StateReads, 10),
+			strconv.FormatInt(tx.ScriptEngineUs, 10), "", "",
+		}); err != nil {
+			return err
+		}
+	}
+	return w.Write([]string{
+		"block", num, "", prof.ID, "",
+		strconv.FormatUint(prof.GasUsed, 10), "",
+		strconv.FormatInt(prof.ExecUs, 10), strconv.FormatUint(prof.StateReads, 10),
+		strconv.FormatInt(prof.ScriptEngineUs, 10), strconv.FormatInt(prof.CommitUs, 10),
+		strconv.FormatBool(prof.RootMatch),
+	})
+}
+
+func profileBlocksAction(ctx *cli.Context) error {
+	mainDB, gene := openMainDB(ctx)
+	defer func() { slog.Info("closing main database..."); mainDB.Close() }()
+
+	meterChain := initChain(ctx, gene, mainDB)
+	best := meterChain.BestBlock().Number()
+	from := uint32(ctx.Int64(fromFlag.Name))
+	to := uint32(ctx.Int64(toFlag.Name))
+	if from == 0 {
+		from = 1
+	}
+	if to == 0 || to > best {
+		to = best
+	}
+	if from > to {
+		return fmt.Errorf("invalid range [%v, %v], best is %v", from, to, best)
+	}
+	format := ctx.String(formatFlag.Name)
+	if format != "csv" && format != "json" {
+		return fmt.Errorf("unknown format %v", format)
+	}
+
+	var out io.Writer = os.Stdout
+	if path := ctx.String(outFlag.Name); path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	db := &profileKV{GetPutter: mainDB}
+	// script engine is needed to execute staking/auction txs
+	script.NewScriptEngine(meterChain, state.NewCreator(db))
+
+	var (
+		csvw       = csv.NewWriter(out)
+		jsonEnc    = json.NewEncoder(out)
+		start      = time.Now()
+		lastReport = start
+		mismatches = 0
+	)
+	if format == "csv" {
+		if err := csvw.Write(profileCSVHeader); err != nil {
+			return err
+		}
+	}
+	slog.Info("Start to profile blocks", "from", from, "to", to)
+	for num := from; num <= to; num++ {
+		blk, err := meterChain.GetTrunkBlock(num)
+		if err != nil {
+			return err
+		}
+		prof, err := profileBlock(meterChain, db, blk)
+		if err != nil {
+			return fmt.Errorf("profile block %v: %w", num, err)
+		}
+		if !prof.RootMatch {
+			mismatches++
+			slog.Warn("state root mismatch", "num", num)
+		}
+		if format == "csv" {
+			err = writeProfileCSV(csvw, prof)
+		} else {
+			// one block per line
+			err = jsonEnc.Encode(prof)
+		}
+		if err != nil {
+			return err
+		}
+		if time.Since(lastReport) > time.Second*8 {
+			slog.Info("Still profiling", "num", num, "elapsed", meter.PrettyDuration(time.Since(start)))
+			lastReport = time.Now()
+		}
+	}
+	csvw.Flush()
+	if err := csvw.Error(); err != nil {
+		return err
+	}
+	slog.Info("Profile blocks completed", "from", from, "to", to, "rootMismatches", mismatches, "elapsed", meter.PrettyDuration(time.Since(start)))
+	return nil
+}
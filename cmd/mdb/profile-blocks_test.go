@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/packer"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileBlock(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	defer db.Close()
+
+	b0, _, err := genesis.NewDevnet().Build(state.NewCreator(db))
+	assert.Nil(t, err)
+	c, err := chain.New(db, b0, false)
+	assert.Nil(t, err)
+
+	accs := genesis.DevAccounts()
+	p := packer.New(c, state.NewCreator(db), accs[0].Address, &accs[0].Address)
+	flow, err := p.Mock(b0.Header(), uint64(time.Now().Unix()), b0.Header().GasLimit(), &accs[0].Address)
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		trx := new(tx.Builder).
+			ChainTag(c.Tag()).
+			Clause(tx.NewClause(&accs[1].Address).WithToken(0).WithValue(big.NewInt(1))).
+			Gas(300000).Nonce(uint64(i)).Expiration(math.MaxUint32).Build()
+		sig, _ := crypto.Sign(trx.SigningHash().Bytes(), accs[0].PrivateKey)
+		assert.Nil(t, flow.Adopt(trx.WithSignature(sig)))
+	}
+	blk, stage, receipts, err := flow.Pack(accs[0].PrivateKey, block.MBlockType, 0)
+	assert.Nil(t, err)
+	_, err = stage.Commit()
+	assert.Nil(t, err)
+	blk.SetQC(&block.QuorumCert{})
+	_, err = c.AddBlock(blk, &block.QuorumCert{QCHeight: 1}, receipts)
+	assert.Nil(t, err)
+
+	pdb := &profileKV{GetPutter: db}
+	prof, err := profileBlock(c, pdb, blk)
+	assert.Nil(t, err)
+	assert.True(t, prof.RootMatch)
+	assert.Equal(t, blk.Header().GasUsed(), prof.GasUsed)
+	assert.Equal(t, 3, len(prof.Txs))
+	for i, tx := range prof.Txs {
+		assert.Equal(t, receipts[i].GasUsed, tx.GasUsed)
+	}
+	assert.True(t, prof.StateReads > 0)
+
+	// writes are discarded
+	assert.Nil(t, pdb.Put([]byte("key"), []byte("value")))
+	has, _ := db.Has([]byte("key"))
+	assert.False(t, has)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	assert.Nil(t, writeProfileCSV(w, prof))
+	w.Flush()
+	rows, err := csv.NewReader(&buf).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(rows))
+	assert.Equal(t, "block", rows[3][0])
+	assert.Equal(t, len(profileCSVHeader), len(rows[3]))
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/meterio/meter-pov/api/debug"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"gopkg.in/urfave/cli.v1"
+)
+
+func stateDiffAction(ctx *cli.Context) error {
+	mainDB, gene := openMainDB(ctx)
+	defer func() { slog.Info("closing main database..."); mainDB.Close() }()
+
+	meterChain := initChain(ctx, gene, mainDB)
+	best := meterChain.BestBlock().Number()
+	from := uint32(ctx.Int64(fromFlag.Name))
+	to := uint32(ctx.Int64(toFlag.Name))
+	if to == 0 {
+		to = best
+	}
+	if from > best || to > best {
+		return fmt.Errorf("invalid range [%v, %v], best is %v", from, to, best)
+	}
+
+	fromHeader, err := meterChain.GetTrunkBlockHeader(from)
+	if err != nil {
+		return err
+	}
+	toHeader, err := meterChain.GetTrunkBlockHeader(to)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	diffs, err := state.Diff(mainDB, fromHeader.StateRoot(), toHeader.StateRoot(), nil)
+	if err != nil {
+		return err
+	}
+	fromState, err := state.New(fromHeader.StateRoot(), mainDB)
+	if err != nil {
+		return err
+	}
+	toState, err := state.New(toHeader.StateRoot(), mainDB)
+	if err != nil {
+		return err
+	}
+	result, err := debug.ConvertStateDiff(fromHeader, toHeader, diffs, state.NewStorageDecoder(fromState), state.NewStorageDecoder(toState))
+	if err != nil {
+		return err
+	}
+	slog.Info("State diff completed", "from", from, "to", to, "accounts", len(diffs), "elapsed", meter.PrettyDuration(time.Since(start)))
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
@@ -22,7 +22,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/meterio/meter-pov/kv"
-	"github.com/meterio/meter-pov/lvldb"
 	"github.com/meterio/meter-pov/meter"
 )
 
@@ -99,7 +98,7 @@ func getJson(client *http.Client, url string, target interface{}) error {
 	return json.NewDecoder(r.Body).Decode(target)
 }
 
-func getValue(ldb *lvldb.LevelDB, key []byte) (string, error) {
+func getValue(ldb kv.Store, key []byte) (string, error) {
 	val, err := ldb.Get(key)
 	if err != nil {
 		return "", err
@@ -107,7 +106,7 @@ func getValue(ldb *lvldb.LevelDB, key []byte) (string, error) {
 	return hex.EncodeToString(val), err
 }
 
-func updateBestQC(ldb *lvldb.LevelDB, hexVal string, dryRun bool) error {
+func updateBestQC(ldb kv.Store, hexVal string, dryRun bool) error {
 	v, _ := hex.DecodeString(hexVal)
 	if !dryRun {
 		ldb.Put(bestQCKey, v)
@@ -118,12 +117,12 @@ func updateBestQC(ldb *lvldb.LevelDB, hexVal string, dryRun bool) error {
 	return nil
 }
 
-func readBestQC(ldb *lvldb.LevelDB) (string, error) {
+func readBestQC(ldb kv.Store) (string, error) {
 	val, err := getValue(ldb, bestQCKey)
 	return val, err
 }
 
-func updateBest(ldb *lvldb.LevelDB, hexVal string, dryRun bool) error {
+func updateBest(ldb kv.Store, hexVal string, dryRun bool) error {
 	v, _ := hex.DecodeString(hexVal)
 	if !dryRun {
 		ldb.Put(bestBlockKey, v)
@@ -134,7 +133,7 @@ func updateBest(ldb *lvldb.LevelDB, hexVal string, dryRun bool) error {
 	return nil
 }
 
-func readBest(ldb *lvldb.LevelDB) (string, error) {
+func readBest(ldb kv.Store) (string, error) {
 	val, err := getValue(ldb, bestBlockKey)
 	return val, err
 }
@@ -7,14 +7,17 @@ package main
 
 import (
 	"log/slog"
+	"time"
 
+	"github.com/meterio/meter-pov/consensus"
+	"github.com/meterio/meter-pov/kvstore"
 	cli "gopkg.in/urfave/cli.v1"
 )
 
 var (
 	networkFlag = cli.StringFlag{
 		Name:   "network",
-		Usage:  "the network to join (main|test|warringstakes)",
+		Usage:  "the network to join (main|test|warringstakes), or path to a custom network file (*.json)",
 		EnvVar: "METER_NETWORK",
 	}
 	dataDirFlag = cli.StringFlag{
@@ -23,9 +26,19 @@ var (
 		Usage:  "directory for block-chain databases",
 		EnvVar: "METER_DATA_DIR",
 	}
+	dbEngineFlag = cli.StringFlag{
+		Name:  "db-engine",
+		Value: kvstore.LevelDB,
+		Usage: "storage engine of the main database (leveldb|pebble), convert existing database with mdb convert-db",
+	}
 	enableStatePruneFlag = cli.BoolFlag{
 		Name:  "enable-state-pruning",
-		Usage: "enable state pruning (default will leave the last 13500000 state untouched and prune the rest)",
+		Usage: "enable state pruning in background, states of the last blocks (see --state-pruning-keep) and all kblocks are kept",
+	}
+	statePruningKeepFlag = cli.UintFlag{
+		Name:  "state-pruning-keep",
+		Value: 13500000,
+		Usage: "number of recent blocks whose states are kept by state pruning, at least 1000",
 	}
 	beneficiaryFlag = cli.StringFlag{
 		Name:  "beneficiary",
@@ -56,6 +69,30 @@ var (
 		Value: 1000,
 		Usage: "limit the distance between 'position' and best block for subscriptions APIs",
 	}
+	apiAdminTokenFlag = cli.StringFlag{
+		Name:  "api-admin-token",
+		Usage: "bearer token required by admin APIs, e.g. txpool eviction. Admin APIs are disabled if not set",
+	}
+	txpoolJournalFlag = cli.StringFlag{
+		Name:  "txpool-journal",
+		Value: "txpool.journal",
+		Usage: "file to journal local txs across restarts, relative to instance dir (disabled if empty)",
+	}
+	txpoolRejournalFlag = cli.DurationFlag{
+		Name:  "txpool-rejournal",
+		Value: time.Hour,
+		Usage: "time interval to regenerate the local txs journal",
+	}
+	txpoolJournalLimitFlag = cli.IntFlag{
+		Name:  "txpool-journal-limit",
+		Value: 10000,
+		Usage: "maximum number of local txs in the journal",
+	}
+	txpoolPriceBumpFlag = cli.Uint64Flag{
+		Name:  "txpool-price-bump",
+		Value: 10,
+		Usage: "minimum price bump percent to replace an eth tx of the same nonce",
+	}
 	verbosityFlag = cli.IntFlag{
 		Name:  "verbosity",
 		Value: int(slog.LevelInfo),
@@ -163,6 +200,37 @@ var (
 		Usage: "path for https cert file (default is meterio.crt)",
 		Value: "meterio.crt",
 	}
+	syncModeFlag = cli.StringFlag{
+		Name:  "sync-mode",
+		Usage: "blockchain sync mode (full|snap), snap mode downloads the state of a recent kblock instead of executing all blocks",
+		Value: "full",
+	}
+	snapCheckpointFlag = cli.StringFlag{
+		Name:  "snap-checkpoint",
+		Usage: "ID of a trusted recent kblock the state is downloaded at in snap mode",
+	}
+	pacemakerPortFlag = cli.IntFlag{
+		Name:  "pacemaker-port",
+		Usage: "port of the mutual TLS transport for consensus messages",
+		Value: consensus.DEFAULT_PACEMAKER_PORT,
+	}
+	pacemakerPeerPortFlag = cli.IntFlag{
+		Name:  "pacemaker-peer-port",
+		Usage: "port of the mutual TLS transport dialed on other delegates",
+		Value: consensus.DEFAULT_PACEMAKER_PORT,
+	}
+	pacemakerLegacyFlag = cli.BoolFlag{
+		Name:  "pacemaker-legacy",
+		Usage: "also accept plaintext consensus messages from committee members on port 8670 and send them to delegates without the TLS transport, only while delegates are upgrading",
+	}
+	traceEndpointFlag = cli.StringFlag{
+		Name:  "trace-endpoint",
+		Usage: "OTLP/HTTP collector address block lifecycle spans are exported to, e.g. localhost:4318",
+	}
+	traceFileFlag = cli.StringFlag{
+		Name:  "trace-file",
+		Usage: "path of the file block lifecycle spans are appended to as JSON",
+	}
 	httpsKeyFlag = cli.StringFlag{
 		Name:  "https-key",
 		Usage: "path for https key file (default is meterio.key)",
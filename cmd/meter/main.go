@@ -6,7 +6,7 @@
 package main
 
 import (
-	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/json"
@@ -36,8 +36,7 @@ import (
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/cmd/meter/node"
 	"github.com/meterio/meter-pov/consensus"
-	"github.com/meterio/meter-pov/genesis"
-	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/kv"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/packer"
 	"github.com/meterio/meter-pov/powpool"
@@ -45,6 +44,7 @@ import (
 	"github.com/meterio/meter-pov/preset"
 	"github.com/meterio/meter-pov/script"
 	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tracing"
 	"github.com/meterio/meter-pov/trie"
 	"github.com/meterio/meter-pov/txpool"
 	"github.com/meterio/meter-pov/types"
@@ -76,7 +76,6 @@ var (
 )
 
 const (
-	statePruningBatch = 1024
 	indexPruningBatch = 256
 	// indexFlatterningBatch = 1024
 	GCInterval = 5 * 60 * 1000 // 5 min in millisecond
@@ -108,12 +107,18 @@ func main() {
 		Flags: []cli.Flag{
 			networkFlag,
 			dataDirFlag,
+			dbEngineFlag,
 			beneficiaryFlag,
 			apiAddrFlag,
 			apiCorsFlag,
 			apiTimeoutFlag,
 			apiCallGasLimitFlag,
 			apiBacktraceLimitFlag,
+			apiAdminTokenFlag,
+			txpoolJournalFlag,
+			txpoolRejournalFlag,
+			txpoolJournalLimitFlag,
+			txpoolPriceBumpFlag,
 			verbosityFlag,
 			maxPeersFlag,
 			p2pPortFlag,
@@ -134,6 +139,14 @@ func main() {
 			httpsCertFlag,
 			httpsKeyFlag,
 			enableStatePruneFlag,
+			statePruningKeepFlag,
+			pacemakerPortFlag,
+			pacemakerPeerPortFlag,
+			pacemakerLegacyFlag,
+			syncModeFlag,
+			snapCheckpointFlag,
+			traceEndpointFlag,
+			traceFileFlag,
 		},
 		Action: defaultAction,
 		Commands: []cli.Command{
@@ -237,10 +250,10 @@ func defaultAction(ctx *cli.Context) error {
 		go pruneIndexTrie(ctx, mainDB, chain)
 	}
 
-	enableStatePruning := ctx.Bool(enableStatePruneFlag.Name)
-	if enableStatePruning {
+	var statePruner *node.StatePruner
+	if ctx.Bool(enableStatePruneFlag.Name) {
 		fmt.Println("!!! State Trie Pruning ENABLED !!!")
-		go pruneStateTrie(ctx, gene, mainDB, chain)
+		statePruner = node.NewStatePruner(chain, mainDB, uint32(ctx.Uint(statePruningKeepFlag.Name)))
 	}
 
 	master, blsCommon := loadNodeMaster(ctx)
@@ -253,6 +266,21 @@ func defaultAction(ctx *cli.Context) error {
 		panic("pubkey mismatch")
 	}
 
+	shutdownTracing, err := tracing.Init(tracing.Options{
+		Endpoint: ctx.String(traceEndpointFlag.Name),
+		File:     ctx.String(traceFileFlag.Name),
+		Node:     master.Address().String(),
+	})
+	if err != nil {
+		fatal("init tracing:", err)
+	}
+	defer func() {
+		slog.Info("stopping tracing...")
+		c, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		shutdownTracing(c)
+	}()
+
 	// load preset config
 	if "warringstakes" == ctx.String(networkFlag.Name) {
 		config := preset.TestnetPresetConfig
@@ -294,6 +322,15 @@ func defaultAction(ctx *cli.Context) error {
 	initDelegates := types.LoadDelegatesFile(ctx, blsCommon)
 	printDelegates(initDelegates)
 
+	if journal := ctx.String(txpoolJournalFlag.Name); journal != "" {
+		if !filepath.IsAbs(journal) {
+			journal = filepath.Join(instanceDir, journal)
+		}
+		defaultTxPoolOptions.Journal = journal
+		defaultTxPoolOptions.Rejournal = ctx.Duration(txpoolRejournalFlag.Name)
+		defaultTxPoolOptions.JournalLimit = ctx.Int(txpoolJournalLimitFlag.Name)
+	}
+	defaultTxPoolOptions.PriceBump = ctx.Uint64(txpoolPriceBumpFlag.Name)
 	txPool := txpool.New(chain, state.NewCreator(mainDB), defaultTxPoolOptions)
 	defer func() { slog.Info("closing tx pool..."); txPool.Close() }()
 
@@ -306,7 +343,7 @@ func defaultAction(ctx *cli.Context) error {
 	powPool := powpool.New(defaultPowPoolOptions, chain, state.NewCreator(mainDB))
 	defer func() { slog.Info("closing pow pool..."); powPool.Close() }()
 
-	p2pcom := newP2PComm(ctx, exitSignal, chain, txPool, instanceDir, powPool, p2pMagic)
+	p2pcom := newP2PComm(ctx, exitSignal, chain, mainDB, txPool, instanceDir, powPool, p2pMagic)
 
 	powApiHandler, powApiCloser := pow_api.New(powPool)
 	defer func() { slog.Info("closing Pow Pool API..."); powApiCloser() }()
@@ -320,7 +357,7 @@ func defaultAction(ctx *cli.Context) error {
 	reactor := consensus.NewConsensusReactor(ctx, chain, logDB, p2pcom.comm, txPool, pker, stateCreator, master.PrivateKey, master.PublicKey, consensusMagic, blsCommon, initDelegates)
 	// calculate committee so that relay is not an issue
 
-	apiHandler, apiCloser := api.New(reactor, chain, state.NewCreator(mainDB), txPool, logDB, p2pcom.comm, ctx.String(apiCorsFlag.Name), uint32(ctx.Int(apiBacktraceLimitFlag.Name)), uint64(ctx.Int(apiCallGasLimitFlag.Name)), p2pcom.p2pSrv, pubkey)
+	apiHandler, apiCloser := api.New(reactor, chain, state.NewCreator(mainDB), txPool, logDB, p2pcom.comm, ctx.String(apiCorsFlag.Name), uint32(ctx.Int(apiBacktraceLimitFlag.Name)), uint64(ctx.Int(apiCallGasLimitFlag.Name)), p2pcom.p2pSrv, pubkey, ctx.String(apiAdminTokenFlag.Name))
 	defer func() { slog.Info("closing API..."); apiCloser() }()
 
 	apiURL, srvCloser := startAPIServer(ctx, apiHandler, chain.GenesisBlock().ID())
@@ -329,6 +366,9 @@ func defaultAction(ctx *cli.Context) error {
 	observeURL, observeSrvCloser := startObserveServer(ctx, reactor, pubkey, p2pcom.comm, chain, stateCreator)
 	defer func() { slog.Info("closing Observe Server ..."); observeSrvCloser() }()
 
+	pacemakerSrvCloser := startPacemakerServer(reactor)
+	defer func() { slog.Info("closing Pacemaker Server ..."); pacemakerSrvCloser() }()
+
 	//also create the POW components
 	// powR := pow.NewPowpoolReactor(chain, stateCreator, powpool)
 
@@ -346,7 +386,8 @@ func defaultAction(ctx *cli.Context) error {
 		txPool,
 		filepath.Join(instanceDir, "tx.stash"),
 		p2pcom.comm,
-		sc).
+		sc,
+		statePruner).
 		Run(exitSignal)
 }
 
@@ -429,7 +470,7 @@ func masterKeyAction(ctx *cli.Context) error {
 	return nil
 }
 
-func pruneIndexTrie(ctx *cli.Context, mainDB *lvldb.LevelDB, meterChain *chain.Chain) {
+func pruneIndexTrie(ctx *cli.Context, mainDB kv.Store, meterChain *chain.Chain) {
 	toBlk := meterChain.BestBlockBeforeIndexFlattern()
 	slog.Info("Start to prune index trie", "to", toBlk.Number())
 
@@ -480,78 +521,3 @@ func pruneIndexTrie(ctx *cli.Context, mainDB *lvldb.LevelDB, meterChain *chain.C
 	meterChain.UpdatePruneIndexHead(toBlk.Number())
 	slog.Info("Prune index trie completed", "elapsed", meter.PrettyDuration(time.Since(start)), "head", toBlk.Number(), "prunedNodes", prunedNodes, "prunedBytes", prunedBytes)
 }
-
-func pruneStateTrie(ctx *cli.Context, gene *genesis.Genesis, mainDB *lvldb.LevelDB, meterChain *chain.Chain) {
-	creator := state.NewCreator(mainDB)
-	geneBlk, _, _ := gene.Build(creator)
-	fmt.Println("!!! State Trie Puring Routine Started !!!")
-	for {
-		bestNum := meterChain.BestBlock().Number()
-		snapNum, _ := meterChain.GetStateSnapshotNum() // ignore err, default is 0
-		targetNum := bestNum - 13500000
-		fmt.Println("!!! State Trie Pruning Check !!!")
-		fmt.Printf("Snapshot: %v, Best: %v, Target: %v\n", snapNum, bestNum, targetNum)
-		if snapNum >= targetNum {
-			fmt.Println("Snapshot >= Target, skip pruning for now")
-			time.Sleep(8 * time.Hour)
-			continue
-		}
-		snapNum = targetNum
-		pruneStateHead, _ := meterChain.GetPruneStateHead() // ignore err, default is 0
-		fmt.Printf("Prune State Head: %v\n", pruneStateHead)
-		if snapNum-pruneStateHead < 8000000 {
-			fmt.Println("Not enough for pruning, skip pruning for now")
-			time.Sleep(8 * time.Hour)
-			continue
-		}
-
-		snapBlk, _ := meterChain.GetTrunkBlock(targetNum)
-
-		pruner := trie.NewPruner(mainDB, ctx.String(dataDirFlag.Name))
-		fmt.Println("Load/Generate Snapshot Bloom")
-		pruner.InitForStatePruning(geneBlk.StateRoot(), snapBlk.StateRoot(), snapBlk.Number())
-		fmt.Println("Snapshot Bloom Loaded.")
-
-		meterChain.UpdateStateSnapshotNum(snapNum)
-		fmt.Println("Snapshot Num updated to", snapNum)
-
-		var (
-			lastRoot    = meter.Bytes32{}
-			prunedBytes = uint64(0)
-			prunedNodes = 0
-			start       = time.Now()
-			lastReport  = start
-		)
-
-		batch := mainDB.NewBatch()
-		for i := pruneStateHead + 1; i < snapNum-1; i++ {
-			b, _ := meterChain.GetTrunkBlock(i)
-			root := b.StateRoot()
-			if bytes.Equal(root[:], lastRoot[:]) {
-				continue
-			}
-			lastRoot = root
-			// pruneStart := time.Now()
-			stat := pruner.Prune(root, batch)
-			prunedNodes += stat.PrunedNodes + stat.PrunedStorageNodes
-			prunedBytes += stat.PrunedNodeBytes + stat.PrunedStorageBytes
-			// slog.Info(fmt.Sprintf("Pruned block %v", i), "prunedNodes", stat.PrunedNodes+stat.PrunedStorageNodes, "prunedBytes", stat.PrunedNodeBytes+stat.PrunedStorageBytes, "elapsed", meter.PrettyDuration(time.Since(pruneStart)))
-			if time.Since(lastReport) > time.Second*8 {
-				slog.Info("Still pruning state trie", "elapsed", meter.PrettyDuration(time.Since(start)), "prunedNodes", prunedNodes, "prunedBytes", prunedBytes)
-				lastReport = time.Now()
-			}
-			if batch.Len() >= statePruningBatch || i == snapNum {
-				if err := batch.Write(); err != nil {
-					slog.Error("Error flushing", "err", err)
-				}
-				slog.Info("Commited batch for state pruning", "len", batch.Len(), "head", i)
-
-				batch = mainDB.NewBatch()
-				meterChain.UpdatePruneStateHead(i)
-			}
-
-		}
-		slog.Info("Prune state trie completed", "elapsed", meter.PrettyDuration(time.Since(start)), "prunedNodes", prunedNodes, "prunedBytes", prunedBytes)
-		time.Sleep(8 * time.Hour)
-	}
-}
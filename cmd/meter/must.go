@@ -35,6 +35,8 @@ import (
 	"github.com/meterio/meter-pov/comm"
 	"github.com/meterio/meter-pov/consensus"
 	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/kvstore"
 	"github.com/meterio/meter-pov/logdb"
 	"github.com/meterio/meter-pov/lvldb"
 	"github.com/meterio/meter-pov/meter"
@@ -86,6 +88,18 @@ func initLogger(ctx *cli.Context) {
 
 func selectGenesis(ctx *cli.Context) *genesis.Genesis {
 	network := ctx.String(networkFlag.Name)
+	if genesis.IsNetworkFile(network) {
+		spec, err := genesis.LoadNetworkFile(network)
+		if err != nil {
+			fatal(fmt.Sprintf("load network file [%v]: %v", network, err))
+		}
+		meter.InitCustomBlockChainConfig(spec.Name, spec.ChainID, spec.Forks)
+		gene, err := genesis.NewCustomNetwork(spec)
+		if err != nil {
+			fatal("build custom network genesis:", err)
+		}
+		return gene
+	}
 	switch network {
 	case "warringstakes":
 		fallthrough
@@ -149,7 +163,7 @@ func makeSnapshotDir(ctx *cli.Context) string {
 	return snapshotDir
 }
 
-func openMainDB(ctx *cli.Context, dataDir string) *lvldb.LevelDB {
+func openMainDB(ctx *cli.Context, dataDir string) kv.Store {
 	if _, err := fdlimit.Raise(5120 * 4); err != nil {
 		fatal("failed to increase fd limit", err)
 	}
@@ -172,7 +186,7 @@ func openMainDB(ctx *cli.Context, dataDir string) *lvldb.LevelDB {
 	}
 
 	dir := filepath.Join(dataDir, "main.db")
-	db, err := lvldb.New(dir, lvldb.Options{
+	db, err := kvstore.Open(ctx.String(dbEngineFlag.Name), dir, kvstore.Options{
 		CacheSize:              128,
 		OpenFilesCacheCapacity: fileCache,
 	})
@@ -191,7 +205,7 @@ func openLogDB(ctx *cli.Context, dataDir string) *logdb.LogDB {
 	return db
 }
 
-func initChain(gene *genesis.Genesis, mainDB *lvldb.LevelDB, logDB *logdb.LogDB) *chain.Chain {
+func initChain(gene *genesis.Genesis, mainDB kv.Store, logDB *logdb.LogDB) *chain.Chain {
 	genesisBlock, genesisEvents, err := gene.Build(state.NewCreator(mainDB))
 	if err != nil {
 		fatal("build genesis block: ", err)
@@ -292,7 +306,7 @@ type p2pComm struct {
 	peersCachePath string
 }
 
-func newP2PComm(cliCtx *cli.Context, ctx context.Context, chain *chain.Chain, txPool *txpool.TxPool, instanceDir string, powPool *powpool.PowPool, magic [4]byte) *p2pComm {
+func newP2PComm(cliCtx *cli.Context, ctx context.Context, chain *chain.Chain, mainDB kv.Store, txPool *txpool.TxPool, instanceDir string, powPool *powpool.PowPool, magic [4]byte) *p2pComm {
 	key, err := loadOrGeneratePrivateKey(filepath.Join(cliCtx.String("data-dir"), "p2p.key"))
 	if err != nil {
 		fatal("load or generate P2P key:", err)
@@ -366,12 +380,39 @@ func newP2PComm(cliCtx *cli.Context, ctx context.Context, chain *chain.Chain, tx
 	topic := cliCtx.String("disco-topic")
 
 	return &p2pComm{
-		comm:           comm.New(ctx, chain, txPool, powPool, topic, magic),
+		comm:           comm.New(ctx, chain, mainDB, syncMode(cliCtx), snapCheckpoint(cliCtx), txPool, powPool, topic, magic),
 		p2pSrv:         p2psrv.New(opts),
 		peersCachePath: peersCachePath,
 	}
 }
 
+func syncMode(ctx *cli.Context) comm.SyncMode {
+	switch mode := ctx.String(syncModeFlag.Name); mode {
+	case "full":
+		return comm.FullSync
+	case "snap":
+		return comm.SnapSync
+	default:
+		fatal("invalid sync mode:", mode)
+		return comm.FullSync
+	}
+}
+
+func snapCheckpoint(ctx *cli.Context) meter.Bytes32 {
+	str := ctx.String(snapCheckpointFlag.Name)
+	if str == "" {
+		if syncMode(ctx) == comm.SnapSync {
+			fatal(fmt.Sprintf("snap sync requires -%s", snapCheckpointFlag.Name))
+		}
+		return meter.Bytes32{}
+	}
+	id, err := meter.ParseBytes32(str)
+	if err != nil {
+		fatal("invalid snap checkpoint:", err)
+	}
+	return id
+}
+
 func (p *p2pComm) Start() {
 	start := time.Now()
 	if err := p.p2pSrv.Start(p.comm.Protocols()); err != nil {
@@ -438,8 +479,10 @@ func startObserveServer(ctx *cli.Context, cons *consensus.Reactor, comboPubkey s
 	mux.HandleFunc("/probe/pubkey", probe.HandlePubkey)
 	mux.HandleFunc("/probe/peers", probe.HandlePeers)
 
-	// dispatch the msg to reactor/pacemaker
-	mux.HandleFunc("/pacemaker", cons.OnReceiveMsg)
+	// keep the plaintext handler for delegates not upgraded to the TLS transport
+	if cons.Transport().Legacy() {
+		mux.HandleFunc("/pacemaker", cons.OnReceiveLegacyMsg)
+	}
 
 	srv := &http.Server{
 		Handler:      mux,
@@ -466,6 +509,45 @@ func startObserveServer(ctx *cli.Context, cons *consensus.Reactor, comboPubkey s
 	}
 }
 
+// startPacemakerServer serves consensus msgs over mutual TLS, only known delegates are accepted.
+func startPacemakerServer(cons *consensus.Reactor) func() {
+	transport := cons.Transport()
+	addr := fmt.Sprintf(":%d", transport.Port())
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		fatal(fmt.Sprintf("listen pacemaker addr [%v]: %v", addr, err))
+	}
+	mux := http.NewServeMux()
+	// dispatch the msg to reactor/pacemaker
+	mux.HandleFunc("/pacemaker", cons.OnReceiveMsg)
+
+	srv := &http.Server{
+		Handler:      mux,
+		TLSConfig:    transport.TLSConfig(),
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		IdleTimeout:  120 * time.Second,
+	}
+	var goes co.Goes
+	goes.Go(func() {
+		// certs are provided by TLSConfig
+		err := srv.ServeTLS(listener, "", "")
+		if err != nil {
+			if err != http.ErrServerClosed {
+				fmt.Println("pacemaker server stopped, error:", err)
+			}
+		}
+	})
+	slog.Info("pacemaker server started", "addr", listener.Addr().String())
+	return func() {
+		err := srv.Close()
+		if err != nil {
+			fmt.Println("can't close pacemaker service, error:", err)
+		}
+		goes.Wait()
+	}
+}
+
 func startAPIServer(ctx *cli.Context, handler http.Handler, genesisID meter.Bytes32) (string, func()) {
 	addr := ctx.String(apiAddrFlag.Name)
 	listener, err := net.Listen("tcp", addr)
@@ -50,6 +50,7 @@ type Node struct {
 	txStashPath string
 	comm        *comm.Communicator
 	script      *script.ScriptEngine
+	pruner      *StatePruner
 	logger      *slog.Logger
 }
 
@@ -72,6 +73,7 @@ func New(
 	txStashPath string,
 	comm *comm.Communicator,
 	script *script.ScriptEngine,
+	pruner *StatePruner,
 ) *Node {
 	node := &Node{
 		reactor:     reactor,
@@ -83,6 +85,7 @@ func New(
 		txStashPath: txStashPath,
 		comm:        comm,
 		script:      script,
+		pruner:      pruner,
 		logger:      slog.With("pkg", "node"),
 	}
 	SetGlobNode(node)
@@ -94,8 +97,12 @@ func (n *Node) Run(ctx context.Context) error {
 
 	n.goes.Go(func() { n.houseKeeping(ctx) })
 	n.goes.Go(func() { n.txStashLoop(ctx) })
+	n.goes.Go(func() { n.loadTxJournal(ctx) })
 
 	n.goes.Go(func() { n.reactor.OnStart(ctx) })
+	if n.pruner != nil {
+		n.goes.Go(func() { n.pruner.Run(ctx) })
+	}
 	go n.printStats(time.Minute)
 
 	n.goes.Wait()
@@ -286,6 +293,24 @@ func (n *Node) txStashLoop(ctx context.Context) {
 	}
 }
 
+// loadTxJournal restores journaled local txs once synced, and re-announces them to peers.
+func (n *Node) loadTxJournal(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-n.comm.Synced():
+	}
+
+	txs, err := n.txPool.LoadJournal()
+	if err != nil {
+		n.logger.Warn("load tx journal", "err", err)
+	}
+	if len(txs) > 0 {
+		n.comm.BroadcastTxs(txs)
+		n.logger.Info("re-announced journaled txs", "count", len(txs))
+	}
+}
+
 func (n *Node) processBlock(blk *block.Block, escortQC *block.QuorumCert, stats *blockStats) (bool, error) {
 	startTime := mclock.Now()
 	now := uint64(time.Now().Unix())
@@ -359,10 +384,8 @@ func (n *Node) commitBlock(newBlock *block.Block, escortQC *block.QuorumCert, re
 		return nil, err
 	}
 
-	if meter.IsMainNet() {
-		if newBlock.Number() == meter.TeslaMainnetStartNum {
-			script.EnterTeslaForkInit()
-		}
+	if meter.IsTeslaForkInit(newBlock.Number()) {
+		script.EnterTeslaForkInit()
 	}
 
 	// skip logdb access if no txs
@@ -375,9 +398,11 @@ func (n *Node) commitBlock(newBlock *block.Block, escortQC *block.QuorumCert, re
 		batch := n.logDB.Prepare(newBlock.Header())
 		for i, tx := range newBlock.Transactions() {
 			origin, _ := tx.Signer()
+			batch.InsertTx(uint32(i), tx, origin, receipts[i].Reverted)
 			txBatch := batch.ForTransaction(tx.ID(), origin)
 			for _, output := range receipts[i].Outputs {
 				txBatch.Insert(output.Events, output.Transfers)
+				batch.InsertStakingRecords(tx.ID(), origin, output.StakingRecords)
 			}
 		}
 
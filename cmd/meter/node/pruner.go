@@ -0,0 +1,327 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package node
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/trie"
+	"github.com/pkg/errors"
+)
+
+const (
+	// size in MB of each bloom filter used by the state pruner
+	statePruneBloomSize = 256
+	// min number of prunable blocks to start a prune round
+	statePruneInterval = 100000
+	// number of nodes to delete in one batch
+	statePruneBatch = 16384
+	// interval to check whether a prune round is due
+	statePruneCheckInterval = 10 * time.Minute
+	// the pruner pauses while the best block lags behind by more than this
+	statePruneMaxLag = 60 * time.Second
+	// MinStatePruneKeep is the min number of recent blocks whose states are kept, well beyond
+	// the drafts and unfinalized blocks, and the default api backtrace limit.
+	MinStatePruneKeep = 1000
+)
+
+var emptyStorageRoot = meter.Blake2b(rlp.EmptyString)
+
+// StatePruner deletes stale state trie nodes in the background while the node is running.
+// The states of genesis, every KBlock and the last `keep` blocks are kept. Progress is saved
+// as the prune state head along with each batch of deletions, so pruning resumes after restart.
+type StatePruner struct {
+	chain     *chain.Chain
+	db        kv.GetPutter
+	keep      uint32
+	bloomSize uint64
+	logger    *slog.Logger
+
+	kept        *trie.StateBloom           // nodes of the kept states
+	swept       *trie.StateBloom           // nodes already visited by sweeping
+	marked      uint32                     // the last block whose state is marked as kept
+	pending     map[meter.Bytes32]struct{} // swept nodes to be deleted in the next batch
+	recentRoots func() []meter.Bytes32     // roots committed ahead of their blocks or drafts
+}
+
+func NewStatePruner(chain *chain.Chain, db kv.GetPutter, keep uint32) *StatePruner {
+	logger := slog.With("pkg", "pruner")
+	if keep < MinStatePruneKeep {
+		logger.Warn("too few blocks to keep states, use the min", "keep", keep, "min", MinStatePruneKeep)
+		keep = MinStatePruneKeep
+	}
+	return &StatePruner{
+		chain:       chain,
+		db:          db,
+		keep:        keep,
+		bloomSize:   statePruneBloomSize,
+		logger:      logger,
+		recentRoots: state.RecentRoots,
+	}
+}
+
+func (p *StatePruner) Run(ctx context.Context) {
+	p.logger.Info("state pruner started", "keep", p.keep)
+	defer p.logger.Info("state pruner stopped")
+
+	ticker := time.NewTicker(statePruneCheckInterval)
+	defer ticker.Stop()
+	for {
+		if err := p.prune(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Error("prune state failed", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// prune runs a round which prunes the states from the prune state head up to the kept window.
+func (p *StatePruner) prune(ctx context.Context) error {
+	best := p.chain.BestBlock()
+	if best.Number() <= p.keep {
+		return nil
+	}
+	target := best.Number() - p.keep
+	head, _ := p.chain.GetPruneStateHead() // ignore err, default is 0
+	if target < head+statePruneInterval || p.lagging() {
+		return nil
+	}
+
+	start := time.Now()
+	p.logger.Info("start to prune state", "head", head, "target", target, "best", best.Number())
+	if err := p.reset(); err != nil {
+		return err
+	}
+	if err := p.markKBlocks(ctx, target); err != nil {
+		return err
+	}
+	p.marked = target - 1
+	if err := p.markNew(ctx); err != nil {
+		return err
+	}
+	p.logger.Info("marked kept states", "elapsed", meter.PrettyDuration(time.Since(start)))
+
+	var (
+		lastRoot    meter.Bytes32
+		batchStart  = time.Now()
+		prunedNodes = 0
+	)
+	for num := head + 1; num < target; num++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		header, err := p.chain.GetTrunkBlockHeader(num)
+		if err != nil {
+			return err
+		}
+		if root := header.StateRoot(); root != lastRoot {
+			lastRoot = root
+			if err := p.sweep(root); err != nil {
+				return errors.WithMessagef(err, "sweep state of block %v", num)
+			}
+		}
+		if len(p.pending) < statePruneBatch {
+			continue
+		}
+		n, err := p.flush(ctx, num)
+		if err != nil {
+			return err
+		}
+		prunedNodes += n
+		// yield to block import, as long as the batch took
+		if err := p.pause(ctx, time.Since(batchStart)); err != nil {
+			return err
+		}
+		batchStart = time.Now()
+	}
+	n, err := p.flush(ctx, target-1)
+	if err != nil {
+		return err
+	}
+	prunedNodes += n
+	p.logger.Info("prune state completed", "head", target-1, "prunedNodes", prunedNodes, "elapsed", meter.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+func (p *StatePruner) reset() (err error) {
+	if p.kept, err = trie.NewStateBloomWithSize(p.bloomSize); err != nil {
+		return err
+	}
+	if p.swept, err = trie.NewStateBloomWithSize(p.bloomSize); err != nil {
+		return err
+	}
+	p.pending = make(map[meter.Bytes32]struct{})
+	return nil
+}
+
+// markKBlocks marks the states of genesis and all KBlocks before target.
+func (p *StatePruner) markKBlocks(ctx context.Context, target uint32) error {
+	if err := p.mark(p.chain.GenesisBlock().StateRoot()); err != nil {
+		return err
+	}
+	header, err := p.chain.GetTrunkBlockHeader(target)
+	if err != nil {
+		return err
+	}
+	for num := header.LastKBlockHeight(); num > 0; num = header.LastKBlockHeight() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if header, err = p.chain.GetTrunkBlockHeader(num); err != nil {
+			return err
+		}
+		if err := p.mark(header.StateRoot()); err != nil {
+			return errors.WithMessagef(err, "mark state of kblock %v", num)
+		}
+		if header.LastKBlockHeight() >= num {
+			break
+		}
+	}
+	return nil
+}
+
+// markNew marks the states of blocks added since last call, and the states of drafts and recent commits.
+func (p *StatePruner) markNew(ctx context.Context) error {
+	best := p.chain.BestBlock().Number()
+	for ; p.marked < best; p.marked++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		header, err := p.chain.GetTrunkBlockHeader(p.marked + 1)
+		if err != nil {
+			return err
+		}
+		if err := p.mark(header.StateRoot()); err != nil {
+			return errors.WithMessagef(err, "mark state of block %v", p.marked+1)
+		}
+	}
+	for _, root := range append(p.chain.GetDraftStateRoots(), p.recentRoots()...) {
+		// drafts may be dropped at any time, so their states might be incomplete
+		if err := p.mark(root); err != nil {
+			p.logger.Debug("mark new state failed", "root", root, "err", err)
+		}
+	}
+	return nil
+}
+
+// mark adds the nodes of state at root to the kept set, subtrees already kept are skipped.
+func (p *StatePruner) mark(root meter.Bytes32) error {
+	canSkip := func(key []byte) bool {
+		kept, _ := p.kept.Contain(key)
+		return kept
+	}
+	return p.walk(root, canSkip, func(key []byte) { p.kept.Put(key) }, true)
+}
+
+// sweep collects the nodes of state at root which are neither kept nor swept yet.
+func (p *StatePruner) sweep(root meter.Bytes32) error {
+	canSkip := func(key []byte) bool {
+		if kept, _ := p.kept.Contain(key); kept {
+			return true
+		}
+		if swept, _ := p.swept.Contain(key); swept {
+			return true
+		}
+		// pruned by last run
+		has, _ := p.db.Has(key)
+		return !has
+	}
+	mark := func(key []byte) {
+		p.swept.Put(key)
+		p.pending[meter.BytesToBytes32(key)] = struct{}{}
+	}
+	return p.walk(root, canSkip, mark, true)
+}
+
+// walk visits the nodes of trie at root, and the storage tries of accounts if it's a state trie.
+func (p *StatePruner) walk(root meter.Bytes32, canSkip func([]byte) bool, mark func([]byte), isState bool) error {
+	if root.IsZero() || root == emptyStorageRoot || canSkip(root[:]) {
+		return nil
+	}
+	mark(root[:])
+
+	tr, err := trie.New(root, p.db)
+	if err != nil {
+		return err
+	}
+	it := trie.NewPruneIterator(tr, canSkip, mark, p.db.Get)
+	for it.Next(true) {
+		if !isState || !it.Leaf() {
+			continue
+		}
+		var acc trie.StateAccount
+		if err := rlp.DecodeBytes(it.LeafBlob(), &acc); err != nil {
+			return err
+		}
+		if len(acc.StorageRoot) > 0 {
+			if err := p.walk(meter.BytesToBytes32(acc.StorageRoot), canSkip, mark, false); err != nil {
+				return err
+			}
+		}
+	}
+	return it.Error()
+}
+
+// flush deletes the pending nodes along with saving the prune state head.
+// State commits are held meanwhile, so that nodes recreated by new states are never deleted.
+func (p *StatePruner) flush(ctx context.Context, head uint32) (int, error) {
+	// mark the bulk of new states without holding commits
+	if err := p.markNew(ctx); err != nil {
+		return 0, err
+	}
+	unlock := state.LockCommits()
+	defer unlock()
+	if err := p.markNew(ctx); err != nil {
+		return 0, err
+	}
+
+	batch := p.db.NewBatch()
+	for hash := range p.pending {
+		if kept, _ := p.kept.Contain(hash[:]); !kept {
+			batch.Delete(hash[:])
+		}
+	}
+	n := batch.Len()
+	if err := p.chain.PutPruneStateHead(batch, head); err != nil {
+		return 0, err
+	}
+	if err := batch.Write(); err != nil {
+		return 0, err
+	}
+	p.pending = make(map[meter.Bytes32]struct{})
+	p.logger.Debug("flushed pruned state nodes", "head", head, "nodes", n)
+	return n, nil
+}
+
+func (p *StatePruner) lagging() bool {
+	best := p.chain.BestBlock()
+	return time.Since(time.Unix(int64(best.Timestamp()), 0)) > statePruneMaxLag
+}
+
+// pause sleeps for d, and keeps waiting while the node is catching up.
+func (p *StatePruner) pause(ctx context.Context, d time.Duration) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d):
+		}
+		if !p.lagging() {
+			return nil
+		}
+		d = statePruneMaxLag
+	}
+}
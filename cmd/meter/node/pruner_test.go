@@ -0,0 +1,94 @@
+// Copyright (c) 2020 The Meter.io developers
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package node
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatePruner(t *testing.T) {
+	db, _ := lvldb.NewMem()
+	defer db.Close()
+
+	b0, _, err := genesis.NewDevnet().Build(state.NewCreator(db))
+	assert.Nil(t, err)
+	c, err := chain.New(db, b0, false)
+	assert.Nil(t, err)
+
+	var (
+		acc      = meter.BytesToAddress([]byte("acc"))
+		contract = meter.BytesToAddress([]byte("contract"))
+		other    = meter.BytesToAddress([]byte("other"))
+		key      = meter.BytesToBytes32([]byte("key"))
+	)
+	commit := func(parent meter.Bytes32, balance int64, value byte, addOther bool) meter.Bytes32 {
+		st, err := state.New(parent, db)
+		assert.Nil(t, err)
+		st.SetBalance(acc, big.NewInt(balance))
+		st.SetStorage(contract, key, meter.BytesToBytes32([]byte{value}))
+		if addOther {
+			st.SetBalance(other, big.NewInt(1))
+		}
+		root, err := st.Stage().Commit()
+		assert.Nil(t, err)
+		return root
+	}
+	stale := commit(b0.StateRoot(), 1, 1, false)
+	kept := commit(stale, 2, 2, false)
+
+	p := NewStatePruner(c, db, 0)
+	assert.Equal(t, uint32(MinStatePruneKeep), p.keep)
+	p.bloomSize = 1
+	var inflight []meter.Bytes32
+	p.recentRoots = func() []meter.Bytes32 { return inflight }
+	assert.Nil(t, p.reset())
+	assert.Nil(t, p.mark(b0.StateRoot()))
+	assert.Nil(t, p.mark(kept))
+	assert.Nil(t, p.sweep(stale))
+	assert.NotEmpty(t, p.pending)
+
+	// a draft committed while pruning recreates the stale storage
+	draft := commit(kept, 1, 1, true)
+	c.AddDraft(&block.DraftBlock{ProposedBlock: new(block.Builder).StateRoot(draft).Build()})
+	// so does a state committed ahead of its block
+	executed := commit(kept, 3, 1, false)
+	inflight = append(inflight, executed)
+
+	n, err := p.flush(context.Background(), 5)
+	assert.Nil(t, err)
+	assert.True(t, n > 0)
+	head, err := c.GetPruneStateHead()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(5), head)
+
+	has, _ := db.Has(stale[:])
+	assert.False(t, has, "stale state should be pruned")
+
+	noSkip := func([]byte) bool { return false }
+	for _, root := range []meter.Bytes32{b0.StateRoot(), kept, draft, executed} {
+		assert.Nil(t, p.walk(root, noSkip, func([]byte) {}, true))
+	}
+	st, err := state.New(draft, db)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), st.GetBalance(acc))
+	assert.Equal(t, meter.BytesToBytes32([]byte{1}), st.GetStorage(contract, key))
+	assert.Nil(t, st.Err())
+
+	// swept nodes pruned by last run are skipped
+	assert.Nil(t, p.reset())
+	assert.Nil(t, p.sweep(stale))
+	assert.Empty(t, p.pending)
+}
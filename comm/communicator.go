@@ -21,6 +21,7 @@ import (
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/co"
 	"github.com/meterio/meter-pov/comm/proto"
+	"github.com/meterio/meter-pov/kv"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/p2psrv"
 	"github.com/meterio/meter-pov/powpool"
@@ -39,9 +40,13 @@ var (
 
 // Communicator communicates with remote p2p peers to exchange blocks and txs, etc.
 type Communicator struct {
-	chain  *chain.Chain
-	txPool *txpool.TxPool
-	ctx    context.Context
+	chain    *chain.Chain
+	db       kv.GetPutter
+	syncMode SyncMode
+	// trusted kblock snap sync starts from
+	snapCheckpoint meter.Bytes32
+	txPool         *txpool.TxPool
+	ctx            context.Context
 	// cancel         context.CancelFunc
 	peerSet        *PeerSet
 	syncedCh       chan struct{}
@@ -59,12 +64,15 @@ type Communicator struct {
 }
 
 // New create a new Communicator instance.
-func New(ctx context.Context, chain *chain.Chain, txPool *txpool.TxPool, powPool *powpool.PowPool, configTopic string, magic [4]byte) *Communicator {
+func New(ctx context.Context, chain *chain.Chain, db kv.GetPutter, syncMode SyncMode, snapCheckpoint meter.Bytes32, txPool *txpool.TxPool, powPool *powpool.PowPool, configTopic string, magic [4]byte) *Communicator {
 	return &Communicator{
-		chain:   chain,
-		txPool:  txPool,
-		powPool: powPool,
-		ctx:     ctx,
+		chain:          chain,
+		db:             db,
+		syncMode:       syncMode,
+		snapCheckpoint: snapCheckpoint,
+		txPool:         txPool,
+		powPool:        powPool,
+		ctx:            ctx,
 		// cancel:         cancel,
 		peerSet:        newPeerSet(),
 		syncedCh:       make(chan struct{}),
@@ -131,6 +139,14 @@ func (c *Communicator) Sync(handler HandleBlockStream) {
 					// if more than 3 peers connected, we are assumed to be the best
 					c.logger.Debug("synchronization done, best assumed")
 				} else {
+					// a fresh node in snap mode starts from the state of a recent kblock
+					if c.syncMode == SnapSync && best.Number() == 0 {
+						if err := c.snapSync(peer); err != nil {
+							peer.logger.Warn("snap sync failed", "err", err)
+							break
+						}
+						best = c.chain.BestBlock().Header()
+					}
 					if err := c.sync(peer, best.Number(), handler); err != nil {
 						peer.logger.Debug("synchronization failed", "err", err)
 						break
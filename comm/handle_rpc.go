@@ -215,6 +215,27 @@ func (c *Communicator) handleRPC(peer *Peer, msg *p2p.Msg, write func(interface{
 			}
 			write(toSend)
 		}
+	case proto.MsgGetTrieRange:
+		var req proto.TrieRangeRequest
+		if err := msg.Decode(&req); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
+
+		c.logger.Debug(fmt.Sprintf(`call in: GetTrieRange(%v) from %s`, req.Root, meter.Addr2IP(peer.RemoteAddr())))
+		result, err := serveTrieRange(c.db, &req)
+		if err != nil {
+			c.logger.Debug("failed to get trie range", "root", req.Root, "err", err)
+			result = &proto.TrieRange{}
+		}
+		write(result)
+	case proto.MsgGetTrieNodes:
+		var hashes []meter.Bytes32
+		if err := msg.Decode(&hashes); err != nil {
+			return errors.WithMessage(err, "decode msg")
+		}
+
+		c.logger.Debug(fmt.Sprintf(`call in: GetTrieNodes(%d) from %s`, len(hashes), meter.Addr2IP(peer.RemoteAddr())))
+		write(serveTrieNodes(c.db, hashes))
 	case proto.MsgNewPowBlock:
 		peer.logger.Debug(`call in: NewPowBlock`)
 		// Disable the powpool gossip.
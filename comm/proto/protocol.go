@@ -26,6 +26,8 @@ const (
 	MsgGetBlocksFromNumber // fetch blocks from given number (including given number)
 	MsgGetTxs
 	MsgNewPowBlock
+	MsgGetTrieRange // fetch leaves of account/storage trie in key range, with boundary proofs
+	MsgGetTrieNodes // fetch trie nodes or contract codes by hash
 )
 
 // MsgName convert msg code to string.
@@ -49,6 +51,10 @@ func MsgName(msgCode uint64) string {
 		return "GetTxs"
 	case MsgNewPowBlock:
 		return "NewPowBlock"
+	case MsgGetTrieRange:
+		return "GetTrieRange"
+	case MsgGetTrieNodes:
+		return "GetTrieNodes"
 	default:
 		return fmt.Sprintf("unknown msg code(%v)", msgCode)
 	}
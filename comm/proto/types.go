@@ -23,6 +23,21 @@ type (
 		BestBlockID    meter.Bytes32
 		TotalScore     uint64
 	}
+
+	// TrieRangeRequest arg of MsgGetTrieRange.
+	TrieRangeRequest struct {
+		Root  meter.Bytes32
+		Start []byte
+		Limit uint32
+	}
+
+	// TrieRange result of MsgGetTrieRange.
+	TrieRange struct {
+		Keys   [][]byte
+		Values [][]byte
+		Proof  [][]byte // nodes proving the first and the last key
+		More   bool     // whether leaves beyond the last key exist
+	}
 )
 
 type WireQC struct {
@@ -116,3 +131,24 @@ func GetTxs(ctx context.Context, rpc RPC) (tx.Transactions, error) {
 	}
 	return txs, nil
 }
+
+// GetTrieRange get a batch of leaves of trie with given root, starts with the start key.
+func GetTrieRange(ctx context.Context, rpc RPC, root meter.Bytes32, start []byte, limit uint32) (*TrieRange, error) {
+	var result TrieRange
+	if err := rpc.Call(ctx, MsgGetTrieRange, &TrieRangeRequest{root, start, limit}, &result); err != nil {
+		rpc.Debug("GetTrieRange failed", "root", root, "err", err)
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTrieNodes get trie nodes or contract codes by hash from remote peer.
+// Entries not found are returned as empty.
+func GetTrieNodes(ctx context.Context, rpc RPC, hashes []meter.Bytes32) ([][]byte, error) {
+	var nodes [][]byte
+	if err := rpc.Call(ctx, MsgGetTrieNodes, hashes, &nodes); err != nil {
+		rpc.Debug("GetTrieNodes failed", "len", len(hashes), "err", err)
+		return nil, err
+	}
+	return nodes, nil
+}
@@ -0,0 +1,474 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package comm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/comm/proto"
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/metric"
+	"github.com/meterio/meter-pov/trie"
+	"github.com/pkg/errors"
+)
+
+// SyncMode defines how a fresh node catches up with the network.
+type SyncMode int
+
+const (
+	// FullSync downloads and executes all blocks from genesis.
+	FullSync SyncMode = iota
+	// SnapSync downloads the state of a recent finalized kblock, and executes blocks after it.
+	SnapSync
+)
+
+const (
+	maxTrieRangeLeaves = 4096
+	maxTrieRangeSize   = 512 * 1024
+	maxTrieNodes       = 384
+	maxTrieNodesSize   = 512 * 1024
+	// leaves inserted before the rebuilding trie is flushed to db
+	trieCommitInterval = 65536
+)
+
+const (
+	// peers that must serve the checkpoint block before its state is downloaded
+	minPivotPeers = 2
+)
+
+var (
+	emptyRoot = meter.Blake2b(rlp.EmptyString)
+
+	errNoCheckpoint     = errors.New("snap sync requires a trusted checkpoint")
+	errStateUnavailable = errors.New("state unavailable on peer")
+)
+
+// snapSync downloads the state of the trusted checkpoint kblock, and makes the kblock
+// the best block, so that block sync continues after it.
+// The checkpoint ID commits to the state root, so the state is never trusted on a single peer's word,
+// and the pivot must be served by at least minPivotPeers peers.
+// The history before the kblock (blocks, receipts, logs and hash preimages) is not available locally.
+func (c *Communicator) snapSync(peer *Peer) error {
+	if c.snapCheckpoint.IsZero() {
+		return errNoCheckpoint
+	}
+	pivotNum := block.Number(c.snapCheckpoint)
+	pivot, err := c.getEscortedBlock(peer, pivotNum)
+	if err != nil {
+		return errors.WithMessage(err, "get pivot block")
+	}
+	if err := verifyPivot(pivot, c.snapCheckpoint); err != nil {
+		return err
+	}
+
+	// cross check the pivot with other peers
+	confirmed := 1
+	for _, other := range c.peerSet.Slice() {
+		if confirmed >= minPivotPeers {
+			break
+		}
+		if other == peer {
+			continue
+		}
+		blk, err := c.getEscortedBlock(other, pivotNum)
+		if err != nil {
+			other.logger.Debug("get pivot block", "err", err)
+			continue
+		}
+		if blk.Block.ID() != c.snapCheckpoint {
+			other.logger.Warn("peer serves a pivot block other than the checkpoint", "id", blk.Block.ID())
+			continue
+		}
+		confirmed++
+	}
+	if confirmed < minPivotPeers {
+		return fmt.Errorf("pivot served by %d peers, want at least %d", confirmed, minPivotPeers)
+	}
+
+	start := time.Now()
+	root := pivot.Block.StateRoot()
+	c.logger.Info("snap sync started", "pivot", pivotNum, "stateRoot", root, "peer", peer.String())
+	if err := newStateSync(c.ctx, peer, c.db, c.logger).run(root); err != nil {
+		return errors.WithMessage(err, "sync state")
+	}
+	if err := c.chain.InitPivot(pivot.Block, pivot.EscortQC); err != nil {
+		return errors.WithMessage(err, "init pivot")
+	}
+	c.logger.Info("snap sync done", "pivot", pivotNum, "elapsed", meter.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// verifyPivot checks the pivot is the checkpoint kblock, escorted by a QC voting for it.
+func verifyPivot(pivot *block.EscortedBlock, checkpoint meter.Bytes32) error {
+	if pivot.Block.ID() != checkpoint {
+		return errors.New("pivot block mismatches checkpoint")
+	}
+	if !pivot.Block.IsKBlock() {
+		return errors.New("pivot block is not a kblock")
+	}
+	qc := pivot.EscortQC
+	if qc == nil || qc.QCHeight != pivot.Block.Number() || qc.VoterMsgHash != pivot.Block.VotingHash() {
+		return errors.New("escort QC mismatches pivot block")
+	}
+	return nil
+}
+
+func (c *Communicator) getEscortedBlock(peer *Peer, num uint32) (*block.EscortedBlock, error) {
+	result, err := proto.GetBlocksFromNumber(c.ctx, peer, num)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, errors.New("block not found")
+	}
+	var blk block.EscortedBlock
+	if err := rlp.DecodeBytes(result[0], &blk); err != nil {
+		return nil, errors.Wrap(err, "invalid block")
+	}
+	if blk.Block.Number() != num {
+		return nil, errors.New("broken sequence")
+	}
+	return &blk, nil
+}
+
+// stateSync downloads the account trie with given root, along with storage tries and contract codes.
+// Tries are rebuilt from leaves fetched in ranges, and healed with nodes fetched by hash if the
+// rebuilt root mismatches.
+type stateSync struct {
+	ctx    context.Context
+	rpc    proto.RPC
+	db     kv.GetPutter
+	logger *slog.Logger
+
+	storageRoots map[meter.Bytes32]bool
+	codeHashes   map[meter.Bytes32]bool
+	accounts     int
+	slots        int
+	nodes        int
+	lastReport   time.Time
+}
+
+func newStateSync(ctx context.Context, rpc proto.RPC, db kv.GetPutter, logger *slog.Logger) *stateSync {
+	return &stateSync{
+		ctx:          ctx,
+		rpc:          rpc,
+		db:           db,
+		logger:       logger,
+		storageRoots: make(map[meter.Bytes32]bool),
+		codeHashes:   make(map[meter.Bytes32]bool),
+	}
+}
+
+func (s *stateSync) run(root meter.Bytes32) error {
+	if err := s.syncTrie(root, s.onAccount); err != nil {
+		return errors.WithMessage(err, "account trie")
+	}
+	for sroot := range s.storageRoots {
+		if err := s.syncTrie(sroot, func([]byte) error { s.slots++; return nil }); err != nil {
+			return errors.WithMessagef(err, "storage trie %v", sroot)
+		}
+	}
+
+	hashes := make([]meter.Bytes32, 0, len(s.codeHashes))
+	for hash := range s.codeHashes {
+		hashes = append(hashes, hash)
+	}
+	for len(hashes) > 0 {
+		codes, err := s.fetch(hashes)
+		if err != nil {
+			return errors.WithMessage(err, "codes")
+		}
+		batch := s.db.NewBatch()
+		for i, code := range codes {
+			if err := batch.Put(hashes[i].Bytes(), code); err != nil {
+				return err
+			}
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		hashes = hashes[len(codes):]
+	}
+
+	// nodes are committed only after their children, so the whole trie is there if the root is
+	if _, err := trie.New(root, s.db); err != nil {
+		return err
+	}
+	s.logger.Info("state downloaded", "root", root, "accounts", s.accounts, "slots", s.slots, "codes", len(s.codeHashes), "healedNodes", s.nodes)
+	return nil
+}
+
+// onAccount collects storage roots and code hashes of an account leaf to be downloaded.
+func (s *stateSync) onAccount(leaf []byte) error {
+	s.accounts++
+	var acc trie.StateAccount
+	if err := rlp.DecodeBytes(leaf, &acc); err != nil {
+		// leaves in the middle of a range are not proven, the trie gets healed for a bad one
+		s.logger.Debug("invalid account leaf", "err", err)
+		return nil
+	}
+	if len(acc.StorageRoot) > 0 {
+		if sroot := meter.BytesToBytes32(acc.StorageRoot); sroot != emptyRoot {
+			if has, _ := s.db.Has(sroot.Bytes()); !has {
+				s.storageRoots[sroot] = true
+			}
+		}
+	}
+	if len(acc.CodeHash) > 0 {
+		hash := meter.BytesToBytes32(acc.CodeHash)
+		if has, _ := s.db.Has(hash.Bytes()); !has {
+			s.codeHashes[hash] = true
+		}
+	}
+	if time.Since(s.lastReport) > 8*time.Second {
+		s.logger.Info("still downloading state", "accounts", s.accounts, "slots", s.slots)
+		s.lastReport = time.Now()
+	}
+	return nil
+}
+
+// syncTrie rebuilds the trie with given root from ranges of leaves, then heals it if needed.
+func (s *stateSync) syncTrie(root meter.Bytes32, onLeaf func(leaf []byte) error) error {
+	tr, err := trie.New(meter.Bytes32{}, s.db)
+	if err != nil {
+		return err
+	}
+	var (
+		start   []byte
+		pending int
+	)
+	for {
+		result, err := proto.GetTrieRange(s.ctx, s.rpc, root, start, maxTrieRangeLeaves)
+		if err != nil {
+			return err
+		}
+		if len(result.Keys) == 0 {
+			if start == nil {
+				return errStateUnavailable
+			}
+			break
+		}
+		if err := verifyTrieRange(root, start, result); err != nil {
+			return err
+		}
+		for i, key := range result.Keys {
+			if err := tr.TryUpdate(key, result.Values[i]); err != nil {
+				return err
+			}
+			if err := onLeaf(result.Values[i]); err != nil {
+				return err
+			}
+		}
+
+		pending += len(result.Keys)
+		if pending >= trieCommitInterval {
+			if _, err := s.commitTrie(tr); err != nil {
+				return err
+			}
+			pending = 0
+		}
+
+		start = nextKey(result.Keys[len(result.Keys)-1])
+		if !result.More || start == nil {
+			break
+		}
+	}
+
+	rebuilt, err := s.commitTrie(tr)
+	if err != nil {
+		return err
+	}
+	if rebuilt == root {
+		return nil
+	}
+	s.logger.Debug("rebuilt trie mismatch, start healing", "root", root, "rebuilt", rebuilt)
+	return s.heal(root, onLeaf)
+}
+
+func (s *stateSync) commitTrie(tr *trie.Trie) (meter.Bytes32, error) {
+	batch := s.db.NewBatch()
+	root, err := tr.CommitTo(batch)
+	if err != nil {
+		return meter.Bytes32{}, err
+	}
+	return root, batch.Write()
+}
+
+// heal downloads trie nodes missing locally by hash, starts from the root.
+func (s *stateSync) heal(root meter.Bytes32, onLeaf func(leaf []byte) error) error {
+	sched := trie.NewTrieSync(root, s.db, func(leaf []byte, _ meter.Bytes32) error {
+		return onLeaf(leaf)
+	})
+
+	var queue []meter.Bytes32
+	for {
+		if len(queue) < maxTrieNodes {
+			queue = append(queue, sched.Missing(maxTrieNodes-len(queue))...)
+		}
+		if len(queue) == 0 {
+			break
+		}
+		nodes, err := s.fetch(queue)
+		if err != nil {
+			return err
+		}
+		results := make([]trie.SyncResult, 0, len(nodes))
+		for i, data := range nodes {
+			results = append(results, trie.SyncResult{Hash: queue[i], Data: data})
+		}
+		if _, i, err := sched.Process(results); err != nil {
+			return errors.WithMessagef(err, "process node %v", results[i].Hash)
+		}
+		batch := s.db.NewBatch()
+		if _, err := sched.Commit(batch); err != nil {
+			return err
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		s.nodes += len(nodes)
+		queue = queue[len(nodes):]
+	}
+	if sched.Pending() > 0 {
+		return fmt.Errorf("%d trie nodes still pending", sched.Pending())
+	}
+	return nil
+}
+
+// fetch downloads trie nodes or codes by hash, a prefix of hashes may be served.
+func (s *stateSync) fetch(hashes []meter.Bytes32) ([][]byte, error) {
+	if len(hashes) > maxTrieNodes {
+		hashes = hashes[:maxTrieNodes]
+	}
+	result, err := proto.GetTrieNodes(s.ctx, s.rpc, hashes)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 || len(result) > len(hashes) {
+		return nil, errors.New("invalid trie nodes")
+	}
+	for i, data := range result {
+		if len(data) == 0 {
+			return nil, errStateUnavailable
+		}
+		// trie nodes are keyed by blake2b hash, while codes are keyed by keccak hash
+		if meter.Blake2b(data) != hashes[i] && meter.Bytes32(crypto.Keccak256Hash(data)) != hashes[i] {
+			return nil, errors.New("trie node hash mismatch")
+		}
+	}
+	return result, nil
+}
+
+// verifyTrieRange checks leaves are in ascending order from start, and the first and the last
+// leaves are proven by the root. The leaves between are verified by the root of rebuilt trie.
+func verifyTrieRange(root meter.Bytes32, start []byte, result *proto.TrieRange) error {
+	if len(result.Keys) != len(result.Values) {
+		return errors.New("invalid trie range: keys and values mismatch")
+	}
+	prev := start
+	for i, key := range result.Keys {
+		if prev != nil && bytes.Compare(key, prev) < 0 || i > 0 && bytes.Equal(key, prev) {
+			return errors.New("invalid trie range: keys out of order")
+		}
+		prev = key
+	}
+	proof := trie.NewProofSet(result.Proof)
+	for _, i := range []int{0, len(result.Keys) - 1} {
+		value, err, _ := trie.VerifyProof(root, result.Keys[i], proof)
+		if err != nil {
+			return errors.WithMessage(err, "invalid trie range proof")
+		}
+		if !bytes.Equal(value, result.Values[i]) {
+			return errors.New("invalid trie range proof: value mismatch")
+		}
+	}
+	return nil
+}
+
+// nextKey returns the smallest key greater than the given key with the same length,
+// or nil if there is no such key.
+func nextKey(key []byte) []byte {
+	next := append([]byte(nil), key...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			return next
+		}
+	}
+	return nil
+}
+
+// serveTrieRange collects leaves of the trie with given root from the start key, with proofs of
+// the first and the last leaf. An empty range is returned if the trie is not available.
+func serveTrieRange(db trie.Database, req *proto.TrieRangeRequest) (*proto.TrieRange, error) {
+	result := &proto.TrieRange{}
+	tr, err := trie.New(req.Root, db)
+	if err != nil {
+		return result, nil
+	}
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxTrieRangeLeaves {
+		limit = maxTrieRangeLeaves
+	}
+
+	var size metric.StorageSize
+	it := tr.NodeIterator(req.Start)
+	for it.Next(true) {
+		if !it.Leaf() {
+			continue
+		}
+		if len(result.Keys) >= limit || size >= maxTrieRangeSize {
+			result.More = true
+			break
+		}
+		key, value := it.LeafKey(), append([]byte(nil), it.LeafBlob()...)
+		result.Keys = append(result.Keys, key)
+		result.Values = append(result.Values, value)
+		size += metric.StorageSize(len(key) + len(value))
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	if len(result.Keys) == 0 {
+		return result, nil
+	}
+
+	proof := make(trie.ProofSet)
+	if err := tr.Prove(result.Keys[0], 0, proof); err != nil {
+		return nil, err
+	}
+	if err := tr.Prove(result.Keys[len(result.Keys)-1], 0, proof); err != nil {
+		return nil, err
+	}
+	result.Proof = proof.Nodes()
+	return result, nil
+}
+
+// serveTrieNodes loads trie nodes or codes by hash, entries not found are left empty.
+func serveTrieNodes(db trie.DatabaseReader, hashes []meter.Bytes32) [][]byte {
+	result := make([][]byte, 0, len(hashes))
+	var size metric.StorageSize
+	for _, hash := range hashes {
+		if len(result) >= maxTrieNodes || size >= maxTrieNodesSize {
+			break
+		}
+		data, err := db.Get(hash.Bytes())
+		if err != nil {
+			data = []byte{}
+		}
+		result = append(result, data)
+		size += metric.StorageSize(len(data))
+	}
+	return result
+}
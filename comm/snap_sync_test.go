@@ -0,0 +1,174 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package comm
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/comm/proto"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"github.com/stretchr/testify/assert"
+)
+
+// statePeer serves state requests from db, as a remote peer does.
+type statePeer struct {
+	db     *lvldb.LevelDB
+	limit  uint32
+	tamper func(*proto.TrieRange)
+}
+
+func (p *statePeer) Call(ctx context.Context, msgCode uint64, arg interface{}, result interface{}) error {
+	data, err := rlp.EncodeToBytes(arg)
+	if err != nil {
+		return err
+	}
+	var resp interface{}
+	switch msgCode {
+	case proto.MsgGetTrieRange:
+		var req proto.TrieRangeRequest
+		if err := rlp.DecodeBytes(data, &req); err != nil {
+			return err
+		}
+		req.Limit = p.limit
+		r, err := serveTrieRange(p.db, &req)
+		if err != nil {
+			return err
+		}
+		if p.tamper != nil {
+			p.tamper(r)
+		}
+		resp = r
+	case proto.MsgGetTrieNodes:
+		var hashes []meter.Bytes32
+		if err := rlp.DecodeBytes(data, &hashes); err != nil {
+			return err
+		}
+		resp = serveTrieNodes(p.db, hashes)
+	default:
+		return errors.New("unexpected msg")
+	}
+	if data, err = rlp.EncodeToBytes(resp); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data, result)
+}
+
+func (p *statePeer) Notify(ctx context.Context, msgCode uint64, arg interface{}) error { return nil }
+func (p *statePeer) String() string                                                    { return "statePeer" }
+func (p *statePeer) Info(msg string, ctx ...interface{})                               {}
+func (p *statePeer) Debug(msg string, ctx ...interface{})                              {}
+func (p *statePeer) Warn(msg string, ctx ...interface{})                               {}
+
+func buildState(t *testing.T) (*lvldb.LevelDB, meter.Bytes32, []meter.Address) {
+	db, _ := lvldb.NewMem()
+	st, err := state.New(meter.Bytes32{}, db)
+	assert.Nil(t, err)
+
+	var addrs []meter.Address
+	for i := 0; i < 300; i++ {
+		addr := meter.BytesToAddress([]byte{byte(i >> 8), byte(i), 1})
+		st.SetBalance(addr, big.NewInt(int64(i+1)))
+		st.SetEnergy(addr, big.NewInt(int64(i+1000)))
+		if i%100 == 0 {
+			// contracts sharing the same code
+			st.SetCode(addr, []byte{0x60, 0x00, 0x60, 0x00, 0xf3})
+			for j := 0; j < 50; j++ {
+				st.SetStorage(addr, meter.BytesToBytes32([]byte{byte(j)}), meter.BytesToBytes32([]byte{byte(i), byte(j + 1)}))
+			}
+		}
+		addrs = append(addrs, addr)
+	}
+	root, err := st.Stage().Commit()
+	assert.Nil(t, err)
+	return db, root, addrs
+}
+
+func TestStateSync(t *testing.T) {
+	srcDB, root, addrs := buildState(t)
+
+	tests := []struct {
+		name   string
+		tamper func(*proto.TrieRange)
+	}{
+		{"honest", nil},
+		{"missing leaves", func(r *proto.TrieRange) {
+			if len(r.Keys) > 2 {
+				r.Keys = append(r.Keys[:1], r.Keys[2:]...)
+				r.Values = append(r.Values[:1], r.Values[2:]...)
+			}
+		}},
+		{"wrong values", func(r *proto.TrieRange) {
+			if len(r.Values) > 2 {
+				r.Values[1] = []byte{0x80}
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, _ := lvldb.NewMem()
+			peer := &statePeer{db: srcDB, limit: 64, tamper: tt.tamper}
+			err := newStateSync(context.Background(), peer, db, slog.Default()).run(root)
+			assert.Nil(t, err)
+
+			src, _ := state.New(root, srcDB)
+			st, err := state.New(root, db)
+			assert.Nil(t, err)
+			for _, addr := range addrs {
+				assert.Equal(t, src.GetBalance(addr), st.GetBalance(addr))
+				assert.Equal(t, src.GetEnergy(addr), st.GetEnergy(addr))
+				assert.Equal(t, src.GetCode(addr), st.GetCode(addr))
+				for j := 0; j < 50; j++ {
+					key := meter.BytesToBytes32([]byte{byte(j)})
+					assert.Equal(t, src.GetStorage(addr, key), st.GetStorage(addr, key))
+				}
+			}
+		})
+	}
+
+	// the first leaf of a range is always proven
+	db, _ := lvldb.NewMem()
+	peer := &statePeer{db: srcDB, limit: 64, tamper: func(r *proto.TrieRange) { r.Values[0] = []byte{0x80} }}
+	assert.NotNil(t, newStateSync(context.Background(), peer, db, slog.Default()).run(root))
+}
+
+func TestVerifyPivot(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	build := func(b *block.Builder) *block.Block {
+		blk := b.ParentID(new(block.Builder).Build().ID()).Build()
+		sig, err := crypto.Sign(blk.Header().SigningHash().Bytes(), key)
+		assert.Nil(t, err)
+		return blk.WithSignature(sig)
+	}
+	escort := func(blk *block.Block) *block.EscortedBlock {
+		return &block.EscortedBlock{Block: blk, EscortQC: &block.QuorumCert{QCHeight: blk.Number(), VoterMsgHash: blk.VotingHash()}}
+	}
+	kblk := build(new(block.Builder).BlockType(block.KBlockType).StateRoot(meter.BytesToBytes32([]byte("root"))))
+	mblk := build(new(block.Builder))
+
+	assert.Nil(t, verifyPivot(escort(kblk), kblk.ID()))
+
+	// a forged state root changes the block id
+	forged := build(new(block.Builder).BlockType(block.KBlockType).StateRoot(meter.BytesToBytes32([]byte("forged"))))
+	assert.NotNil(t, verifyPivot(escort(forged), kblk.ID()))
+	assert.NotNil(t, verifyPivot(escort(mblk), mblk.ID()))
+
+	noQC := escort(kblk)
+	noQC.EscortQC = nil
+	assert.NotNil(t, verifyPivot(noQC, kblk.ID()))
+
+	otherQC := escort(kblk)
+	otherQC.EscortQC.VoterMsgHash = mblk.VotingHash()
+	assert.NotNil(t, verifyPivot(otherQC, kblk.ID()))
+}
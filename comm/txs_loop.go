@@ -7,6 +7,7 @@ package comm
 
 import (
 	"github.com/meterio/meter-pov/comm/proto"
+	"github.com/meterio/meter-pov/tx"
 	"github.com/meterio/meter-pov/txpool"
 )
 
@@ -22,21 +23,31 @@ func (c *Communicator) txsLoop() {
 			return
 		case txEv := <-txEvCh:
 			if txEv.Executable != nil && *txEv.Executable {
-				tx := txEv.Tx
-				peers := c.peerSet.Slice().Filter(func(p *Peer) bool {
-					return !p.IsTransactionKnown(tx.ID())
-				})
-
-				for _, peer := range peers {
-					peer := peer
-					peer.MarkTransaction(tx.ID())
-					c.goes.Go(func() {
-						if err := proto.NotifyNewTx(c.ctx, peer, tx); err != nil {
-							peer.logger.Debug("failed to broadcast tx", "err", err)
-						}
-					})
-				}
+				c.broadcastTx(txEv.Tx)
 			}
 		}
 	}
 }
+
+// BroadcastTxs announces txs to peers which don't know them yet.
+func (c *Communicator) BroadcastTxs(txs tx.Transactions) {
+	for _, tx := range txs {
+		c.broadcastTx(tx)
+	}
+}
+
+func (c *Communicator) broadcastTx(tx *tx.Transaction) {
+	peers := c.peerSet.Slice().Filter(func(p *Peer) bool {
+		return !p.IsTransactionKnown(tx.ID())
+	})
+
+	for _, peer := range peers {
+		peer := peer
+		peer.MarkTransaction(tx.ID())
+		c.goes.Go(func() {
+			if err := proto.NotifyNewTx(c.ctx, peer, tx); err != nil {
+				peer.logger.Debug("failed to broadcast tx", "err", err)
+			}
+		})
+	}
+}
@@ -53,7 +53,7 @@ func BuildAuctionControlTx(height, epoch uint64, chainTag byte, bestNum uint32,
 			lastSequence = summaryList.Summaries[size-1].Sequence
 		} else {
 			if meter.IsTesla(uint32(height)) {
-				lastEndHeight = meter.TeslaMainnetStartNum
+				lastEndHeight = uint64(meter.TeslaStartNum())
 				ep, err := chain.FindEpochOnBlock(uint32(lastEndHeight))
 				if err != nil {
 					// something wrong to get this epoch
@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"context"
 	sha256 "crypto/sha256"
 	"fmt"
 	"log/slog"
@@ -29,6 +30,9 @@ type IncomingMsg struct {
 	ExpireAt  time.Time
 
 	ProcessCount uint32
+
+	// trace context of the sender
+	Ctx context.Context
 }
 
 func newIncomingMsg(msg block.ConsensusMessage, peer ConsensusPeer, rawData []byte) *IncomingMsg {
@@ -42,6 +46,7 @@ func newIncomingMsg(msg block.ConsensusMessage, peer ConsensusPeer, rawData []by
 		// ShortHashStr: shortMsgHash,
 
 		ProcessCount: 0,
+		Ctx:          context.Background(),
 	}
 }
 
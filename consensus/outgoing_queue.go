@@ -1,14 +1,12 @@
 package consensus
 
 import (
-	"bytes"
 	"context"
 	sha256 "crypto/sha256"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log/slog"
-	"net/http"
 	"sync"
 	"time"
 
@@ -42,19 +40,19 @@ var (
 
 type OutgoingQueue struct {
 	sync.WaitGroup
-	logger   *slog.Logger
-	queue    chan (OutgoingParcel)
-	clients  map[string]*http.Client
-	outCache *lru.Cache
+	logger    *slog.Logger
+	queue     chan (OutgoingParcel)
+	transport *PacemakerTransport
+	outCache  *lru.Cache
 }
 
-func NewOutgoingQueue() *OutgoingQueue {
+func NewOutgoingQueue(transport *PacemakerTransport) *OutgoingQueue {
 	outCache, _ := lru.New(1024)
 	return &OutgoingQueue{
-		logger:   slog.With("pkg", "out"),
-		queue:    make(chan (OutgoingParcel), 2048),
-		clients:  make(map[string]*http.Client),
-		outCache: outCache,
+		logger:    slog.With("pkg", "out"),
+		queue:     make(chan (OutgoingParcel), 2048),
+		transport: transport,
+		outCache:  outCache,
 	}
 }
 
@@ -78,7 +76,7 @@ func (q *OutgoingQueue) Start(ctx context.Context) {
 	q.logger.Info(`outgoing queue started`)
 
 	for i := 1; i <= WORKER_CONCURRENCY; i++ {
-		worker := NewOutgoingWorker(i, q.outCache)
+		worker := NewOutgoingWorker(i, q.transport, q.outCache)
 		q.WaitGroup.Add(1)
 		go worker.Run(ctx, q.queue, &q.WaitGroup)
 	}
@@ -88,16 +86,16 @@ func (q *OutgoingQueue) Start(ctx context.Context) {
 }
 
 type outgoingWorker struct {
-	logger  *slog.Logger
-	clients map[string]*http.Client
-	cache   *lru.Cache
+	logger    *slog.Logger
+	transport *PacemakerTransport
+	cache     *lru.Cache
 }
 
-func NewOutgoingWorker(num int, cache *lru.Cache) *outgoingWorker {
+func NewOutgoingWorker(num int, transport *PacemakerTransport, cache *lru.Cache) *outgoingWorker {
 	return &outgoingWorker{
-		logger:  slog.With("pkg", fmt.Sprintf("w%d", num)),
-		clients: make(map[string]*http.Client),
-		cache:   cache,
+		logger:    slog.With("pkg", fmt.Sprintf("w%d", num)),
+		transport: transport,
+		cache:     cache,
 	}
 }
 
@@ -118,25 +116,26 @@ func (w *outgoingWorker) Run(ctx context.Context, queue chan OutgoingParcel, wg
 		}
 		outMutex.RUnlock()
 
-		ipAddr := parcel.to.IP
-		if _, known := w.clients[ipAddr]; !known {
-			w.clients[ipAddr] = &http.Client{Timeout: REQ_TIMEOUT}
-		}
-		client := w.clients[ipAddr]
-		url := "http://" + parcel.to.IP + ":8670/pacemaker"
-
 		if parcel.relay {
 			w.logger.Debug(fmt.Sprintf(`relay %s`, parcel.msgType), "to", parcel.to)
 		} else {
 			w.logger.Info(fmt.Sprintf(`send %s`, parcel.msgSummary), "to", parcel.to)
 
 		}
-		res, err := client.Post(url, "application/json", bytes.NewBuffer(parcel.rawMsg))
+		res, err := w.transport.Post(parcel.to.IP, parcel.rawMsg)
+		// the receiver is busy, hold this worker and retry until the parcel expires
+		for err == errTransportBackoff && !parcel.Expired() {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(BACKOFF_INTERVAL):
+			}
+			res, err = w.transport.Post(parcel.to.IP, parcel.rawMsg)
+		}
 
 		// TODO: print response
 		if err != nil {
 			w.logger.Error(fmt.Sprintf("send msg %s failed", parcel.msgType), "to", parcel.to, "err", err)
-			w.clients[ipAddr] = &http.Client{Timeout: REQ_TIMEOUT}
 			continue
 		}
 		outMutex.Lock()
@@ -6,6 +6,7 @@
 package consensus
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -19,7 +20,10 @@ import (
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/packer"
 	"github.com/meterio/meter-pov/powpool"
+	"github.com/meterio/meter-pov/tracing"
 	"github.com/meterio/meter-pov/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 const (
@@ -99,7 +103,7 @@ func NewPacemaker(r *Reactor) *Pacemaker {
 	return p
 }
 
-func (p *Pacemaker) CreateLeaf(parent *block.DraftBlock, justify *block.DraftQC, round uint32) (error, *block.DraftBlock) {
+func (p *Pacemaker) CreateLeaf(ctx context.Context, parent *block.DraftBlock, justify *block.DraftQC, round uint32) (error, *block.DraftBlock) {
 	timeout := p.TCHigh != nil
 	parentBlock := parent.ProposedBlock
 	if parentBlock == nil {
@@ -124,7 +128,7 @@ func (p *Pacemaker) CreateLeaf(parent *block.DraftBlock, justify *block.DraftQC,
 			}
 		}
 		p.logger.Info(fmt.Sprintf("proposing SBlock on R:%v with QCHigh(#%v,R:%v), Parent(%v,R:%v)", round, justify.QC.QCHeight, justify.QC.QCRound, parent.ProposedBlock.ID().ToBlockShortID(), parent.Round))
-		return p.buildStopCommitteeBlock(uint64(targetTime.Unix()), parent, justify, round)
+		return p.buildStopCommitteeBlock(ctx, uint64(targetTime.Unix()), parent, justify, round)
 	}
 
 	proposeKBlock := false
@@ -137,7 +141,7 @@ func (p *Pacemaker) CreateLeaf(parent *block.DraftBlock, justify *block.DraftQC,
 		kblockData := &block.KBlockData{Nonce: uint64(powResults.Nonce), Data: powResults.Raw}
 		rewards := powResults.Rewards
 		p.logger.Info(fmt.Sprintf("proposing KBlock on R:%v with QCHigh(#%v,R:%v), Parent(%v,R:%v)", round, justify.QC.QCHeight, justify.QC.QCRound, parent.ProposedBlock.ID().ToBlockShortID(), parent.Round))
-		return p.buildKBlock(uint64(targetTime.Unix()), parent, justify, round, kblockData, rewards)
+		return p.buildKBlock(ctx, uint64(targetTime.Unix()), parent, justify, round, kblockData, rewards)
 	} else {
 		if !parent.ProposedBlock.IsKBlock() { // only check round if parent is not KBlock
 			if p.reactor.curEpoch != 0 && round != 0 && round <= justify.QC.QCRound {
@@ -150,7 +154,7 @@ func (p *Pacemaker) CreateLeaf(parent *block.DraftBlock, justify *block.DraftQC,
 			}
 		}
 		p.logger.Info(fmt.Sprintf("proposing MBlock on R:%v with QCHigh(#%v,R:%v), Parent(%v,R:%v)", round, justify.QC.QCHeight, justify.QC.QCRound, parent.ProposedBlock.ID().ToBlockShortID(), parent.Round))
-		err, draftBlock := p.buildMBlock(uint64(targetTime.Unix()), parent, justify, round)
+		err, draftBlock := p.buildMBlock(ctx, uint64(targetTime.Unix()), parent, justify, round)
 		if time.Now().Before(targetTime) {
 			d := time.Until(targetTime)
 			p.logger.Info("sleep until", "targetTime", targetTime, "for", meter.PrettyDuration(d))
@@ -217,19 +221,27 @@ func (p *Pacemaker) OnCommit(commitReady []commitReadyBlock) {
 			p.logger.Warn("skip commit empty block")
 			continue
 		}
+		// trace the commit under the span the block was proposed or received in
+		ctx, span := tracing.Start(tracing.ContextWith(blk.SpanContext), "Pacemaker.OnCommit",
+			attribute.Int64("height", int64(blk.Height)),
+			attribute.Int64("round", int64(blk.Round)))
 
 		// TBD: how to handle this case???
 		if !blk.SuccessProcessed {
 			p.logger.Error("process this proposal failed, possible my states are wrong", "height", blk.Height, "round", blk.Round, "action", "commit", "err", blk.ProcessError)
+			span.SetStatus(codes.Error, "proposal not processed")
+			span.End()
 			continue
 		}
 		if blk.ProcessError == errKnownBlock {
 			p.logger.Warn("skip commit known block", "height", blk.Height, "round", blk.Round)
+			span.End()
 			continue
 		}
 		// commit the approved block
-		err := p.commitBlock(blk, escortQC)
+		err := p.commitBlock(ctx, blk, escortQC)
 		if err != nil {
+			span.RecordError(err)
 			if err != chain.ErrBlockExist && err != errKnownBlock {
 				if blk != nil {
 					p.logger.Warn("commit failed !!!", "err", err, "blk", blk.ProposedBlock.CompactString())
@@ -270,6 +282,7 @@ func (p *Pacemaker) OnCommit(commitReady []commitReadyBlock) {
 		// remove this DraftBlock from map.
 		//delete(p.proposalMap, b.Height)
 		p.chain.PruneDraftsUpTo(blk)
+		span.End()
 	}
 }
 
@@ -288,6 +301,12 @@ func (p *Pacemaker) OnReceiveProposal(mi IncomingMsg) {
 	qc := blk.QC
 	p.logger.Debug(fmt.Sprintf("Handling %s", msg.GetType()), "blk", blk.ID().ToBlockShortID())
 
+	ctx, span := tracing.Start(mi.Ctx, "Pacemaker.OnReceiveProposal",
+		attribute.Int64("height", int64(height)),
+		attribute.Int64("round", int64(round)),
+		attribute.Int("txs", len(blk.Txs)))
+	defer span.End()
+
 	// load parent
 	parent := p.chain.GetDraft(blk.ParentID())
 	if parent == nil {
@@ -324,7 +343,7 @@ func (p *Pacemaker) OnReceiveProposal(mi IncomingMsg) {
 						distinctPeers = append(distinctPeers, peer)
 					}
 				}
-				p.reactor.Send(query, distinctPeers...)
+				p.reactor.Send(ctx, query, distinctPeers...)
 				p.logger.Info(`query proposals`, "distinctPeers", len(distinctPeers))
 			}
 
@@ -375,10 +394,15 @@ func (p *Pacemaker) OnReceiveProposal(mi IncomingMsg) {
 		Parent:        parent,
 		Justify:       justify,
 		ProposedBlock: blk,
+		SpanContext:   span.SpanContext(),
 	}
 
 	// validate proposal
-	if err := p.ValidateProposal(bnew); err != nil {
+	_, validateSpan := tracing.Start(ctx, "Pacemaker.ValidateProposal")
+	err := p.ValidateProposal(bnew)
+	validateSpan.End()
+	if err != nil {
+		span.RecordError(err)
 		p.logger.Error("validate proposal failed", "err", err)
 		return
 	}
@@ -396,7 +420,7 @@ func (p *Pacemaker) OnReceiveProposal(mi IncomingMsg) {
 		}
 
 		p.Update(bnew.Justify.QC)
-		p.sendMsg(voteMsg, false)
+		p.sendMsg(ctx, voteMsg, false)
 		p.lastVoteMsg = voteMsg
 		p.lastVotingHeight = block.Number(voteMsg.VoteBlockID)
 
@@ -429,6 +453,12 @@ func (p *Pacemaker) OnReceiveVote(mi IncomingMsg) {
 		return
 	}
 
+	ctx, span := tracing.Start(mi.Ctx, "Pacemaker.OnReceiveVote",
+		attribute.Int64("height", int64(block.Number(msg.VoteBlockID))),
+		attribute.Int64("round", int64(round)),
+		attribute.Int64("signer", int64(msg.GetSignerIndex())))
+	defer span.End()
+
 	b := p.chain.GetDraft(msg.VoteBlockID)
 	if b == nil {
 		p.logger.Warn("can not get proposed block", "blk", msg.VoteBlockID.ToBlockShortID())
@@ -441,7 +471,7 @@ func (p *Pacemaker) OnReceiveVote(mi IncomingMsg) {
 		return
 	}
 
-	qc := p.qcVoteManager.AddVote(msg.GetSignerIndex(), p.reactor.curEpoch, round, msg.VoteBlockID, msg.VoteSignature, msg.VoteHash)
+	qc := p.qcVoteManager.AddVote(ctx, msg.GetSignerIndex(), p.reactor.curEpoch, round, msg.VoteBlockID, msg.VoteSignature, msg.VoteHash)
 	if qc == nil {
 		p.logger.Debug("no qc formed")
 		return
@@ -457,12 +487,21 @@ func (p *Pacemaker) OnReceiveVote(mi IncomingMsg) {
 }
 
 func (p *Pacemaker) OnPropose(qc *block.DraftQC, round uint32) *block.DraftBlock {
+	// a round is traced from the proposal
+	ctx, span := tracing.Start(context.Background(), "Pacemaker.OnPropose",
+		attribute.Int64("epoch", int64(p.reactor.curEpoch)),
+		attribute.Int64("round", int64(round)))
+	defer span.End()
+
 	parent := p.chain.GetDraftByEscortQC(qc.QC)
-	err, bnew := p.CreateLeaf(parent, qc, round)
+	err, bnew := p.CreateLeaf(ctx, parent, qc, round)
 	if err != nil {
+		span.RecordError(err)
 		p.logger.Error("could not create leaf", "err", err)
 		return nil
 	}
+	bnew.SpanContext = span.SpanContext()
+	span.SetAttributes(attribute.Int64("height", int64(bnew.Height)))
 	// proposedBlk := bnew.ProposedBlockInfo.ProposedBlock
 
 	if bnew.Height <= qc.QC.QCHeight {
@@ -558,7 +597,7 @@ func (p *Pacemaker) OnReceiveTimeout(mi IncomingMsg) {
 	}
 
 	// collect vote and see if QC is formed
-	newQC := p.qcVoteManager.AddVote(msg.SignerIndex, p.reactor.curEpoch, msg.LastVoteRound, msg.LastVoteBlockID, msg.LastVoteSignature, msg.LastVoteHash)
+	newQC := p.qcVoteManager.AddVote(mi.Ctx, msg.SignerIndex, p.reactor.curEpoch, msg.LastVoteRound, msg.LastVoteBlockID, msg.LastVoteSignature, msg.LastVoteHash)
 	if newQC != nil {
 		escortQCNode := p.chain.GetDraftByEscortQC(newQC)
 		p.UpdateQCHigh(&block.DraftQC{QCNode: escortQCNode, QC: newQC})
@@ -583,8 +622,9 @@ func (p *Pacemaker) OnReceiveQuery(mi IncomingMsg) {
 	p.logger.Info(`received query`, "lastCommitted", msg.LastCommitted.ToBlockShortID(), "from", mi.Peer)
 	for _, proposal := range proposals {
 		p.logger.Info(`forward proposal`, "id", proposal.ProposedBlock.ID().ToBlockShortID(), "to", mi.Peer)
-		p.sendMsg(proposal.Msg, false)
-		p.reactor.Send(proposal.Msg, &mi.Peer)
+		ctx := tracing.ContextWith(proposal.SpanContext)
+		p.sendMsg(ctx, proposal.Msg, false)
+		p.reactor.Send(ctx, proposal.Msg, &mi.Peer)
 	}
 }
 
@@ -722,7 +762,7 @@ func (p *Pacemaker) OnBroadcastProposal() {
 		return
 	}
 
-	p.sendMsg(proposalMsg, true)
+	p.sendMsg(tracing.ContextWith(p.curProposal.SpanContext), proposalMsg, true)
 }
 
 func (p *Pacemaker) scheduleOnBeat(epoch uint64, round uint32) {
@@ -840,7 +880,7 @@ func (p *Pacemaker) OnRoundTimeout(ti PMRoundTimeoutInfo) {
 	if err != nil {
 		p.logger.Error("could not build timeout message", "err", err)
 	} else {
-		p.sendMsg(msg, false)
+		p.sendMsg(context.Background(), msg, false)
 	}
 }
 
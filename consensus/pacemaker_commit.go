@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,10 +9,11 @@ import (
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/script"
+	"github.com/meterio/meter-pov/tracing"
 )
 
 // finalize the block with its own QC
-func (p *Pacemaker) commitBlock(draftBlk *block.DraftBlock, escortQC *block.QuorumCert) error {
+func (p *Pacemaker) commitBlock(ctx context.Context, draftBlk *block.DraftBlock, escortQC *block.QuorumCert) error {
 	start := time.Now()
 	blk := draftBlk.ProposedBlock
 	//stage := blkInfo.Stage
@@ -22,13 +24,18 @@ func (p *Pacemaker) commitBlock(draftBlk *block.DraftBlock, escortQC *block.Quor
 	batch := p.reactor.logDB.Prepare(blk.Header())
 	for i, tx := range blk.Transactions() {
 		origin, _ := tx.Signer()
+		batch.InsertTx(uint32(i), tx, origin, (*receipts)[i].Reverted)
 		txBatch := batch.ForTransaction(tx.ID(), origin)
 		for _, output := range (*(*receipts)[i]).Outputs {
 			txBatch.Insert(output.Events, output.Transfers)
+			batch.InsertStakingRecords(tx.ID(), origin, output.StakingRecords)
 		}
 	}
 
-	if err := batch.Commit(); err != nil {
+	_, span := tracing.Start(ctx, "logdb.BlockBatch.Commit")
+	err := batch.Commit()
+	span.End()
+	if err != nil {
 		p.logger.Error("commit logs failed ...", "err", err)
 		return err
 	}
@@ -36,7 +43,9 @@ func (p *Pacemaker) commitBlock(draftBlk *block.DraftBlock, escortQC *block.Quor
 	if blk.Number() <= p.reactor.chain.BestBlock().Number() {
 		return errKnownBlock
 	}
+	_, span = tracing.Start(ctx, "chain.AddBlock")
 	fork, err := p.reactor.chain.AddBlock(blk, escortQC, *receipts)
+	span.End()
 	if err != nil {
 		if err != chain.ErrBlockExist {
 			p.logger.Warn("add block failed ...", "err", err, "id", blk.ID(), "num", blk.Number())
@@ -60,10 +69,8 @@ func (p *Pacemaker) commitBlock(draftBlk *block.DraftBlock, escortQC *block.Quor
 
 	p.logger.Info(fmt.Sprintf("* committed %v", blk.ShortID()), "txs", len(blk.Txs), "epoch", blk.GetBlockEpoch(), "elapsed", meter.PrettyDuration(time.Since(start)))
 
-	if meter.IsMainNet() {
-		if blk.Number() == meter.TeslaMainnetStartNum {
-			script.EnterTeslaForkInit()
-		}
+	if meter.IsTeslaForkInit(blk.Number()) {
+		script.EnterTeslaForkInit()
 	}
 
 	// broadcast the new block to all peers
@@ -6,6 +6,7 @@ package consensus
 // 3. collect votes and generate new QC
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -16,7 +17,10 @@ import (
 	"github.com/meterio/meter-pov/packer"
 	"github.com/meterio/meter-pov/powpool"
 	"github.com/meterio/meter-pov/runtime"
+	"github.com/meterio/meter-pov/tracing"
 	"github.com/meterio/meter-pov/tx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -28,6 +32,11 @@ var (
 	ErrInvalidRound         = errors.New("invalid round")
 )
 
+// startFlowSpan starts a span over a packer flow, from mocking to packing the block at height.
+func startFlowSpan(ctx context.Context, height uint32) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "packer.Flow", attribute.Int64("height", int64(height)))
+}
+
 func (p *Pacemaker) packCommitteeInfo(blk *block.Block) {
 	committeeInfo := p.reactor.MakeBlockCommitteeInfo()
 	// fmt.Println("committee info: ", committeeInfo)
@@ -37,7 +46,7 @@ func (p *Pacemaker) packCommitteeInfo(blk *block.Block) {
 }
 
 // Build MBlock
-func (p *Pacemaker) buildMBlock(ts uint64, parent *block.DraftBlock, justify *block.DraftQC, round uint32) (error, *block.DraftBlock) {
+func (p *Pacemaker) buildMBlock(ctx context.Context, ts uint64, parent *block.DraftBlock, justify *block.DraftQC, round uint32) (error, *block.DraftBlock) {
 	parentBlock := parent.ProposedBlock
 	best := parentBlock
 	qc := justify.QC
@@ -66,6 +75,8 @@ func (p *Pacemaker) buildMBlock(ts uint64, parent *block.DraftBlock, justify *bl
 
 	candAddr := p.reactor.committee[p.reactor.committeeIndex].Address
 	gasLimit := pker.GasLimit(best.GasLimit())
+	_, span := startFlowSpan(ctx, block.Number(best.ID())+1)
+	defer span.End()
 	flow, err := pker.Mock(best.Header(), ts, gasLimit, &candAddr)
 	if err != nil {
 		p.logger.Error("mock packer", "error", err)
@@ -128,6 +139,7 @@ func (p *Pacemaker) buildMBlock(ts uint64, parent *block.DraftBlock, justify *bl
 		p.logger.Error("build block failed", "error", err)
 		return err, nil
 	}
+	span.SetAttributes(attribute.Int("txs", len(newBlock.Txs)))
 	newBlock.SetMagic(block.BlockMagicVersion1)
 	newBlock.SetQC(qc)
 
@@ -225,6 +237,8 @@ func (p *Pacemaker) packMBlock() error {
 	if p.curFlow == nil {
 		return ErrFlowEmpty
 	}
+	_, span := startFlowSpan(tracing.ContextWith(p.curProposal.SpanContext), p.curProposal.Height)
+	defer span.End()
 	newBlock, stage, receipts, err := p.curFlow.Pack(&p.reactor.myPrivKey, block.MBlockType, p.reactor.lastKBlockHeight)
 	if err != nil {
 		p.logger.Error("build block failed", "error", err)
@@ -265,7 +279,9 @@ func (p *Pacemaker) packMBlock() error {
 		CheckPoint:       p.curProposal.CheckPoint,
 		SuccessProcessed: true,
 		ProcessError:     nil,
+		SpanContext:      p.curProposal.SpanContext,
 	}
+	span.SetAttributes(attribute.Int("txs", len(newBlock.Txs)))
 
 	msg, err := p.BuildProposalMessage(proposed.Height, proposed.Round, proposed, p.curProposal.Msg.(*block.PMProposalMessage).TimeoutCert)
 	if err != nil {
@@ -280,7 +296,7 @@ func (p *Pacemaker) packMBlock() error {
 	return nil
 }
 
-func (p *Pacemaker) buildKBlock(ts uint64, parent *block.DraftBlock, justify *block.DraftQC, round uint32, kblockData *block.KBlockData, rewards []powpool.PowReward) (error, *block.DraftBlock) {
+func (p *Pacemaker) buildKBlock(ctx context.Context, ts uint64, parent *block.DraftBlock, justify *block.DraftQC, round uint32, kblockData *block.KBlockData, rewards []powpool.PowReward) (error, *block.DraftBlock) {
 	parentBlock := parent.ProposedBlock
 	qc := justify.QC
 	best := parentBlock
@@ -308,6 +324,8 @@ func (p *Pacemaker) buildKBlock(ts uint64, parent *block.DraftBlock, justify *bl
 
 	candAddr := p.reactor.committee[p.reactor.committeeIndex].Address
 	gasLimit := pker.GasLimit(best.GasLimit())
+	_, span := startFlowSpan(ctx, block.Number(best.ID())+1)
+	defer span.End()
 	flow, err := pker.Mock(best.Header(), ts, gasLimit, &candAddr)
 	if err != nil {
 		p.logger.Warn("mock packer", "error", err)
@@ -338,6 +356,7 @@ func (p *Pacemaker) buildKBlock(ts uint64, parent *block.DraftBlock, justify *bl
 		p.logger.Error("build block failed...", "error", err)
 		return err, nil
 	}
+	span.SetAttributes(attribute.Int("txs", len(newBlock.Txs)))
 
 	//serialize KBlockData
 	newBlock.SetKBlockData(*kblockData)
@@ -363,7 +382,7 @@ func (p *Pacemaker) buildKBlock(ts uint64, parent *block.DraftBlock, justify *bl
 	return nil, proposed
 }
 
-func (p *Pacemaker) buildStopCommitteeBlock(ts uint64, parent *block.DraftBlock, justify *block.DraftQC, round uint32) (error, *block.DraftBlock) {
+func (p *Pacemaker) buildStopCommitteeBlock(ctx context.Context, ts uint64, parent *block.DraftBlock, justify *block.DraftQC, round uint32) (error, *block.DraftBlock) {
 	parentBlock := parent.ProposedBlock
 	qc := justify.QC
 	best := parentBlock
@@ -376,6 +395,8 @@ func (p *Pacemaker) buildStopCommitteeBlock(ts uint64, parent *block.DraftBlock,
 
 	candAddr := p.reactor.committee[p.reactor.committeeIndex].Address
 	gasLimit := pker.GasLimit(best.GasLimit())
+	_, span := startFlowSpan(ctx, block.Number(best.ID())+1)
+	defer span.End()
 	flow, err := pker.Mock(best.Header(), ts, gasLimit, &candAddr)
 	if err != nil {
 		p.logger.Error("mock packer", "error", err)
@@ -10,6 +10,7 @@ package consensus
 // 2. send messages to peer
 
 import (
+	"context"
 	sha256 "crypto/sha256"
 	"fmt"
 	"time"
@@ -20,7 +21,7 @@ import (
 	"github.com/meterio/meter-pov/types"
 )
 
-func (p *Pacemaker) sendMsg(msg block.ConsensusMessage, copyMyself bool) bool {
+func (p *Pacemaker) sendMsg(ctx context.Context, msg block.ConsensusMessage, copyMyself bool) bool {
 	myNetAddr := p.reactor.GetMyNetAddr()
 	myName := p.reactor.GetMyName()
 	myself := NewConsensusPeer(myName, myNetAddr.IP.String())
@@ -48,10 +49,10 @@ func (p *Pacemaker) sendMsg(msg block.ConsensusMessage, copyMyself bool) bool {
 	}
 	// send consensus message to myself first (except for PMNewViewMessage)
 	if copyMyself && !myselfInPeers {
-		p.reactor.Send(msg, myself)
+		p.reactor.Send(ctx, msg, myself)
 	}
 
-	p.reactor.Send(msg, peers...)
+	p.reactor.Send(ctx, msg, peers...)
 	return true
 }
 
@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 
@@ -8,6 +9,8 @@ import (
 	bls "github.com/meterio/meter-pov/crypto/multi_sig"
 	cmn "github.com/meterio/meter-pov/libs/common"
 	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type vote struct {
@@ -43,7 +46,7 @@ func (m *QCVoteManager) Size() uint32 {
 	return m.committeeSize
 }
 
-func (m *QCVoteManager) AddVote(index uint32, epoch uint64, round uint32, blockID meter.Bytes32, sig []byte, hash [32]byte) *block.QuorumCert {
+func (m *QCVoteManager) AddVote(ctx context.Context, index uint32, epoch uint64, round uint32, blockID meter.Bytes32, sig []byte, hash [32]byte) *block.QuorumCert {
 	key := voteKey{Round: round, BlockID: blockID}
 	if _, existed := m.votes[key]; !existed {
 		m.votes[key] = make(map[uint32]*vote)
@@ -66,7 +69,7 @@ func (m *QCVoteManager) AddVote(index uint32, epoch uint64, round uint32, blockI
 	voteCount := uint32(len(m.votes[key]))
 	if block.MajorityTwoThird(voteCount, m.committeeSize) {
 		m.seal(round, blockID)
-		qc := m.Aggregate(round, blockID, epoch)
+		qc := m.Aggregate(ctx, round, blockID, epoch)
 		m.logger.Info(
 			fmt.Sprintf("%d/%d voted on %s, R:%d, QC formed.", voteCount, m.committeeSize, blockID.ToBlockShortID(), round))
 		return qc
@@ -86,7 +89,12 @@ func (m *QCVoteManager) seal(round uint32, blockID meter.Bytes32) {
 	m.sealed[key] = true
 }
 
-func (m *QCVoteManager) Aggregate(round uint32, blockID meter.Bytes32, epoch uint64) *block.QuorumCert {
+func (m *QCVoteManager) Aggregate(ctx context.Context, round uint32, blockID meter.Bytes32, epoch uint64) *block.QuorumCert {
+	_, span := tracing.Start(ctx, "QCVoteManager.Aggregate",
+		attribute.Int64("round", int64(round)),
+		attribute.Int64("height", int64(block.Number(blockID))))
+	defer span.End()
+
 	m.seal(round, blockID)
 	sigs := make([]bls.Signature, 0)
 	key := voteKey{Round: round, BlockID: blockID}
@@ -98,8 +106,10 @@ func (m *QCVoteManager) Aggregate(round uint32, blockID meter.Bytes32, epoch uin
 		bitArray.SetIndex(int(index), true)
 		msgHash = v.Hash
 	}
+	span.SetAttributes(attribute.Int("votes", len(sigs)))
 	sigAgg, err := bls.Aggregate(sigs, m.system)
 	if err != nil {
+		span.RecordError(err)
 		return nil
 	}
 	aggSigBytes := m.system.SigToBytes(sigAgg)
@@ -16,6 +16,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log/slog"
 	"net"
@@ -61,6 +62,9 @@ type ReactorConfig struct {
 	MaxCommitteeSize  int
 	MaxDelegateSize   int
 	InitDelegates     []*types.Delegate
+	PacemakerPort     int
+	PacemakerPeerPort int
+	PacemakerLegacy   bool
 }
 
 // -----------------------------------------------------------------------------
@@ -105,9 +109,12 @@ type Reactor struct {
 
 	magic [4]byte
 
-	inQueue  *IncomingQueue
-	outQueue *OutgoingQueue
-	inCache  *lru.Cache
+	inQueue   *IncomingQueue
+	outQueue  *OutgoingQueue
+	inCache   *lru.Cache
+	transport *PacemakerTransport
+
+	knownNodeKeys knownNodeKeys // snapshot of committee and delegate keys for the transport
 }
 
 // NewConsensusReactor returns a new Reactor with config
@@ -133,9 +140,8 @@ func NewConsensusReactor(ctx *cli.Context, chain *chain.Chain, logDB *logdb.LogD
 		inCommittee:  false,
 		knownIPs:     make(map[string]string),
 
-		inQueue:  NewIncomingQueue(),
-		outQueue: NewOutgoingQueue(),
-		inCache:  inCache,
+		inQueue: NewIncomingQueue(),
+		inCache: inCache,
 
 		blsCommon: blsCommon,
 		myPrivKey: *privKey,
@@ -150,9 +156,23 @@ func NewConsensusReactor(ctx *cli.Context, chain *chain.Chain, logDB *logdb.LogD
 			MaxCommitteeSize:  ctx.Int("committee-max-size"),
 			MaxDelegateSize:   ctx.Int("delegate-max-size"),
 			InitDelegates:     initDelegates,
+			PacemakerPort:     ctx.Int("pacemaker-port"),
+			PacemakerPeerPort: ctx.Int("pacemaker-peer-port"),
+			PacemakerLegacy:   ctx.Bool("pacemaker-legacy"),
 		}
 	}
 
+	transport, err := NewPacemakerTransport(privKey, TransportOptions{
+		Port:     r.config.PacemakerPort,
+		PeerPort: r.config.PacemakerPeerPort,
+		Legacy:   r.config.PacemakerLegacy,
+	}, r.isKnownNodeKey)
+	if err != nil {
+		panic(fmt.Sprintf("could not create pacemaker transport: %v", err))
+	}
+	r.transport = transport
+	r.outQueue = NewOutgoingQueue(transport)
+
 	// initialize consensus common
 	r.logger.Info("my keys", "pubkey", b64.RawStdEncoding.EncodeToString(crypto.FromECDSAPub(pubKey)), "privkey", b64.RawStdEncoding.EncodeToString(crypto.FromECDSA(privKey)))
 
@@ -538,6 +558,7 @@ func (r *Reactor) UpdateCurEpoch() (bool, error) {
 		}
 		r.curDelegates, r.committee, r.committeeIndex, r.inCommittee = r.calcCommitteeByNonce("current", delegates, nonce)
 		r.committeeSize = uint32(len(r.committee))
+		r.knownNodeKeys.update(r.committee, r.lastCommittee, r.curDelegates)
 		if r.delegateSource == fromStaking {
 			r.hardCommittee = r.committee
 		} else {
@@ -602,12 +623,55 @@ func PrintDelegates(delegates []*types.Delegate) {
 func (r *Reactor) OnReceiveMsg(w http.ResponseWriter, req *http.Request) {
 	defer req.Body.Close()
 
+	// reject unverified senders before the body is read
+	status, release, err := r.transport.Accept(req)
+	if err != nil {
+		r.logger.Warn("rejected pacemaker msg", "from", req.RemoteAddr, "err", err)
+		w.WriteHeader(status)
+		return
+	}
+	defer release()
+	r.receiveMsg(req)
+}
+
+// OnReceiveLegacyMsg handles msgs posted in plaintext by delegates not serving the TLS transport yet.
+// Msgs are refused unless the legacy option is on, and only taken from IPs of the current committee.
+func (r *Reactor) OnReceiveLegacyMsg(w http.ResponseWriter, req *http.Request) {
+	defer req.Body.Close()
+	if !r.transport.Legacy() {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	if !r.inCommitteeIP(req.RemoteAddr) {
+		r.logger.Warn("rejected legacy pacemaker msg", "from", req.RemoteAddr)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	r.receiveMsg(req)
+}
+
+// inCommitteeIP returns whether the host of addr is the IP of a member of the current committee.
+func (r *Reactor) inCommitteeIP(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	for _, v := range r.committee {
+		if ip != nil && ip.Equal(v.NetAddr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reactor) receiveMsg(req *http.Request) {
 	var m runtime.MemStats
 	runtime.ReadMemStats(&m)
 
 	r.logger.Debug("before receive", "alloc", meter.PrettyStorage(m.Alloc), "sys", meter.PrettyStorage(m.Sys))
 
-	data, err := ioutil.ReadAll(req.Body)
+	data, err := ioutil.ReadAll(io.LimitReader(req.Body, MAX_MSG_SIZE))
 	if err != nil {
 		r.logger.Error("Unrecognized payload", "err", err)
 		return
@@ -2,16 +2,19 @@ package consensus
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net"
 
 	"github.com/meterio/meter-pov/block"
+	"github.com/meterio/meter-pov/tracing"
 )
 
 type PMParcel struct {
-	Raw   []byte `json:"raw"`
-	IP    string `json:"ip"`
-	Magic []byte `json:"magic"`
+	Raw   []byte            `json:"raw"`
+	IP    string            `json:"ip"`
+	Magic []byte            `json:"magic"`
+	Trace map[string]string `json:"trace,omitempty"` // trace context of the sender, omitted if not traced
 }
 
 func (r *Reactor) UnmarshalMsg(rawData []byte) (*IncomingMsg, error) {
@@ -35,10 +38,12 @@ func (r *Reactor) UnmarshalMsg(rawData []byte) (*IncomingMsg, error) {
 	}
 
 	msgInfo := newIncomingMsg(msg, *peer, rawData)
+	msgInfo.Ctx = tracing.Extract(parcel.Trace)
 	return msgInfo, nil
 }
 
-func (r *Reactor) MarshalMsg(msg block.ConsensusMessage) ([]byte, error) {
+// MarshalMsg wraps msg into a parcel, along with the trace context of ctx.
+func (r *Reactor) MarshalMsg(ctx context.Context, msg block.ConsensusMessage) ([]byte, error) {
 	raw, err := block.EncodeMsg(msg)
 	if err != nil {
 		return make([]byte, 0), err
@@ -49,6 +54,7 @@ func (r *Reactor) MarshalMsg(msg block.ConsensusMessage) ([]byte, error) {
 		Raw:   raw,
 		IP:    myNetAddr.IP.String(),
 		Magic: r.magic[:],
+		Trace: tracing.Inject(ctx),
 	}
 
 	return json.Marshal(parcel)
@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"context"
 	"fmt"
 	"math"
 
@@ -95,8 +96,8 @@ func (r *Reactor) Relay(msg block.ConsensusMessage, rawMsg []byte) {
 
 }
 
-func (r *Reactor) Send(msg block.ConsensusMessage, peers ...*ConsensusPeer) {
-	rawMsg, err := r.MarshalMsg(msg)
+func (r *Reactor) Send(ctx context.Context, msg block.ConsensusMessage, peers ...*ConsensusPeer) {
+	rawMsg, err := r.MarshalMsg(ctx, msg)
 	if err != nil {
 		r.logger.Warn("could not marshal msg", "err", err)
 		return
@@ -0,0 +1,279 @@
+package consensus
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	sha256 "crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/meterio/meter-pov/types"
+)
+
+const (
+	DEFAULT_PACEMAKER_PORT = 8671
+	// port of the plaintext pacemaker handler served before the TLS transport
+	LEGACY_PACEMAKER_PORT = 8670
+	// max concurrent incoming msgs, beyond which senders are asked to back off
+	IN_FLIGHT_LIMIT = 64
+	// retry interval when the receiver asks to back off
+	BACKOFF_INTERVAL = time.Millisecond * 200
+	MAX_MSG_SIZE     = 8 * 1024 * 1024
+)
+
+// oidNodeSignature identifies the cert extension carrying the node key signature over the cert public key
+var oidNodeSignature = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57000, 1, 1}
+
+var (
+	errNoPeerCert       = errors.New("no peer certificate")
+	errNoNodeSignature  = errors.New("no node signature in certificate")
+	errUnknownNodeKey   = errors.New("node key is not a known delegate")
+	errTransportBackoff = errors.New("receiver asked to back off")
+)
+
+// TransportOptions options of the pacemaker transport.
+type TransportOptions struct {
+	Port     int  // port to listen on
+	PeerPort int  // port of the transport on other delegates
+	Legacy   bool // fall back to the plaintext handler of delegates not serving the transport yet
+}
+
+// PacemakerTransport carries pacemaker msgs between delegates over mutual TLS.
+// Each node presents a self-signed certificate whose key is endorsed by its node (ECDSA) key,
+// and only accepts connections from nodes with known delegate keys.
+type PacemakerTransport struct {
+	options      TransportOptions
+	cert         tls.Certificate
+	client       *http.Client
+	legacyClient *http.Client
+	inFlight     chan struct{}
+	isKnown      func(pubKey *ecdsa.PublicKey) bool
+}
+
+func NewPacemakerTransport(privKey *ecdsa.PrivateKey, options TransportOptions, isKnown func(pubKey *ecdsa.PublicKey) bool) (*PacemakerTransport, error) {
+	if options.Port <= 0 {
+		options.Port = DEFAULT_PACEMAKER_PORT
+	}
+	if options.PeerPort <= 0 {
+		options.PeerPort = DEFAULT_PACEMAKER_PORT
+	}
+	cert, err := newNodeCert(privKey)
+	if err != nil {
+		return nil, err
+	}
+	t := &PacemakerTransport{
+		options:      options,
+		cert:         cert,
+		legacyClient: &http.Client{Timeout: REQ_TIMEOUT},
+		inFlight:     make(chan struct{}, IN_FLIGHT_LIMIT),
+		isKnown:      isKnown,
+	}
+	t.client = &http.Client{
+		Timeout: REQ_TIMEOUT,
+		Transport: &http.Transport{
+			TLSClientConfig:     t.tlsConfig(),
+			ForceAttemptHTTP2:   true,
+			MaxIdleConnsPerHost: WORKER_CONCURRENCY,
+			IdleConnTimeout:     120 * time.Second,
+			TLSHandshakeTimeout: REQ_TIMEOUT,
+			DialContext:         (&net.Dialer{Timeout: REQ_TIMEOUT, KeepAlive: 30 * time.Second}).DialContext,
+		},
+	}
+	return t, nil
+}
+
+func (t *PacemakerTransport) Port() int {
+	return t.options.Port
+}
+
+// Legacy returns whether the plaintext pacemaker handler is still served and used as fallback.
+func (t *PacemakerTransport) Legacy() bool {
+	return t.options.Legacy
+}
+
+// TLSConfig returns the config for the pacemaker server, which requires client certificates.
+func (t *PacemakerTransport) TLSConfig() *tls.Config {
+	cfg := t.tlsConfig()
+	cfg.ClientAuth = tls.RequireAnyClientCert
+	return cfg
+}
+
+func (t *PacemakerTransport) tlsConfig() *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{t.cert},
+		MinVersion:   tls.VersionTLS13,
+		// certs are self-signed, chains are replaced by the node key check below
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errNoPeerCert
+			}
+			cert, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return err
+			}
+			_, err = t.verifyCert(cert)
+			return err
+		},
+	}
+}
+
+func (t *PacemakerTransport) url(ip string) string {
+	return fmt.Sprintf("https://%s/pacemaker", net.JoinHostPort(ip, fmt.Sprint(t.options.PeerPort)))
+}
+
+func legacyURL(ip string) string {
+	return fmt.Sprintf("http://%s/pacemaker", net.JoinHostPort(ip, fmt.Sprint(LEGACY_PACEMAKER_PORT)))
+}
+
+// Post sends a msg to peer on a persistent connection.
+// With the legacy option, peers refusing the connection are assumed not upgraded and get the msg in plaintext.
+func (t *PacemakerTransport) Post(ip string, rawMsg []byte) (*http.Response, error) {
+	res, err := t.client.Post(t.url(ip), "application/json", bytes.NewReader(rawMsg))
+	if err != nil && t.options.Legacy && errors.Is(err, syscall.ECONNREFUSED) {
+		res, err = t.legacyClient.Post(legacyURL(ip), "application/json", bytes.NewReader(rawMsg))
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res, nil
+	case http.StatusServiceUnavailable:
+		res.Body.Close()
+		return nil, errTransportBackoff
+	default:
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s", res.Status)
+	}
+}
+
+// Accept checks the sender of an incoming request before its body is read,
+// it returns the http status to respond with and a release func if accepted.
+func (t *PacemakerTransport) Accept(req *http.Request) (int, func(), error) {
+	if req.TLS == nil || len(req.TLS.PeerCertificates) == 0 {
+		return http.StatusForbidden, nil, errNoPeerCert
+	}
+	// delegates may change during the lifetime of a connection, check again per request
+	if _, err := t.verifyCert(req.TLS.PeerCertificates[0]); err != nil {
+		return http.StatusForbidden, nil, err
+	}
+	select {
+	case t.inFlight <- struct{}{}:
+		return http.StatusOK, func() { <-t.inFlight }, nil
+	default:
+		return http.StatusServiceUnavailable, nil, errTransportBackoff
+	}
+}
+
+// verifyCert recovers the node key endorsing the cert key and checks it's a known delegate.
+func (t *PacemakerTransport) verifyCert(cert *x509.Certificate) (*ecdsa.PublicKey, error) {
+	pubKey, err := recoverNodeKey(cert)
+	if err != nil {
+		return nil, err
+	}
+	if !t.isKnown(pubKey) {
+		return nil, errUnknownNodeKey
+	}
+	return pubKey, nil
+}
+
+// newNodeCert creates a self-signed P-256 certificate, the node key (secp256k1, not supported by x509)
+// signs the certificate public key and the signature is embedded as an extension.
+func newNodeCert(privKey *ecdsa.PrivateKey) (tls.Certificate, error) {
+	certKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	spki, err := x509.MarshalPKIXPublicKey(&certKey.PublicKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	hash := sha256.Sum256(spki)
+	sig, err := crypto.Sign(hash[:], privKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:    serial,
+		Subject:         pkix.Name{CommonName: "meter-pacemaker"},
+		NotBefore:       time.Now().Add(-time.Hour),
+		NotAfter:        time.Now().Add(10 * 365 * 24 * time.Hour),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		ExtraExtensions: []pkix.Extension{{Id: oidNodeSignature, Value: sig}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &certKey.PublicKey, certKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: certKey}, nil
+}
+
+func recoverNodeKey(cert *x509.Certificate) (*ecdsa.PublicKey, error) {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidNodeSignature) {
+			hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+			return crypto.SigToPub(hash[:], ext.Value)
+		}
+	}
+	return nil, errNoNodeSignature
+}
+
+// knownNodeKeys holds the keys of the current and last committee and the current delegates,
+// it's replaced as a whole when entering an epoch.
+type knownNodeKeys struct {
+	sync.RWMutex
+	keys map[string]bool
+}
+
+func (k *knownNodeKeys) update(committee, lastCommittee []*types.Validator, delegates []*types.Delegate) {
+	keys := make(map[string]bool)
+	for _, v := range committee {
+		keys[string(crypto.FromECDSAPub(&v.PubKey))] = true
+	}
+	for _, v := range lastCommittee {
+		keys[string(crypto.FromECDSAPub(&v.PubKey))] = true
+	}
+	for _, d := range delegates {
+		keys[string(crypto.FromECDSAPub(&d.PubKey))] = true
+	}
+	k.Lock()
+	defer k.Unlock()
+	k.keys = keys
+}
+
+func (k *knownNodeKeys) contains(pubKey *ecdsa.PublicKey) bool {
+	k.RLock()
+	defer k.RUnlock()
+	return k.keys[string(crypto.FromECDSAPub(pubKey))]
+}
+
+// isKnownNodeKey returns whether the key belongs to myself, the current or last committee, or the current delegates.
+func (r *Reactor) isKnownNodeKey(pubKey *ecdsa.PublicKey) bool {
+	if r.myPubKey.Equal(pubKey) {
+		return true
+	}
+	return r.knownNodeKeys.contains(pubKey)
+}
+
+func (r *Reactor) Transport() *PacemakerTransport {
+	return r.transport
+}
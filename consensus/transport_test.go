@@ -0,0 +1,127 @@
+package consensus
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/meterio/meter-pov/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeCert(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	cert, err := newNodeCert(key)
+	assert.Nil(t, err)
+
+	parsed, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.Nil(t, err)
+	pub, err := recoverNodeKey(parsed)
+	assert.Nil(t, err)
+	assert.True(t, key.PublicKey.Equal(pub))
+}
+
+func TestPacemakerTransport(t *testing.T) {
+	serverKey, _ := crypto.GenerateKey()
+	knownKey, _ := crypto.GenerateKey()
+	unknownKey, _ := crypto.GenerateKey()
+
+	isKnown := func(pub *ecdsa.PublicKey) bool {
+		return pub.Equal(&serverKey.PublicKey) || pub.Equal(&knownKey.PublicKey)
+	}
+	server, err := NewPacemakerTransport(serverKey, TransportOptions{}, isKnown)
+	assert.Nil(t, err)
+	assert.Equal(t, DEFAULT_PACEMAKER_PORT, server.Port())
+
+	var received string
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, release, err := server.Accept(req)
+		if err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		defer release()
+		data, _ := io.ReadAll(req.Body)
+		received = string(data)
+	}))
+	ts.TLS = server.TLSConfig()
+	ts.StartTLS()
+	defer ts.Close()
+
+	host := strings.TrimPrefix(ts.URL, "https://")
+	ip := host[:strings.LastIndex(host, ":")]
+	port, _ := strconv.Atoi(host[strings.LastIndex(host, ":")+1:])
+
+	// peers are dialed on the peer port, not the port listened on
+	known, err := NewPacemakerTransport(knownKey, TransportOptions{PeerPort: port}, isKnown)
+	assert.Nil(t, err)
+	res, err := known.Post(ip, []byte("hello"))
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, "hello", received)
+
+	// the server is not known by this client
+	picky, err := NewPacemakerTransport(knownKey, TransportOptions{PeerPort: port}, func(*ecdsa.PublicKey) bool { return false })
+	assert.Nil(t, err)
+	_, err = picky.Post(ip, []byte("picky"))
+	assert.NotNil(t, err)
+
+	// the client is rejected during handshake
+	unknown, err := NewPacemakerTransport(unknownKey, TransportOptions{PeerPort: port}, isKnown)
+	assert.Nil(t, err)
+	_, err = unknown.Post(ip, []byte("unknown"))
+	assert.NotNil(t, err)
+	assert.Equal(t, "hello", received)
+}
+
+func TestKnownNodeKeys(t *testing.T) {
+	member, _ := crypto.GenerateKey()
+	delegate, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+
+	var keys knownNodeKeys
+	assert.False(t, keys.contains(&member.PublicKey))
+
+	keys.update([]*types.Validator{{PubKey: member.PublicKey}}, nil, []*types.Delegate{{PubKey: delegate.PublicKey}})
+	assert.True(t, keys.contains(&member.PublicKey))
+	assert.True(t, keys.contains(&delegate.PublicKey))
+	assert.False(t, keys.contains(&other.PublicKey))
+
+	// keys of the former epoch are dropped
+	keys.update(nil, nil, []*types.Delegate{{PubKey: other.PublicKey}})
+	assert.False(t, keys.contains(&member.PublicKey))
+	assert.True(t, keys.contains(&other.PublicKey))
+}
+
+func TestLegacyMsgRejected(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	post := func(r *Reactor, remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodPost, "/pacemaker", strings.NewReader("{}"))
+		req.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		r.OnReceiveLegacyMsg(w, req)
+		return w.Code
+	}
+	member := &types.Validator{NetAddr: types.NetAddress{IP: net.ParseIP("10.0.0.1")}}
+
+	// unauthenticated msgs are refused with legacy off, even from committee IPs
+	off, err := NewPacemakerTransport(key, TransportOptions{}, func(*ecdsa.PublicKey) bool { return true })
+	assert.Nil(t, err)
+	r := &Reactor{transport: off, committee: []*types.Validator{member}, logger: slog.Default()}
+	assert.Equal(t, http.StatusForbidden, post(r, "10.0.0.1:8670"))
+
+	// with legacy on, only the committee is heard
+	on, err := NewPacemakerTransport(key, TransportOptions{Legacy: true}, func(*ecdsa.PublicKey) bool { return true })
+	assert.Nil(t, err)
+	r.transport = on
+	assert.Equal(t, http.StatusForbidden, post(r, "10.0.0.2:8670"))
+	assert.True(t, r.inCommitteeIP("10.0.0.1:8670"))
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/meterio/meter-pov/builtin"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/meterio/meter-pov/types"
+	"github.com/meterio/meter-pov/vm"
+	"github.com/pkg/errors"
+)
+
+// NetworkSpec defines a custom network, it's loaded from a network file.
+type NetworkSpec struct {
+	Name      string               `json:"name"`
+	ChainID   uint64               `json:"chainId"`
+	GasLimit  uint64               `json:"gasLimit"`
+	Timestamp uint64               `json:"timestamp"`
+	ExtraData string               `json:"extraData"`
+	Executor  *meter.Address       `json:"executor"` // builtin executor is used if not set
+	Alloc     []*Alloc             `json:"alloc"`
+	Delegates []*types.DelegateDef `json:"delegates"`
+	Forks     meter.ForkHeights    `json:"forks"`
+}
+
+// Alloc is an account allocated in genesis.
+type Alloc struct {
+	Address meter.Address            `json:"address"`
+	Balance *math.HexOrDecimal256    `json:"balance"` // MTRG
+	Energy  *math.HexOrDecimal256    `json:"energy"`  // MTR
+	Code    hexutil.Bytes            `json:"code"`
+	Storage map[string]meter.Bytes32 `json:"storage"` // keyed by hex bytes32
+}
+
+// IsNetworkFile returns whether the network flag refers to a network file.
+func IsNetworkFile(network string) bool {
+	return strings.HasSuffix(network, ".json")
+}
+
+// LoadNetworkFile loads and validates the network spec in file.
+func LoadNetworkFile(path string) (*NetworkSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var spec NetworkSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, errors.WithMessage(err, "decode network file")
+	}
+	if err := requireForks(data); err != nil {
+		return nil, errors.WithMessage(err, "invalid network file")
+	}
+	if err := spec.validate(); err != nil {
+		return nil, errors.WithMessage(err, "invalid network file")
+	}
+	return &spec, nil
+}
+
+func (s *NetworkSpec) validate() error {
+	if s.Name == "" {
+		s.Name = "custom"
+	}
+	// the name becomes the chain flag, which must not turn on code paths of the builtin networks
+	switch s.Name {
+	case "main", "staging", "test", "warringstakes":
+		return fmt.Errorf("name %v is reserved", s.Name)
+	}
+	if s.ChainID == 0 {
+		return errors.New("chainId is required")
+	}
+	if s.ChainID == meter.MainnetChainID || s.ChainID == meter.TestnetChainID {
+		return fmt.Errorf("chainId %v is reserved", s.ChainID)
+	}
+	if s.GasLimit == 0 {
+		s.GasLimit = meter.InitialGasLimit
+	}
+	if len(s.ExtraData) > 28 {
+		return errors.New("extraData exceeds 28 bytes")
+	}
+	for _, a := range s.Alloc {
+		for k := range a.Storage {
+			if _, err := meter.ParseBytes32(k); err != nil {
+				return fmt.Errorf("storage key %v of %v: %v", k, a.Address, err)
+			}
+		}
+	}
+	if len(s.Delegates) == 0 {
+		return errors.New("no initial delegates")
+	}
+
+	// forks build on top of the previous ones
+	f := s.Forks
+	heights := []uint32{f.Tesla, f.TeslaFork1, f.TeslaFork2, f.TeslaFork3, f.TeslaFork4, f.TeslaFork5,
+		f.TeslaFork6, f.TeslaFork7, f.TeslaFork8, f.TeslaFork9, f.TeslaFork10, f.TeslaFork11, f.TeslaFork12, f.TeslaFork13}
+	for i := 1; i < len(heights); i++ {
+		if heights[i] < heights[i-1] {
+			return fmt.Errorf("teslaFork%v activates before the previous fork", i)
+		}
+	}
+	return nil
+}
+
+// explicitForks are forks that change storage layout or execution rules of existing networks,
+// their heights must be set in the network file rather than default to genesis.
+var explicitForks = []string{"teslaFork12", "teslaFork13"}
+
+func requireForks(data []byte) error {
+	var raw struct {
+		Forks map[string]json.RawMessage `json:"forks"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for _, name := range explicitForks {
+		if _, ok := raw.Forks[name]; !ok {
+			return fmt.Errorf("%v height is required", name)
+		}
+	}
+	return nil
+}
+
+// NewCustomNetwork create genesis for custom network.
+// Chain config should be initialized with the spec before, as genesis is built by the runtime.
+func NewCustomNetwork(spec *NetworkSpec) (*Genesis, error) {
+	executor := builtin.Executor.Address
+	if spec.Executor != nil {
+		executor = *spec.Executor
+	}
+
+	builder := new(Builder).
+		Timestamp(spec.Timestamp).
+		GasLimit(spec.GasLimit).
+		State(func(state *state.State) error {
+			// alloc precompiled contracts
+			for addr := range vm.PrecompiledContractsByzantium {
+				state.SetCode(meter.Address(addr), emptyRuntimeBytecode)
+			}
+
+			// alloc builtin contracts
+			state.SetCode(builtin.Meter.Address, builtin.Meter.RuntimeBytecodes())
+			state.SetCode(builtin.MeterGov.Address, builtin.MeterGov.RuntimeBytecodes())
+			state.SetCode(builtin.MeterTracker.Address, builtin.MeterTracker.RuntimeBytecodes())
+			state.SetCode(builtin.Executor.Address, builtin.Executor.RuntimeBytecodes())
+			state.SetCode(builtin.Extension.Address, builtin.Extension.RuntimeBytecodes())
+			state.SetCode(builtin.Params.Address, builtin.Params.RuntimeBytecodes())
+			state.SetCode(builtin.Prototype.Address, builtin.Prototype.RuntimeBytecodes())
+
+			tokenSupply := &big.Int{}
+			energySupply := &big.Int{}
+			for _, a := range spec.Alloc {
+				if a.Balance != nil {
+					bal := (*big.Int)(a.Balance)
+					state.SetBalance(a.Address, bal)
+					tokenSupply.Add(tokenSupply, bal)
+				}
+				if a.Energy != nil {
+					energy := (*big.Int)(a.Energy)
+					state.SetEnergy(a.Address, energy)
+					energySupply.Add(energySupply, energy)
+				}
+				if len(a.Code) > 0 {
+					state.SetCode(a.Address, a.Code)
+				}
+				for k, v := range a.Storage {
+					state.SetStorage(a.Address, meter.MustParseBytes32(k), v)
+				}
+			}
+			builtin.MeterTracker.Native(state).SetInitialSupply(tokenSupply, energySupply)
+			return nil
+		})
+
+	// initialize params
+	data := mustEncodeInput(builtin.Params.ABI, "set", meter.KeyExecutorAddress, new(big.Int).SetBytes(executor[:]))
+	builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), meter.Address{})
+
+	params := []struct {
+		key   meter.Bytes32
+		value *big.Int
+	}{
+		{meter.KeyBaseGasPrice, meter.InitialBaseGasPrice},
+		{meter.KeyProposerEndorsement, meter.InitialProposerEndorsement},
+		{meter.KeyPowPoolCoef, meter.InitialPowPoolCoef},
+		{meter.KeyPowPoolCoefFadeDays, meter.InitialPowPoolCoefFadeDays},
+		{meter.KeyPowPoolCoefFadeRate, meter.InitialPowPoolCoefFadeRate},
+		{meter.KeyValidatorBenefitRatio, meter.InitialValidatorBenefitRatio},
+		{meter.KeyValidatorBaseReward, meter.InitialValidatorBaseReward},
+		{meter.KeyAuctionReservedPrice, meter.InitialAuctionReservedPrice},
+		{meter.KeyMinRequiredByDelegate, meter.InitialMinRequiredByDelegate},
+		{meter.KeyAuctionInitRelease, meter.InitialAuctionInitRelease},
+		{meter.KeyBorrowInterestRate, meter.InitialBorrowInterestRate},
+		{meter.KeyConsensusCommitteeSize, meter.InitialConsensusCommitteeSize},
+		{meter.KeyConsensusDelegateSize, meter.InitialConsensusDelegateSize},
+	}
+	for _, p := range params {
+		data = mustEncodeInput(builtin.Params.ABI, "set", p.key, p.value)
+		builder.Call(tx.NewClause(&builtin.Params.Address).WithData(data), executor)
+	}
+
+	var extra [28]byte
+	copy(extra[:], spec.ExtraData)
+	builder.ExtraData(extra)
+	id, err := builder.ComputeID()
+	if err != nil {
+		return nil, err
+	}
+	return &Genesis{builder, id, spec.Name}, nil
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package genesis_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"github.com/stretchr/testify/assert"
+)
+
+const networkFile = `{
+	"name": "mynet",
+	"chainId": 1001,
+	"timestamp": 1700000000,
+	"extraData": "my private net",
+	"alloc": [
+		{
+			"address": "0x7567d83b7b8d80addcb281a71d54fc7b3364ffed",
+			"balance": "1000000000000000000000",
+			"energy": "0x10"
+		},
+		{
+			"address": "0xd3ae78222beadb038203be21ed5ce7c9b1bff602",
+			"code": "0x6060604052600256",
+			"storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": "0x00000000000000000000000000000000000000000000000000000000000000ff"
+			}
+		}
+	],
+	"delegates": [
+		{"name": "node1", "pub_key": "pub", "voting_power": 100, "network_addr": {"ip": "127.0.0.1", "port": 8670}}
+	],
+	"forks": {
+		"tesla": 10,
+		"teslaFork1": 10,
+		"teslaFork2": 20,
+		"teslaFork3": 20,
+		"teslaFork4": 20,
+		"teslaFork5": 20,
+		"teslaFork6": 20,
+		"teslaFork7": 20,
+		"teslaFork8": 20,
+		"teslaFork9": 30,
+		"teslaFork10": 30,
+		"teslaFork11": 40,
+		"teslaFork12": 50,
+		"teslaFork13": 50
+	}
+}`
+
+func writeNetworkFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "mynet.json")
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestCustomNetworkGenesis(t *testing.T) {
+	defer meter.InitBlockChainConfig("main")
+
+	spec, err := genesis.LoadNetworkFile(writeNetworkFile(t, networkFile))
+	assert.Nil(t, err)
+	assert.Equal(t, "mynet", spec.Name)
+	assert.Equal(t, meter.InitialGasLimit, spec.GasLimit, "gas limit defaults to initial")
+
+	meter.InitCustomBlockChainConfig(spec.Name, spec.ChainID, spec.Forks)
+	assert.Equal(t, uint64(1001), meter.ChainID())
+	assert.True(t, meter.IsEdison(9))
+	assert.False(t, meter.IsTesla(9))
+	assert.True(t, meter.IsTesla(10))
+	assert.True(t, meter.IsTeslaFork1(10))
+	assert.False(t, meter.IsTeslaFork9(29))
+	assert.True(t, meter.IsTeslaFork9(30))
+	assert.False(t, meter.IsTeslaFork12(49))
+	assert.True(t, meter.IsTeslaFork12(50))
+	assert.True(t, meter.IsTeslaFork13(50))
+	assert.True(t, meter.IsTeslaForkInit(9))
+	assert.Equal(t, uint32(10), meter.TeslaStartNum())
+
+	gene, err := genesis.NewCustomNetwork(spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "mynet", gene.Name())
+
+	kv, _ := lvldb.NewMem()
+	b0, _, err := gene.Build(state.NewCreator(kv))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1700000000), b0.Header().Timestamp())
+
+	st, err := state.New(b0.Header().StateRoot(), kv)
+	assert.Nil(t, err)
+	acc1 := meter.MustParseAddress("0x7567d83b7b8d80addcb281a71d54fc7b3364ffed")
+	bal, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	assert.Equal(t, bal, st.GetBalance(acc1))
+	assert.Equal(t, big.NewInt(16), st.GetEnergy(acc1))
+
+	acc2 := meter.MustParseAddress("0xd3ae78222beadb038203be21ed5ce7c9b1bff602")
+	assert.Equal(t, []byte{0x60, 0x60, 0x60, 0x40, 0x52, 0x60, 0x02, 0x56}, st.GetCode(acc2))
+	assert.Equal(t, meter.BytesToBytes32([]byte{0xff}), st.GetStorage(acc2, meter.BytesToBytes32([]byte{1})))
+
+	// same spec, same genesis
+	again, err := genesis.NewCustomNetwork(spec)
+	assert.Nil(t, err)
+	assert.Equal(t, gene.ID(), again.ID())
+}
+
+func TestInvalidNetworkFile(t *testing.T) {
+	cases := map[string]string{
+		"no chain id":      `{"delegates": [{"name": "node1"}], "forks": {"teslaFork12": 0, "teslaFork13": 0}}`,
+		"reserved id":      `{"chainId": 82, "delegates": [{"name": "node1"}], "forks": {"teslaFork12": 0, "teslaFork13": 0}}`,
+		"reserved name":    `{"name": "main", "chainId": 1001, "delegates": [{"name": "node1"}], "forks": {"teslaFork12": 0, "teslaFork13": 0}}`,
+		"no delegates":     `{"chainId": 1001, "forks": {"teslaFork12": 0, "teslaFork13": 0}}`,
+		"forks disordered": `{"chainId": 1001, "delegates": [{"name": "node1"}], "forks": {"teslaFork2": 20, "teslaFork3": 10, "teslaFork12": 20, "teslaFork13": 20}}`,
+		"no fork12":        `{"chainId": 1001, "delegates": [{"name": "node1"}], "forks": {"teslaFork13": 0}}`,
+		"no fork13":        `{"chainId": 1001, "delegates": [{"name": "node1"}], "forks": {"teslaFork12": 0}}`,
+		"bad storage key":  `{"chainId": 1001, "delegates": [{"name": "node1"}], "forks": {"teslaFork12": 0, "teslaFork13": 0}, "alloc": [{"address": "0x7567d83b7b8d80addcb281a71d54fc7b3364ffed", "storage": {"0x01": "0x00000000000000000000000000000000000000000000000000000000000000ff"}}]}`,
+	}
+	_, err := genesis.LoadNetworkFile(writeNetworkFile(t, `{"chainId": 1001, "delegates": [{"name": "node1"}], "forks": {"teslaFork12": 0, "teslaFork13": 0}}`))
+	assert.Nil(t, err)
+	for name, content := range cases {
+		_, err := genesis.LoadNetworkFile(writeNetworkFile(t, content))
+		assert.NotNil(t, err, name)
+	}
+}
@@ -5,6 +5,14 @@
 
 package kv
 
+import "github.com/pkg/errors"
+
+// StatsProperty is the stat property supported by all stores, for overall stats of the engine.
+const StatsProperty = "stats"
+
+// ErrUnknownProperty returned by Stat if the property is not supported.
+var ErrUnknownProperty = errors.New("unknown property")
+
 // Getter wraps methods for getting kvs.
 type Getter interface {
 	// Get value for given key.
@@ -36,6 +44,17 @@ type GetPutCloser interface {
 	Close() error
 }
 
+// Store is a persistent kv store backed by a storage engine.
+type Store interface {
+	GetPutCloser
+
+	// Stat returns a particular internal stat of the engine.
+	Stat(property string) (string, error)
+	// Compact flattens the underlying data for the given key range.
+	// A nil start or limit is treated as the first or last key.
+	Compact(start []byte, limit []byte) error
+}
+
 // Batch defines batch of putting ops.
 type Batch interface {
 	Putter
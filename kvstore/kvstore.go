@@ -0,0 +1,108 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package kvstore opens persistent kv stores with the chosen engine.
+package kvstore
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/pebbledb"
+)
+
+// supported engines
+const (
+	LevelDB = "leveldb"
+	Pebble  = "pebble"
+)
+
+// Engines lists supported engines.
+var Engines = []string{LevelDB, Pebble}
+
+// Options options for opening store.
+type Options struct {
+	CacheSize              int
+	OpenFilesCacheCapacity int
+}
+
+// Detect returns the engine of the store at path, or empty string if there's no store.
+func Detect(path string) (string, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if len(entries) == 0 {
+		return "", nil
+	}
+	// pebble writes CURRENT as well, so check OPTIONS first
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), "OPTIONS-") {
+			return Pebble, nil
+		}
+	}
+	for _, e := range entries {
+		if e.Name() == "CURRENT" {
+			return LevelDB, nil
+		}
+	}
+	return "", fmt.Errorf("unknown store at %v", path)
+}
+
+// Open opens the store at path with the engine, an empty one is created if not exists.
+// It refuses to open the store created by another engine.
+func Open(engine string, path string, opts Options) (kv.Store, error) {
+	existing, err := Detect(path)
+	if err != nil {
+		return nil, err
+	}
+	if existing != "" && existing != engine {
+		return nil, fmt.Errorf("store at %v is %v, not %v", path, existing, engine)
+	}
+	switch engine {
+	case LevelDB:
+		return lvldb.New(path, lvldb.Options{CacheSize: opts.CacheSize, OpenFilesCacheCapacity: opts.OpenFilesCacheCapacity})
+	case Pebble:
+		return pebbledb.New(path, pebbledb.Options{CacheSize: opts.CacheSize, OpenFilesCacheCapacity: opts.OpenFilesCacheCapacity})
+	default:
+		return nil, fmt.Errorf("unknown engine %v, expected one of %v", engine, Engines)
+	}
+}
+
+// Copy copies all kvs from src to dst in batches, and returns number of copied kvs.
+func Copy(dst, src kv.GetPutter, batchSize int, progress func(n int)) (int, error) {
+	it := src.NewIterator(kv.Range{})
+	defer it.Release()
+
+	var (
+		n     = 0
+		batch = dst.NewBatch()
+	)
+	for it.Next() {
+		if err := batch.Put(it.Key(), it.Value()); err != nil {
+			return n, err
+		}
+		n++
+		if batch.Len() >= batchSize {
+			if err := batch.Write(); err != nil {
+				return n, err
+			}
+			batch = dst.NewBatch()
+			if progress != nil {
+				progress(n)
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return n, err
+	}
+	return n, batch.Write()
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package kvstore
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	paths := map[string]string{
+		LevelDB: filepath.Join(dir, "leveldb"),
+		Pebble:  filepath.Join(dir, "pebble"),
+	}
+	for engine, path := range paths {
+		engine, path := engine, path
+		detected, err := Detect(path)
+		assert.Nil(t, err)
+		assert.Equal(t, "", detected)
+
+		store, err := Open(engine, path, Options{})
+		assert.Nil(t, err)
+		for i := 0; i < 100; i++ {
+			assert.Nil(t, store.Put([]byte(fmt.Sprintf("key%03d", i)), []byte{byte(i)}))
+		}
+		assert.Nil(t, store.Close())
+
+		detected, err = Detect(path)
+		assert.Nil(t, err)
+		assert.Equal(t, engine, detected)
+	}
+
+	_, err := Open(Pebble, paths[LevelDB], Options{})
+	assert.NotNil(t, err)
+	_, err = Open(LevelDB, paths[Pebble], Options{})
+	assert.NotNil(t, err)
+	_, err = Open("rocksdb", filepath.Join(dir, "rocksdb"), Options{})
+	assert.NotNil(t, err)
+}
+
+func TestCopy(t *testing.T) {
+	dir := t.TempDir()
+	src, err := Open(LevelDB, filepath.Join(dir, "src"), Options{})
+	assert.Nil(t, err)
+	defer src.Close()
+	dst, err := Open(Pebble, filepath.Join(dir, "dst"), Options{})
+	assert.Nil(t, err)
+	defer dst.Close()
+
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, src.Put([]byte(fmt.Sprintf("key%03d", i)), []byte{byte(i)}))
+	}
+	batches := 0
+	n, err := Copy(dst, src, 30, func(int) { batches++ })
+	assert.Nil(t, err)
+	assert.Equal(t, 100, n)
+	assert.Equal(t, 3, batches)
+
+	for i := 0; i < 100; i++ {
+		val, err := dst.Get([]byte(fmt.Sprintf("key%03d", i)))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{byte(i)}, val)
+	}
+}
@@ -8,6 +8,7 @@ package logdb
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math/big"
@@ -17,6 +18,7 @@ import (
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/meter"
 	"github.com/meterio/meter-pov/tx"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type LogDB struct {
@@ -39,10 +41,13 @@ func New(path string) (logDB *LogDB, err error) {
 			}
 		}
 	}()
-	if _, err := db.Exec(eventTableSchema + transferTableSchema); err != nil {
+	if _, err := db.Exec(eventTableSchema + transferTableSchema + accountTxTableSchema + stakingRecordTableSchema); err != nil {
 		return nil, err
 	}
 
+	prometheus.Register(commitDurationHistogram)
+	prometheus.Register(committedRowsCounter)
+
 	driverVer, _, _ := sqlite3.Version()
 	return &LogDB{
 		path,
@@ -205,6 +210,74 @@ func (db *LogDB) FilterTransfers(ctx context.Context, filter *TransferFilter) ([
 	return db.queryTransfers(ctx, stmt, args...)
 }
 
+// FilterAccountTxs lists txs sent or received by an account.
+func (db *LogDB) FilterAccountTxs(ctx context.Context, filter *AccountTxFilter) ([]*AccountTx, error) {
+	args := []interface{}{filter.Address.Bytes()}
+	stmt := "SELECT * FROM accountTx WHERE address = ?"
+	condition := "blockNumber"
+	if filter.Range != nil {
+		if filter.Range.Unit == Time {
+			condition = "blockTime"
+		}
+		args = append(args, filter.Range.From)
+		stmt += " AND " + condition + " >= ? "
+		if filter.Range.To >= filter.Range.From {
+			args = append(args, filter.Range.To)
+			stmt += " AND " + condition + " <= ? "
+		}
+	}
+	if filter.Order == DESC {
+		stmt += " ORDER BY blockNumber DESC,txIndex DESC "
+	} else {
+		stmt += " ORDER BY blockNumber ASC,txIndex ASC "
+	}
+	if filter.Options != nil {
+		stmt += " limit ?, ? "
+		args = append(args, filter.Options.Offset, filter.Options.Limit)
+	}
+	return db.queryAccountTxs(ctx, stmt, args...)
+}
+
+// FilterStakingRecords lists history of a bucket or a candidate.
+func (db *LogDB) FilterStakingRecords(ctx context.Context, filter *StakingRecordFilter) ([]*StakingRecord, error) {
+	var (
+		args []interface{}
+		stmt string
+	)
+	switch {
+	case filter.BucketID != nil:
+		args = append(args, filter.BucketID.Bytes())
+		stmt = "SELECT * FROM stakingRecord WHERE bucketID = ?"
+	case filter.Candidate != nil:
+		args = append(args, filter.Candidate.Bytes())
+		stmt = "SELECT * FROM stakingRecord WHERE candidate = ? AND bucketID IS NULL"
+	default:
+		return nil, errors.New("bucket id or candidate is required")
+	}
+	condition := "blockNumber"
+	if filter.Range != nil {
+		if filter.Range.Unit == Time {
+			condition = "blockTime"
+		}
+		args = append(args, filter.Range.From)
+		stmt += " AND " + condition + " >= ? "
+		if filter.Range.To >= filter.Range.From {
+			args = append(args, filter.Range.To)
+			stmt += " AND " + condition + " <= ? "
+		}
+	}
+	if filter.Order == DESC {
+		stmt += " ORDER BY blockNumber DESC,recordIndex DESC "
+	} else {
+		stmt += " ORDER BY blockNumber ASC,recordIndex ASC "
+	}
+	if filter.Options != nil {
+		stmt += " limit ?, ? "
+		args = append(args, filter.Options.Offset, filter.Options.Limit)
+	}
+	return db.queryStakingRecords(ctx, stmt, args...)
+}
+
 func (db *LogDB) queryEvents(ctx context.Context, stmt string, args ...interface{}) ([]*Event, error) {
 	rows, err := db.db.QueryContext(ctx, stmt, args...)
 	if err != nil {
@@ -330,6 +403,141 @@ func (db *LogDB) queryTransfers(ctx context.Context, stmt string, args ...interf
 	return transfers, nil
 }
 
+func (db *LogDB) queryAccountTxs(ctx context.Context, stmt string, args ...interface{}) ([]*AccountTx, error) {
+	rows, err := db.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var txs []*AccountTx
+	for rows.Next() {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		var (
+			blockID     []byte
+			index       uint32
+			blockNumber uint32
+			blockTime   uint64
+			txID        []byte
+			txOrigin    []byte
+			address     []byte
+			reverted    bool
+		)
+		if err := rows.Scan(
+			&blockID,
+			&index,
+			&blockNumber,
+			&blockTime,
+			&txID,
+			&txOrigin,
+			&address,
+			&reverted,
+		); err != nil {
+			return nil, err
+		}
+		txs = append(txs, &AccountTx{
+			BlockID:     meter.BytesToBytes32(blockID),
+			Index:       index,
+			BlockNumber: blockNumber,
+			BlockTime:   blockTime,
+			TxID:        meter.BytesToBytes32(txID),
+			TxOrigin:    meter.BytesToAddress(txOrigin),
+			Address:     meter.BytesToAddress(address),
+			Reverted:    reverted,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+func (db *LogDB) queryStakingRecords(ctx context.Context, stmt string, args ...interface{}) ([]*StakingRecord, error) {
+	rows, err := db.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var records []*StakingRecord
+	for rows.Next() {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		var (
+			blockID     []byte
+			index       uint32
+			blockNumber uint32
+			blockTime   uint64
+			txID        []byte
+			txOrigin    []byte
+			op          string
+			bucketID    []byte
+			owner       []byte
+			candidate   []byte
+			value       []byte
+			totalVotes  []byte
+			autobid     uint8
+			unbounded   bool
+			removed     bool
+		)
+		if err := rows.Scan(
+			&blockID,
+			&index,
+			&blockNumber,
+			&blockTime,
+			&txID,
+			&txOrigin,
+			&op,
+			&bucketID,
+			&owner,
+			&candidate,
+			&value,
+			&totalVotes,
+			&autobid,
+			&unbounded,
+			&removed,
+		); err != nil {
+			return nil, err
+		}
+		record := &StakingRecord{
+			BlockID:     meter.BytesToBytes32(blockID),
+			Index:       index,
+			BlockNumber: blockNumber,
+			BlockTime:   blockTime,
+			TxID:        meter.BytesToBytes32(txID),
+			TxOrigin:    meter.BytesToAddress(txOrigin),
+			Op:          op,
+			BucketID:    meter.BytesToBytes32(bucketID),
+			Owner:       meter.BytesToAddress(owner),
+			Candidate:   meter.BytesToAddress(candidate),
+			TotalVotes:  new(big.Int).SetBytes(totalVotes),
+			Autobid:     autobid,
+			Unbounded:   unbounded,
+			Removed:     removed,
+		}
+		if value != nil {
+			record.Value = new(big.Int).SetBytes(value)
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func bigIntValue(v *big.Int) []byte {
+	if v == nil {
+		return nil
+	}
+	return v.Bytes()
+}
+
 func topicValue(topic *meter.Bytes32) []byte {
 	if topic == nil {
 		return nil
@@ -338,10 +546,12 @@ func topicValue(topic *meter.Bytes32) []byte {
 }
 
 type BlockBatch struct {
-	db        *sql.DB
-	header    *block.Header
-	events    []*Event
-	transfers []*Transfer
+	db         *sql.DB
+	header     *block.Header
+	events     []*Event
+	transfers  []*Transfer
+	accountTxs []*AccountTx
+	records    []*StakingRecord
 }
 
 func (bb *BlockBatch) execInTx(proc func(*sql.Tx) error) (err error) {
@@ -361,6 +571,19 @@ func (bb *BlockBatch) execInTx(proc func(*sql.Tx) error) (err error) {
 }
 
 func (bb *BlockBatch) Commit(abandonedBlocks ...meter.Bytes32) error {
+	start := time.Now()
+	if err := bb.commit(abandonedBlocks...); err != nil {
+		return err
+	}
+	commitDurationHistogram.Observe(time.Since(start).Seconds())
+	committedRowsCounter.WithLabelValues("event").Add(float64(len(bb.events)))
+	committedRowsCounter.WithLabelValues("transfer").Add(float64(len(bb.transfers)))
+	committedRowsCounter.WithLabelValues("accountTx").Add(float64(len(bb.accountTxs)))
+	committedRowsCounter.WithLabelValues("stakingRecord").Add(float64(len(bb.records)))
+	return nil
+}
+
+func (bb *BlockBatch) commit(abandonedBlocks ...meter.Bytes32) error {
 	return bb.execInTx(func(tx *sql.Tx) error {
 		for _, event := range bb.events {
 			if _, err := tx.Exec("INSERT OR REPLACE INTO event(blockID ,eventIndex, blockNumber ,blockTime ,txID ,txOrigin ,address ,topic0 ,topic1 ,topic2 ,topic3 ,topic4, data) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);",
@@ -398,6 +621,45 @@ func (bb *BlockBatch) Commit(abandonedBlocks ...meter.Bytes32) error {
 				return err
 			}
 		}
+		for _, accountTx := range bb.accountTxs {
+			if _, err := tx.Exec("INSERT OR REPLACE INTO accountTx(blockID ,txIndex, blockNumber ,blockTime ,txID ,txOrigin ,address ,reverted) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?);",
+				accountTx.BlockID.Bytes(),
+				accountTx.Index,
+				accountTx.BlockNumber,
+				accountTx.BlockTime,
+				accountTx.TxID.Bytes(),
+				accountTx.TxOrigin.Bytes(),
+				accountTx.Address.Bytes(),
+				accountTx.Reverted,
+			); err != nil {
+				return err
+			}
+		}
+		for _, record := range bb.records {
+			var bucketID []byte
+			if !record.BucketID.IsZero() {
+				bucketID = record.BucketID.Bytes()
+			}
+			if _, err := tx.Exec("INSERT OR REPLACE INTO stakingRecord(blockID ,recordIndex, blockNumber ,blockTime ,txID ,txOrigin ,op ,bucketID ,owner ,candidate ,value ,totalVotes ,autobid ,unbounded ,removed) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);",
+				record.BlockID.Bytes(),
+				record.Index,
+				record.BlockNumber,
+				record.BlockTime,
+				record.TxID.Bytes(),
+				record.TxOrigin.Bytes(),
+				record.Op,
+				bucketID,
+				record.Owner.Bytes(),
+				record.Candidate.Bytes(),
+				bigIntValue(record.Value),
+				bigIntValue(record.TotalVotes),
+				record.Autobid,
+				record.Unbounded,
+				record.Removed,
+			); err != nil {
+				return err
+			}
+		}
 		for _, id := range abandonedBlocks {
 			if _, err := tx.Exec("DELETE FROM event WHERE blockID = ?;", id.Bytes()); err != nil {
 				return err
@@ -405,6 +667,12 @@ func (bb *BlockBatch) Commit(abandonedBlocks ...meter.Bytes32) error {
 			if _, err := tx.Exec("DELETE FROM transfer WHERE blockID = ?;", id.Bytes()); err != nil {
 				return err
 			}
+			if _, err := tx.Exec("DELETE FROM accountTx WHERE blockID = ?;", id.Bytes()); err != nil {
+				return err
+			}
+			if _, err := tx.Exec("DELETE FROM stakingRecord WHERE blockID = ?;", id.Bytes()); err != nil {
+				return err
+			}
 		}
 		return nil
 	})
@@ -427,3 +695,34 @@ func (bb *BlockBatch) ForTransaction(txID meter.Bytes32, txOrigin meter.Address)
 		},
 	}
 }
+
+// InsertTx indexes the tx for its origin and recipients of its clauses.
+func (bb *BlockBatch) InsertTx(index uint32, trx *tx.Transaction, txOrigin meter.Address, reverted bool) *BlockBatch {
+	addresses := []meter.Address{txOrigin}
+	for _, clause := range trx.Clauses() {
+		if to := clause.To(); to != nil && !containsAddress(addresses, *to) {
+			addresses = append(addresses, *to)
+		}
+	}
+	for _, addr := range addresses {
+		bb.accountTxs = append(bb.accountTxs, newAccountTx(bb.header, index, trx.ID(), txOrigin, addr, reverted))
+	}
+	return bb
+}
+
+// InsertStakingRecords records staking changes made by the tx.
+func (bb *BlockBatch) InsertStakingRecords(txID meter.Bytes32, txOrigin meter.Address, records tx.StakingRecords) *BlockBatch {
+	for _, r := range records {
+		bb.records = append(bb.records, newStakingRecord(bb.header, uint32(len(bb.records)), txID, txOrigin, r))
+	}
+	return bb
+}
+
+func containsAddress(addresses []meter.Address, addr meter.Address) bool {
+	for _, a := range addresses {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -7,11 +7,14 @@ package logdb_test
 
 import (
 	"context"
+	"database/sql"
 	"math/big"
 	"os"
 	"os/user"
+	"path/filepath"
 	"testing"
 
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/logdb"
 	"github.com/meterio/meter-pov/meter"
@@ -134,6 +137,130 @@ func TestTransfers(t *testing.T) {
 	assert.Equal(t, len(ts), count, "transfers searched")
 }
 
+func TestAccountTxs(t *testing.T) {
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	from := meter.BytesToAddress([]byte("from"))
+	to := meter.BytesToAddress([]byte("to"))
+	header := new(block.Builder).Build().Header()
+	var lastTx *tx.Transaction
+	for i := 0; i < 10; i++ {
+		// zero value call, contract creation and another call to the same recipient
+		trx := new(tx.Builder).Nonce(uint64(i)).
+			Clause(tx.NewClause(&to)).
+			Clause(tx.NewClause(nil)).
+			Clause(tx.NewClause(&to).WithValue(big.NewInt(1))).
+			Build()
+		header = new(block.Builder).ParentID(header.ID()).Build().Header()
+		if err := db.Prepare(header).InsertTx(0, trx, from, i%2 == 0).Commit(); err != nil {
+			t.Fatal(err)
+		}
+		lastTx = trx
+	}
+
+	txs, err := db.FilterAccountTxs(context.Background(), &logdb.AccountTxFilter{Address: to})
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(txs))
+
+	txs, err = db.FilterAccountTxs(context.Background(), &logdb.AccountTxFilter{
+		Address: from,
+		Range:   &logdb.Range{Unit: logdb.Block, From: 3, To: 8},
+		Options: &logdb.Options{Offset: 1, Limit: 2},
+		Order:   logdb.DESC,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(txs))
+	assert.Equal(t, uint32(7), txs[0].BlockNumber)
+	assert.Equal(t, uint32(6), txs[1].BlockNumber)
+	assert.Equal(t, from, txs[0].TxOrigin)
+	assert.False(t, txs[0].Reverted)
+	assert.True(t, txs[1].Reverted)
+
+	// txs of abandoned block are removed
+	assert.Nil(t, db.Prepare(header).Commit(header.ID()))
+	txs, err = db.FilterAccountTxs(context.Background(), &logdb.AccountTxFilter{Address: to, Order: logdb.DESC})
+	assert.Nil(t, err)
+	assert.Equal(t, 9, len(txs))
+	assert.NotEqual(t, lastTx.ID(), txs[0].TxID)
+}
+
+func TestAccountTxIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.db")
+	db, err := logdb.New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	sqlDB, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlDB.Close()
+
+	// index names are global in sqlite, the unique index of accountTx must not collide with others
+	var table string
+	err = sqlDB.QueryRow("SELECT tbl_name FROM sqlite_master WHERE type = 'index' AND name = 'accountTxPrim'").Scan(&table)
+	assert.Nil(t, err)
+	assert.Equal(t, "accountTx", table)
+}
+
+func TestStakingRecords(t *testing.T) {
+	db, err := logdb.NewMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	owner := meter.BytesToAddress([]byte("owner"))
+	cand := meter.BytesToAddress([]byte("cand"))
+	bucketID := meter.BytesToBytes32([]byte("bucket"))
+	header := new(block.Builder).Build().Header()
+	for i := 0; i < 5; i++ {
+		records := tx.StakingRecords{
+			{Op: "delegate", BucketID: bucketID, Owner: owner, Candidate: cand, Value: big.NewInt(int64(i)), TotalVotes: big.NewInt(int64(i))},
+			{Op: "delegate", Candidate: cand, TotalVotes: big.NewInt(int64(i * 10))},
+		}
+		header = new(block.Builder).ParentID(header.ID()).Build().Header()
+		txID := meter.BytesToBytes32([]byte{byte(i)})
+		if err := db.Prepare(header).InsertStakingRecords(txID, owner, records).Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := db.FilterStakingRecords(context.Background(), &logdb.StakingRecordFilter{BucketID: &bucketID})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(records))
+	assert.Equal(t, owner, records[0].Owner)
+	assert.Equal(t, big.NewInt(4), records[4].Value)
+
+	// candidate history leaves out bucket records
+	records, err = db.FilterStakingRecords(context.Background(), &logdb.StakingRecordFilter{
+		Candidate: &cand,
+		Range:     &logdb.Range{Unit: logdb.Block, From: 2, To: 4},
+		Options:   &logdb.Options{Offset: 0, Limit: 2},
+		Order:     logdb.DESC,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, uint32(4), records[0].BlockNumber)
+	assert.Equal(t, big.NewInt(20), records[0].TotalVotes)
+	assert.Nil(t, records[0].Value)
+
+	_, err = db.FilterStakingRecords(context.Background(), &logdb.StakingRecordFilter{})
+	assert.NotNil(t, err)
+
+	// records of abandoned block are removed
+	assert.Nil(t, db.Prepare(header).Commit(header.ID()))
+	records, err = db.FilterStakingRecords(context.Background(), &logdb.StakingRecordFilter{BucketID: &bucketID})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(records))
+}
+
 func home() (string, error) {
 	// try to get HOME env
 	if home := os.Getenv("HOME"); home != "" {
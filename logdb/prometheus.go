@@ -0,0 +1,20 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package logdb
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	commitDurationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "logdb_commit_duration_seconds",
+		Help:    "Time taken to commit the logs of a block",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 12),
+	})
+	committedRowsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "logdb_committed_rows_total",
+		Help: "Counter of rows committed to logdb, by table",
+	}, []string{"table"})
+)
@@ -58,4 +58,45 @@ CREATE INDEX IF NOT EXISTS blockTimeIndex ON transfer(blockTime);
 CREATE INDEX IF NOT EXISTS senderIndex ON transfer(sender);
 CREATE INDEX IF NOT EXISTS recipientIndex ON transfer(recipient);
 CREATE INDEX IF NOT EXISTS transferIndex ON transfer(transferIndex);`
+
+	// create a table for txs of accounts, indexed by tx origin and clause recipients
+	accountTxTableSchema = `CREATE TABLE IF NOT EXISTS accountTx (
+	blockID	BLOB(32),
+	txIndex INTEGER,
+	blockNumber INTEGER,
+	blockTime INTEGER,
+	txID BLOB(32),
+	txOrigin BLOB(20),
+	address BLOB(20),
+	reverted INTEGER
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS accountTxPrim ON accountTx(blockID, txIndex, address);
+
+CREATE INDEX IF NOT EXISTS addressAndBlockNumberIndex ON accountTx(address, blockNumber);
+CREATE INDEX IF NOT EXISTS addressAndBlockTimeIndex ON accountTx(address, blockTime);`
+
+	// create a table for staking history, bucketID is null for candidate records
+	stakingRecordTableSchema = `CREATE TABLE IF NOT EXISTS stakingRecord (
+	blockID	BLOB(32),
+	recordIndex INTEGER,
+	blockNumber INTEGER,
+	blockTime INTEGER,
+	txID BLOB(32),
+	txOrigin BLOB(20),
+	op TEXT,
+	bucketID BLOB(32),
+	owner BLOB(20),
+	candidate BLOB(20),
+	value BLOB,
+	totalVotes BLOB,
+	autobid INTEGER,
+	unbounded INTEGER,
+	removed INTEGER
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS stakingRecordPrim ON stakingRecord(blockID, recordIndex);
+
+CREATE INDEX IF NOT EXISTS bucketIDAndBlockNumberIndex ON stakingRecord(bucketID, blockNumber);
+CREATE INDEX IF NOT EXISTS candidateAndBlockNumberIndex ON stakingRecord(candidate, blockNumber);`
 )
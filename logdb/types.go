@@ -74,6 +74,71 @@ func newTransfer(header *block.Header, index uint32, txID meter.Bytes32, txOrigi
 	}
 }
 
+// AccountTx represents a tx sent or received by an account.
+type AccountTx struct {
+	BlockID     meter.Bytes32
+	Index       uint32 // index of tx in block
+	BlockNumber uint32
+	BlockTime   uint64
+	TxID        meter.Bytes32
+	TxOrigin    meter.Address
+	Address     meter.Address // tx origin or recipient of any clause
+	Reverted    bool
+}
+
+// newAccountTx indexes tx for the account.
+func newAccountTx(header *block.Header, index uint32, txID meter.Bytes32, txOrigin meter.Address, addr meter.Address, reverted bool) *AccountTx {
+	return &AccountTx{
+		BlockID:     header.ID(),
+		Index:       index,
+		BlockNumber: header.Number(),
+		BlockTime:   header.Timestamp(),
+		TxID:        txID,
+		TxOrigin:    txOrigin,
+		Address:     addr,
+		Reverted:    reverted,
+	}
+}
+
+// StakingRecord represents a bucket or candidate changed by a staking op.
+type StakingRecord struct {
+	BlockID     meter.Bytes32
+	Index       uint32 // index of record in block
+	BlockNumber uint32
+	BlockTime   uint64
+	TxID        meter.Bytes32
+	TxOrigin    meter.Address
+	Op          string
+	BucketID    meter.Bytes32 // zero for candidate records
+	Owner       meter.Address
+	Candidate   meter.Address
+	Value       *big.Int
+	TotalVotes  *big.Int
+	Autobid     uint8
+	Unbounded   bool
+	Removed     bool
+}
+
+func newStakingRecord(header *block.Header, index uint32, txID meter.Bytes32, txOrigin meter.Address, r *tx.StakingRecord) *StakingRecord {
+	return &StakingRecord{
+		BlockID:     header.ID(),
+		Index:       index,
+		BlockNumber: header.Number(),
+		BlockTime:   header.Timestamp(),
+		TxID:        txID,
+		TxOrigin:    txOrigin,
+		Op:          r.Op,
+		BucketID:    r.BucketID,
+		Owner:       r.Owner,
+		Candidate:   r.Candidate,
+		Value:       r.Value,
+		TotalVotes:  r.TotalVotes,
+		Autobid:     r.Autobid,
+		Unbounded:   r.Unbounded,
+		Removed:     r.Removed,
+	}
+}
+
 type RangeType string
 
 const (
@@ -125,3 +190,19 @@ type TransferFilter struct {
 	Options     *Options
 	Order       Order //default asc
 }
+
+type AccountTxFilter struct {
+	Address meter.Address
+	Range   *Range
+	Options *Options
+	Order   Order //default asc
+}
+
+// StakingRecordFilter filters history of a bucket, or of a candidate if bucket id is not set.
+type StakingRecordFilter struct {
+	BucketID  *meter.Bytes32
+	Candidate *meter.Address
+	Range     *Range
+	Options   *Options
+	Order     Order //default asc
+}
@@ -15,7 +15,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
-var _ kv.GetPutCloser = (*LevelDB)(nil)
+var _ kv.Store = (*LevelDB)(nil)
 
 // Options options for creating level db instance.
 type Options struct {
@@ -118,7 +118,19 @@ func (ldb *LevelDB) NewIterator(r kv.Range) kv.Iterator {
 }
 
 // Stat returns a particular internal stat of the database.
+// kv.StatsProperty returns both leveldb.stats and leveldb.iostats.
 func (ldb *LevelDB) Stat(property string) (string, error) {
+	if property == kv.StatsProperty {
+		stats, err := ldb.db.GetProperty("leveldb.stats")
+		if err != nil {
+			return "", err
+		}
+		ioStats, err := ldb.db.GetProperty("leveldb.iostats")
+		if err != nil {
+			return "", err
+		}
+		return stats + "\n" + ioStats, nil
+	}
 	return ldb.db.GetProperty(property)
 }
 
@@ -195,6 +195,21 @@ const (
 	TeslaFork11_TestnetStartNum = 99999999 // TBD
 )
 
+// Fork 12 fixes includes:
+//  1. staking buckets and candidates are stored per entry instead of one rlp list,
+//     existing lists are migrated once the fork activates
+const (
+	TeslaFork12_MainnetStartNum = 99999999 // TBD
+	TeslaFork12_TestnetStartNum = 99999999 // TBD
+)
+
+// Fork 13 fixes includes:
+//  1. access lists of typed ethereum txs and warm/cold gas accounting (EIP-2929/2930)
+const (
+	TeslaFork13_MainnetStartNum = 99999999 // TBD
+	TeslaFork13_TestnetStartNum = 99999999 // TBD
+)
+
 var (
 	// BlocktChainConfig is the chain parameters to run a node on the main network.
 	BlockChainConfig = &ChainConfig{
@@ -206,9 +221,36 @@ var (
 type ChainConfig struct {
 	ChainFlag   string
 	Initialized bool
+
+	// set only for custom networks
+	ChainID uint64
+	Forks   *ForkHeights
+}
+
+// ForkHeights is the fork schedule of a custom network, each fork is active from its height on.
+type ForkHeights struct {
+	SysContract uint32 `json:"sysContract"`
+	Tesla       uint32 `json:"tesla"`
+	TeslaFork1  uint32 `json:"teslaFork1"`
+	TeslaFork2  uint32 `json:"teslaFork2"`
+	TeslaFork3  uint32 `json:"teslaFork3"`
+	TeslaFork4  uint32 `json:"teslaFork4"`
+	TeslaFork5  uint32 `json:"teslaFork5"`
+	TeslaFork6  uint32 `json:"teslaFork6"`
+	TeslaFork7  uint32 `json:"teslaFork7"`
+	TeslaFork8  uint32 `json:"teslaFork8"`
+	TeslaFork9  uint32 `json:"teslaFork9"`
+	TeslaFork10 uint32 `json:"teslaFork10"`
+	TeslaFork11 uint32 `json:"teslaFork11"`
+	TeslaFork12 uint32 `json:"teslaFork12"` // required in network files
+	TeslaFork13 uint32 `json:"teslaFork13"` // required in network files
 }
 
 func (c *ChainConfig) ToString() string {
+	if c.IsCustom() {
+		return fmt.Sprintf("ChainFlag: %v, Initialized: %v, ChainID: %v, Forks: %+v",
+			c.ChainFlag, c.Initialized, c.ChainID, *c.Forks)
+	}
 	return fmt.Sprintf("ChainFlag: %v, Initialized: %v",
 		c.ChainFlag, c.Initialized)
 }
@@ -235,6 +277,11 @@ func (c *ChainConfig) IsMainnet() bool {
 	}
 }
 
+// IsCustom returns whether the chain is a custom network loaded from a network file.
+func (c *ChainConfig) IsCustom() bool {
+	return c.IsInitialized() && c.Forks != nil
+}
+
 func (c *ChainConfig) IsStaging() bool {
 	if !c.IsInitialized() {
 		slog.Warn("Chain is not initialized", "chain-flag", c.ChainFlag)
@@ -268,6 +315,61 @@ func (c *ChainConfig) IsTestnet() bool {
 func InitBlockChainConfig(chainFlag string) {
 	BlockChainConfig.ChainFlag = chainFlag
 	BlockChainConfig.Initialized = true
+	BlockChainConfig.ChainID = 0
+	BlockChainConfig.Forks = nil
+}
+
+// InitCustomBlockChainConfig inits the config of a custom network, with its own chain id and fork schedule.
+func InitCustomBlockChainConfig(name string, chainID uint64, forks ForkHeights) {
+	BlockChainConfig.ChainFlag = name
+	BlockChainConfig.Initialized = true
+	BlockChainConfig.ChainID = chainID
+	BlockChainConfig.Forks = &forks
+}
+
+func IsCustomNet() bool {
+	return BlockChainConfig.IsCustom()
+}
+
+// ChainID returns the chain id of the running network.
+func ChainID() uint64 {
+	if BlockChainConfig.IsCustom() {
+		return BlockChainConfig.ChainID
+	}
+	if BlockChainConfig.IsMainnet() {
+		return MainnetChainID
+	}
+	return TestnetChainID
+}
+
+// TeslaStartNum returns the height tesla starts at, which is the mainnet height unless it's a custom network.
+func TeslaStartNum() uint32 {
+	if BlockChainConfig.IsCustom() {
+		return BlockChainConfig.Forks.Tesla
+	}
+	return TeslaMainnetStartNum
+}
+
+// IsTeslaForkInit returns whether tesla modules should be started once the block is committed.
+func IsTeslaForkInit(blockNum uint32) bool {
+	if BlockChainConfig.IsCustom() {
+		return BlockChainConfig.Forks.Tesla > 0 && blockNum+1 == BlockChainConfig.Forks.Tesla
+	}
+	return BlockChainConfig.IsMainnet() && blockNum == TeslaMainnetStartNum
+}
+
+// EthForkHeights returns heights of Istanbul, London, Paris and Berlin forks, which are ported to tesla fork3, fork9, fork11 and fork13.
+// Berlin starts at the first block where IsTeslaFork13 holds.
+func EthForkHeights() (istanbul, london, paris, berlin uint32) {
+	switch {
+	case BlockChainConfig.IsCustom():
+		f := BlockChainConfig.Forks
+		return f.TeslaFork3, f.TeslaFork9, f.TeslaFork11, f.TeslaFork13
+	case BlockChainConfig.IsMainnet():
+		return TeslaFork3_MainnetStartNum, TeslaFork9_MainnetStartNum, TeslaFork11_MainnetStartNum, TeslaFork13_MainnetStartNum + 1
+	default:
+		return TeslaFork3_TestnetStartNum, TeslaFork9_TestnetStartNum, TeslaFork11_TestnetStartNum, TeslaFork13_TestnetStartNum + 1
+	}
 }
 
 func IsTestNet() bool {
@@ -283,57 +385,113 @@ func IsStaging() bool {
 }
 
 func IsEdison(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum < f.Tesla
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum >= EdisonMainnetStartNum && blockNum < TeslaMainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum >= EdisonTestnetStartNum && blockNum < TeslaTestnetStartNum)
 }
 
 func IsSysContractEnabled(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.SysContract
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum >= EdisonSysContract_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum >= EdisonSysContract_TestnetStartNum)
 }
 
 func IsTesla(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.Tesla
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaMainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum >= TeslaTestnetStartNum)
 }
 
 func IsTeslaFork1(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork1
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork1_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork1_TestnetStartNum)
 }
 
 func IsTeslaFork2(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork2
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork2_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork2_TestnetStartNum)
 }
 
 func IsTeslaFork3(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork3
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork3_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork3_TestnetStartNum)
 }
 
 func IsTeslaFork4(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork4
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork4_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork4_TestnetStartNum)
 }
 
 func IsTeslaFork5(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork5
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork5_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork5_TestnetStartNum)
 }
 
 func IsTeslaFork6(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork6
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork6_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork6_TestnetStartNum)
 }
 
 func IsTeslaFork7(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork7
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork7_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork7_TestnetStartNum)
 }
 
 func IsTeslaFork8(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork8
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork8_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork8_TestnetStartNum)
 }
 
 func IsTeslaFork9(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork9
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork9_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork9_TestnetStartNum)
 }
 
 func IsTeslaFork10(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork10
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork10_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork10_TestnetStartNum)
 }
 
 func IsTeslaFork11(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork11
+	}
 	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork11_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork11_TestnetStartNum)
 }
+
+func IsTeslaFork12(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork12
+	}
+	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork12_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork12_TestnetStartNum)
+}
+
+func IsTeslaFork13(blockNum uint32) bool {
+	if f := BlockChainConfig.Forks; BlockChainConfig.IsCustom() {
+		return blockNum >= f.TeslaFork13
+	}
+	return (BlockChainConfig.IsMainnet() && blockNum > TeslaFork13_MainnetStartNum) || (BlockChainConfig.IsTestnet() && blockNum > TeslaFork13_TestnetStartNum)
+}
@@ -103,6 +103,11 @@ var (
 	StatisticsEpochKey     = Blake2b([]byte("delegate-statistics-epoch-key"))
 	InJailListKey          = Blake2b([]byte("delegate-injail-list-key"))
 	ValidatorRewardListKey = Blake2b([]byte("validator-reward-list-key"))
+
+	// per entry layout of buckets and candidates since fork12
+	StakingEntryLayoutKey = Blake2b([]byte("staking-entry-layout-key"))
+	BucketIndexKey        = Blake2b([]byte("bucket-index-key"))    // ordered bucket ids
+	CandidateIndexKey     = Blake2b([]byte("candidate-index-key")) // ordered candidate addresses
 )
 
 // Keys of governance params.
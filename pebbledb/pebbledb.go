@@ -0,0 +1,239 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package pebbledb
+
+import (
+	"bytes"
+	"runtime"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/meterio/meter-pov/kv"
+)
+
+var _ kv.Store = (*PebbleDB)(nil)
+
+// Options options for creating pebble db instance.
+type Options struct {
+	CacheSize              int
+	OpenFilesCacheCapacity int
+}
+
+// same as level db, writes are not synced but logged
+var writeOpt = pebble.NoSync
+
+// PebbleDB wraps pebble db impls.
+type PebbleDB struct {
+	db *pebble.DB
+}
+
+// New create a persistent pebble db instance.
+// Create an empty one if not exists, or open if already there.
+func New(path string, opts Options) (*PebbleDB, error) {
+	if opts.CacheSize < 16 {
+		opts.CacheSize = 16
+	}
+
+	if opts.OpenFilesCacheCapacity < 16 {
+		opts.OpenFilesCacheCapacity = 16
+	}
+
+	cache := pebble.NewCache(int64(opts.CacheSize) * 1024 * 1024)
+	defer cache.Unref()
+
+	levels := make([]pebble.LevelOptions, 7)
+	for i := range levels {
+		levels[i] = pebble.LevelOptions{TargetFileSize: 64 * 1024 * 1024, FilterPolicy: bloom.FilterPolicy(10)}
+	}
+	opt := &pebble.Options{
+		Cache:        cache,
+		MaxOpenFiles: opts.OpenFilesCacheCapacity,
+		// two memtables, a frozen one and a live one, as level db does
+		MemTableSize:                uint64(opts.CacheSize) * 1024 * 1024 / 4,
+		MemTableStopWritesThreshold: 2,
+		MaxConcurrentCompactions:    func() int { return runtime.NumCPU() },
+		Levels:                      levels,
+	}
+	opt.Experimental.ReadSamplingMultiplier = -1
+
+	db, err := pebble.Open(path, opt)
+	if err != nil {
+		return nil, err
+	}
+	return &PebbleDB{db: db}, nil
+}
+
+// NewMem create a pebble db in memory.
+func NewMem() (*PebbleDB, error) {
+	db, err := pebble.Open("", &pebble.Options{FS: vfs.NewMem()})
+	if err != nil {
+		return nil, err
+	}
+	return &PebbleDB{db: db}, nil
+}
+
+// IsNotFound to check if the error returned by Get indicates key not found.
+func (pdb *PebbleDB) IsNotFound(err error) bool {
+	return err == pebble.ErrNotFound
+}
+
+// Get retrieve value for given key.
+// It returns an error if key not found. The error can be checked via IsNotFound.
+func (pdb *PebbleDB) Get(key []byte) (value []byte, err error) {
+	val, closer, err := pdb.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return append([]byte{}, val...), nil
+}
+
+// Has returns whether a key exists.
+func (pdb *PebbleDB) Has(key []byte) (bool, error) {
+	_, closer, err := pdb.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	closer.Close()
+	return true, nil
+}
+
+// Put save value fo give key.
+func (pdb *PebbleDB) Put(key, value []byte) error {
+	return pdb.db.Set(key, value, writeOpt)
+}
+
+// Delete deletes the give key and its value.
+func (pdb *PebbleDB) Delete(key []byte) error {
+	return pdb.db.Delete(key, writeOpt)
+}
+
+// Close close the pebble db.
+// Later operations will all fail.
+func (pdb *PebbleDB) Close() error {
+	return pdb.db.Close()
+}
+
+// NewBatch create a batch for writing ops.
+func (pdb *PebbleDB) NewBatch() kv.Batch {
+	return &pebbleDBBatch{
+		pdb.db,
+		pdb.db.NewBatch(),
+	}
+}
+
+// NewIterator create a iterator by range.
+func (pdb *PebbleDB) NewIterator(r kv.Range) kv.Iterator {
+	it, err := pdb.db.NewIter(&pebble.IterOptions{
+		LowerBound: r.From,
+		UpperBound: r.To,
+	})
+	return &pebbleDBIterator{it: it, err: err}
+}
+
+// Stat returns a particular internal stat of the database.
+// Only kv.StatsProperty is supported, which returns the pebble metrics.
+func (pdb *PebbleDB) Stat(property string) (string, error) {
+	if property != kv.StatsProperty {
+		return "", kv.ErrUnknownProperty
+	}
+	return pdb.db.Metrics().String(), nil
+}
+
+// Compact flattens the underlying data store for the given key range.
+//
+// A nil start is treated as a key before all keys in the data store; a nil limit
+// is treated as a key after all keys in the data store.
+func (pdb *PebbleDB) Compact(start []byte, limit []byte) error {
+	// pebble has no key to represent the end of key range, use a key larger than
+	// any trie node key instead
+	if limit == nil {
+		limit = bytes.Repeat([]byte{0xff}, 33)
+	}
+	return pdb.db.Compact(start, limit, true)
+}
+
+//////
+
+// pebbleDBBatch wraps batch operations.
+type pebbleDBBatch struct {
+	db    *pebble.DB
+	batch *pebble.Batch
+}
+
+// Put adds a put operation.
+func (b *pebbleDBBatch) Put(key, value []byte) error {
+	return b.batch.Set(key, value, nil)
+}
+
+// Delete adds a delete operation.
+func (b *pebbleDBBatch) Delete(key []byte) error {
+	return b.batch.Delete(key, nil)
+}
+
+func (b *pebbleDBBatch) NewBatch() kv.Batch {
+	return &pebbleDBBatch{
+		b.db,
+		b.db.NewBatch(),
+	}
+}
+
+// Len returns ops in the batch.
+func (b *pebbleDBBatch) Len() int {
+	return int(b.batch.Count())
+}
+
+// Write perform all ops in this batch.
+// The batch should not be written again.
+func (b *pebbleDBBatch) Write() error {
+	return b.db.Apply(b.batch, writeOpt)
+}
+
+//////
+
+// pebbleDBIterator adapts pebble iterator to kv.Iterator.
+type pebbleDBIterator struct {
+	it      *pebble.Iterator
+	err     error
+	started bool
+}
+
+func (i *pebbleDBIterator) Next() bool {
+	if i.err != nil || i.it == nil {
+		return false
+	}
+	if !i.started {
+		i.started = true
+		return i.it.First()
+	}
+	return i.it.Next()
+}
+
+func (i *pebbleDBIterator) Release() {
+	if i.it != nil {
+		i.it.Close()
+		i.it = nil
+	}
+}
+
+func (i *pebbleDBIterator) Error() error {
+	if i.err != nil || i.it == nil {
+		return i.err
+	}
+	return i.it.Error()
+}
+
+func (i *pebbleDBIterator) Key() []byte {
+	return i.it.Key()
+}
+
+func (i *pebbleDBIterator) Value() []byte {
+	return i.it.Value()
+}
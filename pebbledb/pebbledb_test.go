@@ -0,0 +1,88 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package pebbledb
+
+import (
+	"testing"
+
+	"github.com/meterio/meter-pov/kv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPebbleDB(t *testing.T) {
+	var (
+		key        = []byte("123")
+		value      = []byte("456")
+		inValidKey = []byte("abc")
+	)
+	pdb, err := New(t.TempDir(), Options{16, 16})
+	assert.Nil(t, err)
+	defer pdb.Close()
+
+	mempdb, err := NewMem()
+	assert.Nil(t, err)
+	defer mempdb.Close()
+
+	for _, db := range []*PebbleDB{pdb, mempdb} {
+		assert.Nil(t, db.Put(key, value))
+
+		ret, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, value, ret)
+
+		has, err := db.Has(key)
+		assert.Nil(t, err)
+		assert.True(t, has)
+
+		has, err = db.Has(inValidKey)
+		assert.Nil(t, err)
+		assert.False(t, has)
+
+		assert.Nil(t, db.Delete(key))
+		_, err = db.Get(key)
+		assert.True(t, db.IsNotFound(err))
+	}
+
+	stats, err := pdb.Stat(kv.StatsProperty)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, stats)
+	assert.Nil(t, pdb.Compact(nil, nil))
+}
+
+func TestPebbleDBBatch(t *testing.T) {
+	db, err := NewMem()
+	assert.Nil(t, err)
+	defer db.Close()
+
+	batch := db.NewBatch()
+	for _, k := range []string{"a1", "a2", "b1", "b2", "c1"} {
+		assert.Nil(t, batch.Put([]byte(k), []byte(k)))
+	}
+	assert.Equal(t, 5, batch.Len())
+
+	// not visible before written
+	_, err = db.Get([]byte("a1"))
+	assert.True(t, db.IsNotFound(err))
+	assert.Nil(t, batch.Write())
+
+	batch = batch.NewBatch()
+	assert.Nil(t, batch.Delete([]byte("b1")))
+	assert.Nil(t, batch.Write())
+
+	iterate := func(r kv.Range) (keys []string) {
+		it := db.NewIterator(r)
+		defer it.Release()
+		for it.Next() {
+			assert.Equal(t, it.Key(), it.Value())
+			keys = append(keys, string(it.Key()))
+		}
+		assert.Nil(t, it.Error())
+		return
+	}
+	assert.Equal(t, []string{"a1", "a2", "b2", "c1"}, iterate(kv.Range{}))
+	assert.Equal(t, []string{"b2"}, iterate(*kv.NewRangeWithBytesPrefix([]byte("b"))))
+	assert.Equal(t, []string{"a2", "b2"}, iterate(kv.Range{From: []byte("a2"), To: []byte("c1")}))
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package runtime
+
+import (
+	"log/slog"
+
+	"github.com/meterio/meter-pov/meter"
+)
+
+// EnforceTeslaFork12_StakingStorage migrates staking buckets and candidates to per entry storage,
+// it's done once at the first clause executed after fork12 activates, script engine clauses included.
+func (rt *Runtime) EnforceTeslaFork12_StakingStorage() {
+	blockNumber := rt.Context().Number
+	if blockNumber == 0 || !meter.IsTeslaFork12(blockNumber) || rt.State().IsStakingEntryLayout() {
+		return
+	}
+
+	log := slog.With("pkg", "fork12")
+	log.Info("Start fork12 staking storage migration")
+	rt.State().MigrateStakingStorage()
+	log.Info("Finished fork12 staking storage migration",
+		"buckets", rt.State().GetBucketList().Len(), "candidates", rt.State().GetCandidateList().Len())
+}
@@ -62,6 +62,22 @@ func ResolveTransaction(tx *tx.Transaction) (*ResolvedTransaction, error) {
 	}, nil
 }
 
+// IntrinsicGasAt returns intrinsic gas of the tx executed in the block of given number,
+// which includes the access list gas since fork13.
+func (r *ResolvedTransaction) IntrinsicGasAt(blockNum uint32) (uint64, error) {
+	if !meter.IsTeslaFork13(blockNum) {
+		return r.IntrinsicGas, nil
+	}
+	gas, overflow := math.SafeAdd(r.IntrinsicGas, r.tx.AccessListGas())
+	if overflow {
+		return 0, errors.New("intrinsic gas overflow")
+	}
+	if r.tx.Gas() < gas {
+		return 0, fmt.Errorf("intrinsic gas (%d) exceeds provided gas (%d)", gas, r.tx.Gas())
+	}
+	return gas, nil
+}
+
 // CommonTo returns common 'To' field of clauses if any.
 // Nil returned if no common 'To'.
 func (r *ResolvedTransaction) CommonTo() *meter.Address {
@@ -155,5 +171,6 @@ func (r *ResolvedTransaction) ToContext(gasPrice *big.Int, blockNumber uint32, g
 		BlockRef:   r.tx.BlockRef(),
 		Expiration: r.tx.Expiration(),
 		Nonce:      r.tx.Nonce(),
+		AccessList: r.tx.AccessList(),
 	}
 }
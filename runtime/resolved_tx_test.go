@@ -6,16 +6,20 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/meterio/meter-pov/builtin"
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/genesis"
 	"github.com/meterio/meter-pov/lvldb"
 	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/params"
 	"github.com/meterio/meter-pov/runtime"
 	"github.com/meterio/meter-pov/state"
 	"github.com/meterio/meter-pov/tx"
+	"github.com/meterio/meter-pov/vm"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -99,6 +103,44 @@ func (tr *testResolvedTransaction) TestResolveTransaction() {
 	tr.assert.Nil(err)
 }
 
+func (tr *testResolvedTransaction) TestIntrinsicGasAt() {
+	meter.InitBlockChainConfig("main")
+	_, _, _, berlin := meter.EthForkHeights()
+
+	chainID := big.NewInt(83)
+	to := common.Address{1}
+	ethTx, err := types.SignTx(types.NewTx(&types.AccessListTx{
+		ChainID:  chainID,
+		Gas:      30000,
+		GasPrice: big.NewInt(1),
+		To:       &to,
+		Value:    big.NewInt(0),
+		AccessList: types.AccessList{
+			{Address: to, StorageKeys: []common.Hash{{1}, {2}}},
+		},
+	}), types.NewLondonSigner(chainID), genesis.DevAccounts()[0].PrivateKey)
+	tr.assert.Nil(err)
+	trx, err := tx.NewTransactionFromEthTx(ethTx, tr.chain.Tag(), tx.NewBlockRef(berlin), false)
+	tr.assert.Nil(err)
+	resolved, err := runtime.ResolveTransaction(trx)
+	tr.assert.Nil(err)
+
+	// the access list is charged and warmed from the same block on
+	config := &vm.ChainConfig{BerlinBlock: big.NewInt(int64(berlin))}
+
+	tr.assert.False(meter.IsTeslaFork13(berlin - 1))
+	tr.assert.False(config.IsBerlin(big.NewInt(int64(berlin - 1))))
+	gas, err := resolved.IntrinsicGasAt(berlin - 1)
+	tr.assert.Nil(err)
+	tr.assert.Equal(uint64(21000), gas)
+
+	tr.assert.True(meter.IsTeslaFork13(berlin))
+	tr.assert.True(config.IsBerlin(big.NewInt(int64(berlin))))
+	gas, err = resolved.IntrinsicGasAt(berlin)
+	tr.assert.Nil(err)
+	tr.assert.Equal(uint64(21000+params.TxAccessListAddressGas+2*params.TxAccessListStorageKeyGas), gas)
+}
+
 func (tr *testResolvedTransaction) TestCommonTo() {
 
 	txBuild := func() *tx.Builder {
@@ -77,6 +77,7 @@ var chainConfig = vm.ChainConfig{
 	IstanbulBlock: big.NewInt(0),
 	LondonBlock:   big.NewInt(0),
 	ParisBlock:    big.NewInt(0),
+	BerlinBlock:   big.NewInt(0),
 	LastPowNonce:  uint64(0),
 }
 
@@ -89,6 +90,8 @@ type Output struct {
 	RefundGas       uint64         `json:"refundGas"`
 	VMErr           error          `json:"vmErr"`           // VMErr identify the execution result of the contract function, not evm function's err.
 	ContractAddress *meter.Address `json:"contractAddress"` // if create a new contract, or is nil.
+
+	StakingRecords tx.StakingRecords `json:"-"` // staking changes made by script engine
 }
 
 func (o *Output) String() string {
@@ -113,6 +116,8 @@ type Runtime struct {
 	ctx        *xenv.BlockContext
 	forkConfig meter.ForkConfig
 	logger     *slog.Logger
+
+	scriptEngineTime time.Duration // total time spent in script engine
 }
 
 // copied over from transaction.go:GasPrice
@@ -147,20 +152,19 @@ func New(
 	// 	panic(err)
 	// }
 	chainConfig.BaseFee = baseFee
-	if meter.IsMainNet() == true {
-		chainConfig.ChainID = new(big.Int).SetUint64(meter.MainnetChainID)
-		chainConfig.IstanbulBlock = big.NewInt(int64(meter.TeslaFork3_MainnetStartNum))
-		chainConfig.LondonBlock = big.NewInt(int64(meter.TeslaFork9_MainnetStartNum))
-		chainConfig.ParisBlock = big.NewInt(int64(meter.TeslaFork11_MainnetStartNum))
-	} else {
-		chainConfig.ChainID = new(big.Int).SetUint64(meter.TestnetChainID)
-		chainConfig.IstanbulBlock = big.NewInt(int64(meter.TeslaFork3_TestnetStartNum))
-		chainConfig.LondonBlock = big.NewInt(int64(meter.TeslaFork9_TestnetStartNum))
-		chainConfig.ParisBlock = big.NewInt(int64(meter.TeslaFork11_TestnetStartNum))
-	}
+	istanbul, london, paris, berlin := meter.EthForkHeights()
+	chainConfig.ChainID = new(big.Int).SetUint64(meter.ChainID())
+	chainConfig.IstanbulBlock = big.NewInt(int64(istanbul))
+	chainConfig.LondonBlock = big.NewInt(int64(london))
+	chainConfig.ParisBlock = big.NewInt(int64(paris))
+	chainConfig.BerlinBlock = big.NewInt(int64(berlin))
 
 	// alloc precompiled contracts at the begining of Istanbul
-	if meter.TeslaFork3_MainnetStartNum == ctx.Number {
+	istanbulAllocNum := uint32(meter.TeslaFork3_MainnetStartNum)
+	if meter.IsCustomNet() {
+		istanbulAllocNum = istanbul
+	}
+	if istanbulAllocNum == ctx.Number {
 		for addr := range vm.PrecompiledContractsIstanbul {
 			state.SetCode(meter.Address(addr), EmptyRuntimeBytecode)
 		}
@@ -169,6 +173,12 @@ func New(
 			state.SetCode(meter.Address(addr), EmptyRuntimeBytecode)
 		}
 	}
+	// alloc precompiled contracts at the begining of Berlin
+	if berlin == ctx.Number {
+		for addr := range vm.PrecompiledContractsTeslaFork13 {
+			state.SetCode(meter.Address(addr), EmptyRuntimeBytecode)
+		}
+	}
 
 	rt := Runtime{
 		seeker: seeker,
@@ -188,6 +198,9 @@ func New(
 func (rt *Runtime) Seeker() *chain.Seeker       { return rt.seeker }
 func (rt *Runtime) State() *state.State         { return rt.state }
 func (rt *Runtime) Context() *xenv.BlockContext { return rt.ctx }
+
+// ScriptEngineTime returns total time spent in script engine by clauses executed so far.
+func (rt *Runtime) ScriptEngineTime() time.Duration { return rt.scriptEngineTime }
 func (rt *Runtime) ScriptEngineCheck(d []byte) bool {
 	return ScriptEngineCheck(d)
 }
@@ -941,6 +954,9 @@ func (rt *Runtime) PrepareClause(
 
 	log := rt.logger
 	exec = func() (*Output, bool) {
+		// tesla fork12 per entry staking storage, migrated before any clause reads staking state
+		rt.EnforceTeslaFork12_StakingStorage()
+
 		// does not handle any transfer, it is a pure script running engine
 		if (clause.Value().Sign() == 0) && (len(clause.Data()) > MinScriptEngDataLen) && rt.ScriptEngineCheck(clause.Data()) {
 			se := script.GetScriptGlobInst()
@@ -951,7 +967,9 @@ func (rt *Runtime) PrepareClause(
 			// exclude 4 bytes of clause data
 			// fmt.Println("Exec Clause: ", hex.EncodeToString(clause.Data()))
 			senv := setypes.NewScriptEnv(rt.state, rt.ctx, txCtx, clauseIndex)
+			seStart := time.Now()
 			seOutput, leftOverGas, vmErr = se.HandleScriptData(senv, clause.Data()[4:], clause.To(), gas)
+			rt.scriptEngineTime += time.Since(seStart)
 			// fmt.Println("scriptEngine handling return", data, leftOverGas, vmErr)
 
 			var data []byte
@@ -969,6 +987,7 @@ func (rt *Runtime) PrepareClause(
 			if seOutput != nil {
 				output.Events = seOutput.GetEvents()
 				output.Transfers = seOutput.GetTransfers()
+				output.StakingRecords = seOutput.GetStakingRecords()
 			}
 			if output.VMErr != nil {
 				log.Info("Output with vmerr from script engine:", "vmerr", output.VMErr.Error())
@@ -1019,6 +1038,11 @@ func (rt *Runtime) PrepareClause(
 			return output, false
 		}
 
+		// warm accounts and slots of EIP-2929 and EIP-2930
+		if evm.ChainConfig().IsBerlin(evm.BlockNumber) {
+			stateDB.PrepareAccessList(common.Address(txCtx.Origin), (*common.Address)(clause.To()), evm.ActivePrecompiles(), txCtx.AccessList)
+		}
+
 		if clause.To() == nil {
 			var caddr common.Address
 			data, caddr, leftOverGas, vmErr = evm.Create(vm.AccountRef(txCtx.Origin), clause.Data(), gas, clause.Value(), clause.Token())
@@ -1110,6 +1134,10 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 	if err != nil {
 		return nil, err
 	}
+	intrinsicGas, err := resolvedTx.IntrinsicGasAt(rt.ctx.Number)
+	if err != nil {
+		return nil, err
+	}
 	resolveElapsed := time.Since(resolveStart)
 
 	buyGasStart := time.Now()
@@ -1120,8 +1148,8 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 	buyGasElapsed := time.Since(buyGasStart)
 
 	ckpointStart := time.Now()
-	// ResolveTransaction has checked that tx.Gas() >= IntrinsicGas
-	leftOverGas := tx.Gas() - resolvedTx.IntrinsicGas
+	// IntrinsicGasAt has checked that tx.Gas() >= intrinsicGas
+	leftOverGas := tx.Gas() - intrinsicGas
 
 	// checkpoint to be reverted when clause failure.
 	checkpoint := rt.state.NewCheckpoint()
@@ -1173,7 +1201,7 @@ func (rt *Runtime) PrepareTransaction(tx *tx.Transaction) (*TransactionExecutor,
 				txOutputs = nil
 				return
 			}
-			txOutputs = append(txOutputs, &Tx.Output{Events: output.Events, Transfers: output.Transfers})
+			txOutputs = append(txOutputs, &Tx.Output{Events: output.Events, Transfers: output.Transfers, StakingRecords: output.StakingRecords})
 			return
 		},
 		Finalize: func() (*Tx.Receipt, error) {
@@ -38,6 +38,13 @@ type (
 	eventKey       struct{}
 	transferKey    struct{}
 	stateRevKey    struct{}
+
+	// access list of EIP-2929, which is journaled with the snapshots
+	accessAddressKey common.Address
+	accessSlotKey    struct {
+		addr common.Address
+		slot common.Hash
+	}
 )
 
 // New create a statedb object.
@@ -48,6 +55,8 @@ func New(state *_state.State) *StateDB {
 			return false, true
 		case refundKey:
 			return uint64(0), true
+		case accessAddressKey, accessSlotKey:
+			return false, true
 		}
 		panic(fmt.Sprintf("unknown type of key %+v", k))
 	}
@@ -301,6 +310,51 @@ func (s *StateDB) RevertToSnapshot(rev int) {
 	}
 }
 
+// PrepareAccessList warms the sender, the destination, the precompiles and the
+// entries of the tx access list before executing a clause, as EIP-2929 and EIP-2930 require.
+func (s *StateDB) PrepareAccessList(sender common.Address, dst *common.Address, precompiles []common.Address, list types.AccessList) {
+	s.AddAddressToAccessList(sender)
+	if dst != nil {
+		s.AddAddressToAccessList(*dst)
+	}
+	for _, addr := range precompiles {
+		s.AddAddressToAccessList(addr)
+	}
+	for _, el := range list {
+		s.AddAddressToAccessList(el.Address)
+		for _, key := range el.StorageKeys {
+			s.AddSlotToAccessList(el.Address, key)
+		}
+	}
+}
+
+// AddressInAccessList returns whether the address is in the access list.
+func (s *StateDB) AddressInAccessList(addr common.Address) bool {
+	v, _ := s.repo.Get(accessAddressKey(addr))
+	return v.(bool)
+}
+
+// SlotInAccessList returns whether the address and the slot are in the access list.
+func (s *StateDB) SlotInAccessList(addr common.Address, slot common.Hash) (addressOk bool, slotOk bool) {
+	v, _ := s.repo.Get(accessSlotKey{addr, slot})
+	return s.AddressInAccessList(addr), v.(bool)
+}
+
+// AddAddressToAccessList adds the address to the access list.
+func (s *StateDB) AddAddressToAccessList(addr common.Address) {
+	if !s.AddressInAccessList(addr) {
+		s.repo.Put(accessAddressKey(addr), true)
+	}
+}
+
+// AddSlotToAccessList adds the address and the slot to the access list.
+func (s *StateDB) AddSlotToAccessList(addr common.Address, slot common.Hash) {
+	s.AddAddressToAccessList(addr)
+	if _, slotOk := s.SlotInAccessList(addr, slot); !slotOk {
+		s.repo.Put(accessSlotKey{addr, slot}, true)
+	}
+}
+
 func ethlogToEvent(ethlog *types.Log) *tx.Event {
 	var topics []meter.Bytes32
 	if len(ethlog.Topics) > 0 {
@@ -127,6 +127,19 @@ func newTestAction(addr common.Address, r *rand.Rand) testAction {
 			},
 			args: make([]int64, 1),
 		},
+		{
+			name: "AddAddressToAccessList",
+			fn: func(a testAction, s *statedb.StateDB) {
+				s.AddAddressToAccessList(addr)
+			},
+		},
+		{
+			name: "AddSlotToAccessList",
+			fn: func(a testAction, s *statedb.StateDB) {
+				s.AddSlotToAccessList(addr, common.Hash{byte(a.args[0])})
+			},
+			args: make([]int64, 1),
+		},
 	}
 	action := actions[r.Intn(len(actions))]
 	var nameargs []string
@@ -233,6 +246,12 @@ func (test *snapshotTest) checkEqual(state, checkstate *statedb.StateDB) error {
 		checkeq("GetCode", state.GetCode(addr), checkstate.GetCode(addr))
 		checkeq("GetCodeHash", state.GetCodeHash(addr), checkstate.GetCodeHash(addr))
 		checkeq("GetCodeSize", state.GetCodeSize(addr), checkstate.GetCodeSize(addr))
+		checkeq("AddressInAccessList", state.AddressInAccessList(addr), checkstate.AddressInAccessList(addr))
+		for i := 0; i < 100; i++ {
+			_, slotOk := state.SlotInAccessList(addr, common.Hash{byte(i)})
+			_, checkSlotOk := checkstate.SlotInAccessList(addr, common.Hash{byte(i)})
+			checkeq("SlotInAccessList", slotOk, checkSlotOk)
+		}
 
 		if err != nil {
 			return err
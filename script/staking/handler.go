@@ -76,6 +76,12 @@ func (s *Staking) Handle(senv *setypes.ScriptEnv, payload []byte, to *meter.Addr
 	}
 
 	s.logger.Debug("Entering staking handler "+GetOpName(sb.Opcode), "tx", senv.GetTxHash())
+	var recorder *stakingRecorder
+	if isRecordedOp(sb.Opcode) {
+		recorder = newStakingRecorder(GetOpName(sb.Opcode))
+		senv.GetState().WatchStaking(recorder)
+		defer senv.GetState().WatchStaking(nil)
+	}
 	switch sb.Opcode {
 	case OP_BOUND:
 		if senv.GetTxOrigin() != sb.HolderAddr {
@@ -161,6 +167,9 @@ func (s *Staking) Handle(senv *setypes.ScriptEnv, payload []byte, to *meter.Addr
 		return nil, gas, errors.New("unknow staking opcode")
 	}
 	s.logger.Debug("Leaving script handler for operation", "op", GetOpName(sb.Opcode))
+	if recorder != nil && err == nil {
+		senv.AddStakingRecords(recorder.records)
+	}
 
 	seOutput = senv.GetOutput()
 	return
@@ -16,8 +16,6 @@ func (s *Staking) BoundHandler(env *setypes.ScriptEnv, sb *StakingBody, gas uint
 		env.SetReturnData(ret)
 	}()
 	state := env.GetState()
-	candidateList := state.GetCandidateList()
-	bucketList := state.GetBucketList()
 	stakeholderList := state.GetStakeHolderList()
 
 	if gas < meter.ClauseGas {
@@ -43,23 +41,25 @@ func (s *Staking) BoundHandler(env *setypes.ScriptEnv, sb *StakingBody, gas uint
 	number := env.GetBlockNum()
 	// check if candidate exists or not
 	setCand := !sb.CandAddr.IsZero()
+	var cand *meter.Candidate
 	if setCand {
-		c := candidateList.Get(sb.CandAddr)
-		if c == nil {
+		cand = state.GetCandidate(sb.CandAddr)
+		if cand == nil {
 			s.logger.Warn("candidate is not listed", "address", sb.CandAddr)
 			setCand = false
 		} else {
+			bucketList := candidateBuckets(state, cand)
 			selfRatioValid := false
 			if meter.IsTeslaFork8(number) {
-				selfRatioValid = CorrectCheckEnoughSelfVotes(c, bucketList, meter.TESLA1_1_SELF_VOTE_RATIO, nil, nil, sb.Amount, nil)
+				selfRatioValid = CorrectCheckEnoughSelfVotes(cand, bucketList, meter.TESLA1_1_SELF_VOTE_RATIO, nil, nil, sb.Amount, nil)
 			} else if meter.IsTeslaFork1(number) {
-				selfRatioValid = CheckCandEnoughSelfVotes(sb.Amount, c, bucketList, meter.TESLA1_1_SELF_VOTE_RATIO)
+				selfRatioValid = CheckCandEnoughSelfVotes(sb.Amount, cand, bucketList, meter.TESLA1_1_SELF_VOTE_RATIO)
 			} else {
-				selfRatioValid = CheckCandEnoughSelfVotes(sb.Amount, c, bucketList, meter.TESLA1_0_SELF_VOTE_RATIO)
+				selfRatioValid = CheckCandEnoughSelfVotes(sb.Amount, cand, bucketList, meter.TESLA1_0_SELF_VOTE_RATIO)
 			}
 			if selfRatioValid == false {
 				s.logger.Error(errCandidateNotEnoughSelfVotes.Error(), "candidate",
-					c.Addr.String(), "error", errCandidateNotEnoughSelfVotes)
+					cand.Addr.String(), "error", errCandidateNotEnoughSelfVotes)
 				setCand = false
 			}
 		}
@@ -107,7 +107,7 @@ func (s *Staking) BoundHandler(env *setypes.ScriptEnv, sb *StakingBody, gas uint
 	}
 	bucket := meter.NewBucket(sb.HolderAddr, candAddr, sb.Amount, uint8(sb.Token), opt, rate, sb.Autobid, ts, nonce)
 	env.GetTxCtx().Inc()
-	bucketList.Add(bucket)
+	state.SetBucket(bucket)
 
 	stakeholder := stakeholderList.Get(sb.HolderAddr)
 	if stakeholder == nil {
@@ -119,13 +119,8 @@ func (s *Staking) BoundHandler(env *setypes.ScriptEnv, sb *StakingBody, gas uint
 	}
 
 	if setCand {
-		cand := candidateList.Get(sb.CandAddr)
-		if cand == nil {
-			err = errCandidateNotListed
-			s.logger.Error("Errors", "error", err)
-			return
-		}
 		cand.AddBucket(bucket)
+		state.SetCandidate(cand)
 	}
 
 	switch sb.Token {
@@ -142,8 +137,6 @@ func (s *Staking) BoundHandler(env *setypes.ScriptEnv, sb *StakingBody, gas uint
 		env.AddNativeBucketOpenEvent(bucket.Owner, bucket.BucketID, sb.Amount, sb.Token)
 	}
 
-	state.SetCandidateList(candidateList)
-	state.SetBucketList(bucketList)
 	state.SetStakeHolderList(stakeholderList)
 	return
 }
@@ -16,9 +16,6 @@ func (s *Staking) DelegateHandler(env *setypes.ScriptEnv, sb *StakingBody, gas u
 		env.SetReturnData(ret)
 	}()
 	state := env.GetState()
-	candidateList := state.GetCandidateList()
-	bucketList := state.GetBucketList()
-	stakeholderList := state.GetStakeHolderList()
 
 	if gas < meter.ClauseGas {
 		leftOverGas = 0
@@ -26,7 +23,7 @@ func (s *Staking) DelegateHandler(env *setypes.ScriptEnv, sb *StakingBody, gas u
 		leftOverGas = gas - meter.ClauseGas
 	}
 
-	b := bucketList.Get(sb.StakingID)
+	b := state.GetBucket(sb.StakingID)
 	if b == nil {
 		return leftOverGas, errBucketNotFound
 	}
@@ -53,12 +50,13 @@ func (s *Staking) DelegateHandler(env *setypes.ScriptEnv, sb *StakingBody, gas u
 		return
 	}
 
-	cand := candidateList.Get(sb.CandAddr)
+	cand := state.GetCandidate(sb.CandAddr)
 	if cand == nil {
 		return leftOverGas, errBucketNotFound
 	}
 
 	number := env.GetBlockNum()
+	bucketList := candidateBuckets(state, cand)
 	selfRatioValid := false
 	if meter.IsTeslaFork8(number) {
 		selfRatioValid = CorrectCheckEnoughSelfVotes(cand, bucketList, meter.TESLA1_1_SELF_VOTE_RATIO, nil, nil, b.Value, nil)
@@ -82,8 +80,7 @@ func (s *Staking) DelegateHandler(env *setypes.ScriptEnv, sb *StakingBody, gas u
 	b.Autobid = sb.Autobid
 	cand.AddBucket(b)
 
-	state.SetCandidateList(candidateList)
-	state.SetBucketList(bucketList)
-	state.SetStakeHolderList(stakeholderList)
+	state.SetBucket(b)
+	state.SetCandidate(cand)
 	return
 }
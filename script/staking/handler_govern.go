@@ -11,6 +11,7 @@ import (
 	"github.com/meterio/meter-pov/builtin"
 	"github.com/meterio/meter-pov/meter"
 	setypes "github.com/meterio/meter-pov/script/types"
+	"github.com/meterio/meter-pov/tx"
 )
 
 func (s *Staking) distributeValidatorRewards(env *setypes.ScriptEnv, sb *StakingBody, candidateList *meter.CandidateList, inJailList *meter.InJailList) {
@@ -53,7 +54,8 @@ func (s *Staking) distributeValidatorRewards(env *setypes.ScriptEnv, sb *Staking
 	state.SetValidatorRewardList(rewardList)
 }
 
-func (s *Staking) distributeAndAutobidAfterTeslaFork6(env *setypes.ScriptEnv, sb *StakingBody, candidateList *meter.CandidateList, inJailList *meter.InJailList) {
+// distributeAndAutobidAfterTeslaFork6 returns records of the autobids made.
+func (s *Staking) distributeAndAutobidAfterTeslaFork6(env *setypes.ScriptEnv, sb *StakingBody, candidateList *meter.CandidateList, inJailList *meter.InJailList) (autobids tx.StakingRecords) {
 	state := env.GetState()
 	rewardList := state.GetValidatorRewardList()
 	riV2s := []*meter.RewardInfoV2{}
@@ -133,10 +135,12 @@ func (s *Staking) distributeAndAutobidAfterTeslaFork6(env *setypes.ScriptEnv, sb
 				err = errNotEnoughMTR
 				return
 			}
+			autobids = append(autobids, newAutobidRecord(a.Address, a.Amount))
 		}
 
 	}
 	state.SetAuctionCB(auctionCB)
+	return
 }
 
 func (s *Staking) calcDelegates(env *setypes.ScriptEnv, bucketList *meter.BucketList, candidateList *meter.CandidateList, inJailList *meter.InJailList) {
@@ -358,8 +362,9 @@ func (s *Staking) GoverningHandler(env *setypes.ScriptEnv, sb *StakingBody, gas
 	}
 
 	number := env.GetBlockNum()
+	var autobids tx.StakingRecords
 	if meter.IsTeslaFork6(number) {
-		s.distributeAndAutobidAfterTeslaFork6(env, sb, candidateList, inJailList)
+		autobids = s.distributeAndAutobidAfterTeslaFork6(env, sb, candidateList, inJailList)
 	} else {
 		s.distributeValidatorRewards(env, sb, candidateList, inJailList)
 	}
@@ -395,6 +400,9 @@ func (s *Staking) GoverningHandler(env *setypes.ScriptEnv, sb *StakingBody, gas
 	state.SetCandidateList(candidateList)
 	state.SetBucketList(bucketList)
 	state.SetStakeHolderList(stakeholderList)
+	if err == nil {
+		env.AddStakingRecords(autobids)
+	}
 
 	//s.logger.Info("After Governing, new delegate list calculated", "members", delegateList.Members())
 	// fmt.Println(delegateList.ToString())
@@ -14,9 +14,6 @@ func (s *Staking) UnBoundHandler(env *setypes.ScriptEnv, sb *StakingBody, gas ui
 		env.SetReturnData(ret)
 	}()
 	state := env.GetState()
-	candidateList := state.GetCandidateList()
-	bucketList := state.GetBucketList()
-	stakeholderList := state.GetStakeHolderList()
 
 	if gas < meter.ClauseGas {
 		leftOverGas = 0
@@ -24,7 +21,7 @@ func (s *Staking) UnBoundHandler(env *setypes.ScriptEnv, sb *StakingBody, gas ui
 		leftOverGas = gas - meter.ClauseGas
 	}
 
-	b := bucketList.Get(sb.StakingID)
+	b := state.GetBucket(sb.StakingID)
 	if b == nil {
 		return leftOverGas, errBucketNotFound
 	}
@@ -61,8 +58,6 @@ func (s *Staking) UnBoundHandler(env *setypes.ScriptEnv, sb *StakingBody, gas ui
 		env.AddNativeBucketCloseEvent(b.Owner, b.BucketID)
 	}
 
-	state.SetCandidateList(candidateList)
-	state.SetBucketList(bucketList)
-	state.SetStakeHolderList(stakeholderList)
+	state.SetBucket(b)
 	return
 }
@@ -0,0 +1,95 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package staking
+
+import (
+	"math/big"
+
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/tx"
+)
+
+// isRecordedOp returns whether changes made by the op are recorded for staking history.
+func isRecordedOp(op uint32) bool {
+	switch op {
+	case OP_BOUND, OP_UNBOUND, OP_CANDIDATE, OP_UNCANDIDATE, OP_DELEGATE, OP_UNDELEGATE,
+		OP_CANDIDATE_UPDT, OP_BUCKET_UPDT, OP_GOVERNING:
+		return true
+	}
+	return false
+}
+
+// newAutobidRecord returns the record of rewards autobid for the bidder, autobids don't change buckets or
+// candidates, so they're recorded on their own instead of being watched.
+func newAutobidRecord(bidder meter.Address, amount *big.Int) *tx.StakingRecord {
+	return &tx.StakingRecord{
+		Op:         tx.AutobidOp,
+		Candidate:  bidder,
+		Value:      copyBig(amount),
+		TotalVotes: new(big.Int),
+	}
+}
+
+// stakingRecorder records buckets and candidates written by a staking op, it watches the state while
+// the op runs. An entry written more than once by the op is recorded once, with the last value.
+type stakingRecorder struct {
+	op      string
+	records tx.StakingRecords
+	buckets map[meter.Bytes32]int // index of bucket records
+	cands   map[meter.Address]int // index of candidate records
+}
+
+func newStakingRecorder(op string) *stakingRecorder {
+	return &stakingRecorder{
+		op:      op,
+		records: make(tx.StakingRecords, 0),
+		buckets: make(map[meter.Bytes32]int),
+		cands:   make(map[meter.Address]int),
+	}
+}
+
+func copyBig(v *big.Int) *big.Int {
+	if v == nil {
+		return nil
+	}
+	return new(big.Int).Set(v)
+}
+
+func (r *stakingRecorder) BucketChanged(b *meter.Bucket, removed bool) {
+	// values are copied, as the op may change the bucket after it's written
+	record := &tx.StakingRecord{
+		Op:         r.op,
+		BucketID:   b.BucketID,
+		Owner:      b.Owner,
+		Candidate:  b.Candidate,
+		Value:      copyBig(b.Value),
+		TotalVotes: copyBig(b.TotalVotes),
+		Autobid:    b.Autobid,
+		Unbounded:  b.Unbounded,
+		Removed:    removed,
+	}
+	if i, ok := r.buckets[b.BucketID]; ok {
+		r.records[i] = record
+		return
+	}
+	r.buckets[b.BucketID] = len(r.records)
+	r.records = append(r.records, record)
+}
+
+func (r *stakingRecorder) CandidateChanged(c *meter.Candidate, removed bool) {
+	record := &tx.StakingRecord{
+		Op:         r.op,
+		Candidate:  c.Addr,
+		TotalVotes: copyBig(c.TotalVotes),
+		Removed:    removed,
+	}
+	if i, ok := r.cands[c.Addr]; ok {
+		r.records[i] = record
+		return
+	}
+	r.cands[c.Addr] = len(r.records)
+	r.records = append(r.records, record)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package staking
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	setypes "github.com/meterio/meter-pov/script/types"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/meterio/meter-pov/xenv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStakingRecorder(t *testing.T) {
+	for _, entryLayout := range []bool{false, true} {
+		db, _ := lvldb.NewMem()
+		st, _ := state.New(meter.Bytes32{}, db)
+		if entryLayout {
+			st.MigrateStakingStorage()
+		}
+
+		owner := meter.BytesToAddress([]byte("owner"))
+		cand := meter.BytesToAddress([]byte("cand"))
+		b1 := meter.NewBucket(owner, cand, big.NewInt(100), meter.MTRG, 0, 0, 0, 1, 1)
+		b2 := meter.NewBucket(owner, cand, big.NewInt(200), meter.MTRG, 0, 0, 0, 1, 2)
+		st.SetBucketList(meter.NewBucketList([]*meter.Bucket{b1, b2}))
+		st.SetCandidateList(meter.NewCandidateList([]*meter.Candidate{
+			meter.NewCandidate(cand, []byte("cand"), nil, nil, nil, 0, 0, 0),
+		}))
+
+		recorder := newStakingRecorder("bound")
+		st.WatchStaking(recorder)
+		st.SetBucketList(st.GetBucketList())
+		st.SetCandidateList(st.GetCandidateList())
+		assert.Equal(t, 0, len(recorder.records), "unchanged entries are not recorded")
+
+		// b1 updated twice, b2 removed, b3 added and candidate untouched
+		recorder = newStakingRecorder("bucketUpdate")
+		st.WatchStaking(recorder)
+		buckets := st.GetBucketList()
+		buckets.Get(b1.BucketID).Value = big.NewInt(150)
+		st.SetBucketList(buckets)
+		buckets.Get(b1.BucketID).Value = big.NewInt(160)
+		buckets.Remove(b2.BucketID)
+		b3 := meter.NewBucket(owner, cand, big.NewInt(300), meter.MTRG, 0, 0, 0, 1, 3)
+		buckets.Add(b3)
+		st.SetBucketList(buckets)
+		st.SetCandidateList(st.GetCandidateList())
+		st.WatchStaking(nil)
+		assert.Nil(t, st.Err())
+
+		assert.Equal(t, 3, len(recorder.records))
+		removed := make(map[meter.Bytes32]bool)
+		for _, r := range recorder.records {
+			assert.Equal(t, "bucketUpdate", r.Op)
+			assert.False(t, r.IsCandidate())
+			removed[r.BucketID] = r.Removed
+			if r.BucketID == b1.BucketID {
+				assert.Equal(t, big.NewInt(160), r.Value)
+			}
+			if r.BucketID == b2.BucketID {
+				assert.Equal(t, b2.Value, r.Value)
+			}
+		}
+		assert.Equal(t, map[meter.Bytes32]bool{b1.BucketID: false, b2.BucketID: true, b3.BucketID: false}, removed)
+	}
+}
+
+func TestAutobidRecords(t *testing.T) {
+	meter.InitBlockChainConfig("main")
+	db, _ := lvldb.NewMem()
+	st, _ := state.New(meter.Bytes32{}, db)
+
+	bidder := meter.BytesToAddress([]byte("bidder"))
+	st.SetAuctionCB(&meter.AuctionCB{AuctionID: meter.BytesToBytes32([]byte("auction")), RcvdMTR: new(big.Int)})
+	st.AddEnergy(meter.ValidatorBenefitAddr, big.NewInt(1000))
+
+	extra, _ := rlp.EncodeToBytes([]*meter.RewardInfoV2{
+		{Address: bidder, DistAmount: new(big.Int), AutobidAmount: big.NewInt(300)},
+	})
+	payload, _ := rlp.EncodeToBytes(&StakingBody{Opcode: OP_GOVERNING, ExtraData: extra})
+	blockCtx := &xenv.BlockContext{Number: meter.TeslaFork7_MainnetStartNum + 1, Time: 1}
+	senv := setypes.NewScriptEnv(st, blockCtx, &xenv.TransactionContext{}, 0)
+
+	_, _, err := NewStaking(nil, nil).Handle(senv, payload, &meter.StakingModuleAddr, meter.ClauseGas)
+	assert.Nil(t, err)
+	assert.Nil(t, st.Err())
+
+	// autobids are recorded on their own, not as candidate changes
+	records := senv.GetOutput().GetStakingRecords()
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, tx.AutobidOp, records[0].Op)
+	assert.True(t, records[0].IsAutobid())
+	assert.False(t, records[0].IsCandidate())
+	assert.Equal(t, bidder, records[0].Candidate)
+	assert.Equal(t, big.NewInt(300), records[0].Value)
+}
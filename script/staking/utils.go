@@ -51,6 +51,21 @@ var (
 	errCandidateNotEnoughSelfVotes = errors.New("candidate's accumulated votes > 100x candidate's own vote")
 )
 
+// candidateBuckets returns buckets to check self votes of the candidate against. Buckets are stored per
+// entry since fork12, then only buckets of the candidate are read instead of the whole list.
+func candidateBuckets(st *state.State, c *meter.Candidate) *meter.BucketList {
+	if !st.IsStakingEntryLayout() {
+		return st.GetBucketList()
+	}
+	buckets := make([]*meter.Bucket, 0, len(c.Buckets))
+	for _, id := range c.Buckets {
+		if b := st.GetBucket(id); b != nil {
+			buckets = append(buckets, b)
+		}
+	}
+	return meter.NewBucketList(buckets)
+}
+
 // get the bucket that candidate initialized
 func GetCandidateBucket(c *meter.Candidate, bl *meter.BucketList) (*meter.Bucket, error) {
 	for _, id := range c.Buckets {
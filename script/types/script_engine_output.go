@@ -11,6 +11,8 @@ type ScriptEngineOutput struct {
 	data      []byte
 	transfers []*tx.Transfer
 	events    []*tx.Event
+
+	stakingRecords tx.StakingRecords
 }
 
 func NewScriptEngineOutput(data []byte) *ScriptEngineOutput {
@@ -72,3 +74,7 @@ func (o *ScriptEngineOutput) GetData() []byte {
 	}
 	return o.data
 }
+
+func (o *ScriptEngineOutput) GetStakingRecords() tx.StakingRecords {
+	return o.stakingRecords
+}
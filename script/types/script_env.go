@@ -36,6 +36,8 @@ type ScriptEnv struct {
 	returnData []byte
 	transfers  []*tx.Transfer
 	events     []*tx.Event
+
+	stakingRecords tx.StakingRecords
 }
 
 func NewScriptEnv(state *state.State, blockCtx *xenv.BlockContext, txCtx *xenv.TransactionContext, clauseIndex uint32) *ScriptEnv {
@@ -200,10 +202,17 @@ func (env *ScriptEnv) GetEvents() tx.Events {
 	return env.events
 }
 
+// AddStakingRecords records staking changes for staking history.
+func (env *ScriptEnv) AddStakingRecords(records tx.StakingRecords) {
+	env.stakingRecords = append(env.stakingRecords, records...)
+}
+
 func (env *ScriptEnv) GetOutput() *ScriptEngineOutput {
 	return &ScriptEngineOutput{
 		data:      env.GetReturnData(),
 		transfers: env.transfers,
 		events:    env.events,
+
+		stakingRecords: env.stakingRecords,
 	}
 }
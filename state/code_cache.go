@@ -29,9 +29,12 @@ func newCodeCache() *CodeCache {
 // to get a trie for writing, copy should be set to true
 func (c *CodeCache) Get(key []byte) ([]byte, error) {
 	skey := hex.EncodeToString(key)
-	if v, ok := c.cache.Get(skey); ok {
+	v, ok := c.cache.Get(skey)
+	if ok {
+		codeCacheHits.Inc()
 		return v.([]byte), nil
 	}
+	codeCacheMisses.Inc()
 	return make([]byte, 0), errors.New("not found")
 }
 
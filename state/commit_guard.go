@@ -0,0 +1,40 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/meterio/meter-pov/meter"
+)
+
+// number of recently committed roots remembered, far more than the blocks and drafts in flight
+const recentRootsLimit = 1024
+
+var (
+	// held shared by stage commits, and exclusively while stale nodes are deleted
+	commitLock sync.RWMutex
+	// a state is committed ahead of its block or draft being added to the chain,
+	// so roots of recent commits are kept by pruning as well
+	recentRoots, _ = lru.New(recentRootsLimit)
+)
+
+// LockCommits blocks state commits until the returned func is called.
+func LockCommits() (unlock func()) {
+	commitLock.Lock()
+	return commitLock.Unlock
+}
+
+// RecentRoots returns the roots of recently committed states.
+func RecentRoots() []meter.Bytes32 {
+	keys := recentRoots.Keys()
+	roots := make([]meter.Bytes32, 0, len(keys))
+	for _, key := range keys {
+		roots = append(roots, key.(meter.Bytes32))
+	}
+	return roots
+}
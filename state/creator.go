@@ -8,6 +8,7 @@ package state
 import (
 	"github.com/meterio/meter-pov/kv"
 	"github.com/meterio/meter-pov/meter"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 // Creator state creator to cut-off kv dependency.
@@ -17,6 +18,7 @@ type Creator struct {
 
 // NewCreator create a new state creator.
 func NewCreator(kv kv.GetPutter) *Creator {
+	prometheus.Register(cacheRequestsCounter)
 	return &Creator{kv}
 }
 
@@ -24,3 +26,13 @@ func NewCreator(kv kv.GetPutter) *Creator {
 func (c *Creator) NewState(root meter.Bytes32) (*State, error) {
 	return New(root, c.kv)
 }
+
+// Diff returns changed accounts and storage slots between two states.
+func (c *Creator) Diff(from, to meter.Bytes32, options *DiffOptions) ([]*AccountDiff, error) {
+	return Diff(c.kv, from, to, options)
+}
+
+// DiffStakingEntries returns buckets and candidates changed between two states in per entry layout.
+func (c *Creator) DiffStakingEntries(from, to meter.Bytes32) (*StakingEntryDiff, error) {
+	return DiffStakingEntries(c.kv, from, to)
+}
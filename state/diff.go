@@ -0,0 +1,283 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/trie"
+)
+
+// AccountDiff describes how an account changes between two states.
+type AccountDiff struct {
+	Address meter.Address
+	From    *Account // nil if the account does not exist in the from state
+	To      *Account // nil if the account does not exist in the to state
+	Storage []*StorageDiff
+
+	StorageTruncated bool // more storage slots changed than the storage limit
+}
+
+// StorageDiff describes how a storage slot changes, empty value means the slot is not set.
+type StorageDiff struct {
+	Key  meter.Bytes32
+	From rlp.RawValue
+	To   rlp.RawValue
+}
+
+// DiffOptions pages the changed accounts returned by Diff, zero limits mean no limit.
+type DiffOptions struct {
+	Offset       uint64 // number of changed accounts to skip
+	Limit        uint64 // max number of changed accounts
+	StorageLimit uint64 // max number of changed storage slots of each account
+}
+
+type leafDiff struct {
+	key  []byte // hashed key
+	from []byte
+	to   []byte
+}
+
+// Diff walks the state tries at from and to, and returns changed accounts and storage slots in order
+// of hashed address. Subtries shared by both states are skipped, and changes are streamed so only the
+// requested page is kept in memory. Options can be nil.
+func Diff(kv kv.GetPutter, from, to meter.Bytes32, options *DiffOptions) ([]*AccountDiff, error) {
+	if options == nil {
+		options = &DiffOptions{}
+	}
+	var (
+		diffs   []*AccountDiff
+		skipped uint64
+		err     error
+	)
+	walkErr := walkDiff(kv, from, to, func(leaf *leafDiff) bool {
+		if skipped < options.Offset {
+			skipped++
+			return true
+		}
+		var d *AccountDiff
+		if d, err = newAccountDiff(kv, leaf, options.StorageLimit); err != nil {
+			return false
+		}
+		diffs = append(diffs, d)
+		return options.Limit == 0 || uint64(len(diffs)) < options.Limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	if walkErr != nil {
+		return nil, walkErr
+	}
+	return diffs, nil
+}
+
+func newAccountDiff(kv kv.GetPutter, leaf *leafDiff, storageLimit uint64) (*AccountDiff, error) {
+	addr, err := kv.Get(leaf.key)
+	if err != nil {
+		return nil, err
+	}
+	d := &AccountDiff{Address: meter.BytesToAddress(addr)}
+	if d.From, err = decodeAccount(leaf.from); err != nil {
+		return nil, err
+	}
+	if d.To, err = decodeAccount(leaf.to); err != nil {
+		return nil, err
+	}
+	if d.Storage, d.StorageTruncated, err = diffStorage(kv, d.From, d.To, storageLimit); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func decodeAccount(data []byte) (*Account, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var a Account
+	if err := rlp.DecodeBytes(data, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func diffStorage(kv kv.GetPutter, from, to *Account, limit uint64) (diffs []*StorageDiff, truncated bool, err error) {
+	var fromRoot, toRoot meter.Bytes32
+	if from != nil {
+		fromRoot = meter.BytesToBytes32(from.StorageRoot)
+	}
+	if to != nil {
+		toRoot = meter.BytesToBytes32(to.StorageRoot)
+	}
+	if fromRoot == toRoot {
+		return nil, false, nil
+	}
+	walkErr := walkDiff(kv, fromRoot, toRoot, func(leaf *leafDiff) bool {
+		if limit > 0 && uint64(len(diffs)) == limit {
+			truncated = true
+			return false
+		}
+		var key []byte
+		if key, err = kv.Get(leaf.key); err != nil {
+			return false
+		}
+		diffs = append(diffs, &StorageDiff{Key: meter.BytesToBytes32(key), From: leaf.from, To: leaf.to})
+		return true
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if walkErr != nil {
+		return nil, false, walkErr
+	}
+	return diffs, truncated, nil
+}
+
+// walkDiff calls fn with leaves which differ between the tries in order of key, until fn returns false.
+// Leaves only in the to trie are added or changed, and those only in the from trie are removed or changed,
+// both are iterated in order of key so they're merged while walking.
+func walkDiff(kv kv.GetPutter, from, to meter.Bytes32, fn func(*leafDiff) bool) error {
+	fromTrie, err := trie.New(from, kv)
+	if err != nil {
+		return err
+	}
+	toTrie, err := trie.New(to, kv)
+	if err != nil {
+		return err
+	}
+
+	addedIt, _ := trie.NewDifferenceIterator(fromTrie.NodeIterator(nil), toTrie.NodeIterator(nil))
+	removedIt, _ := trie.NewDifferenceIterator(toTrie.NodeIterator(nil), fromTrie.NodeIterator(nil))
+	added, removed := trie.NewIterator(addedIt), trie.NewIterator(removedIt)
+	hasAdded, hasRemoved := added.Next(), removed.Next()
+	for hasAdded || hasRemoved {
+		var leaf *leafDiff
+		cmp := 0
+		if !hasRemoved {
+			cmp = -1
+		} else if !hasAdded {
+			cmp = 1
+		} else {
+			cmp = bytes.Compare(added.Key, removed.Key)
+		}
+		switch {
+		case cmp < 0:
+			leaf = &leafDiff{key: added.Key, to: added.Value}
+			hasAdded = added.Next()
+		case cmp > 0:
+			leaf = &leafDiff{key: removed.Key, from: removed.Value}
+			hasRemoved = removed.Next()
+		default:
+			leaf = &leafDiff{key: added.Key, from: removed.Value, to: added.Value}
+			hasAdded, hasRemoved = added.Next(), removed.Next()
+		}
+		if !fn(leaf) {
+			return nil
+		}
+	}
+	if added.Err != nil {
+		return added.Err
+	}
+	return removed.Err
+}
+
+// scriptStorageTypes are types of script engine storage values, keyed by module address and storage key.
+var scriptStorageTypes = map[storageKey]func() interface{}{
+	{meter.AccountLockModuleAddr, meter.ProfileListKey}:     func() interface{} { return &[]*meter.Profile{} },
+	{meter.AuctionModuleAddr, meter.AuctionCBKey}:           func() interface{} { return &meter.AuctionCB{} },
+	{meter.AuctionModuleAddr, meter.AuctionSummaryListKey}:  func() interface{} { return &[]*meter.AuctionSummary{} },
+	{meter.StakingModuleAddr, meter.CandidateListKey}:       func() interface{} { return &[]*meter.Candidate{} },
+	{meter.StakingModuleAddr, meter.StakeHolderListKey}:     func() interface{} { return &[]*meter.Stakeholder{} },
+	{meter.StakingModuleAddr, meter.BucketListKey}:          func() interface{} { return &[]*meter.Bucket{} },
+	{meter.StakingModuleAddr, meter.DelegateListKey}:        func() interface{} { return &[]*meter.Delegate{} },
+	{meter.StakingModuleAddr, meter.DelegateStatListKey}:    func() interface{} { return &[]*meter.DelegateStat{} },
+	{meter.StakingModuleAddr, meter.StatisticsEpochKey}:     func() interface{} { return new(uint32) },
+	{meter.StakingModuleAddr, meter.InJailListKey}:          func() interface{} { return &[]*meter.InJail{} },
+	{meter.StakingModuleAddr, meter.ValidatorRewardListKey}: func() interface{} { return &[]*meter.ValidatorReward{} },
+}
+
+// DecodeStorageValue decodes a raw storage value. Values kept by script engine modules are decoded
+// into their own types, others are decoded as bytes32. It returns nil for empty value.
+func DecodeStorageValue(addr meter.Address, key meter.Bytes32, raw rlp.RawValue) (interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	if newValue, ok := scriptStorageTypes[storageKey{addr, key}]; ok {
+		return decodeRaw(raw, newValue())
+	}
+	return decodeBytes32(raw)
+}
+
+func decodeBytes32(raw rlp.RawValue) (interface{}, error) {
+	var v []byte
+	if err := rlp.DecodeBytes(raw, &v); err != nil {
+		return nil, err
+	}
+	return meter.BytesToBytes32(v), nil
+}
+
+// StorageDecoder decodes storage values in a state. Since fork12 buckets and candidates are kept per
+// entry under hashed keys, which are resolved from the entry indexes of the state, and the list keys
+// hold the revision of the entries.
+type StorageDecoder struct {
+	state   *State
+	entries map[meter.Bytes32]func() interface{} // types of staking entries, built on first use
+}
+
+// NewStorageDecoder creates a storage decoder for values in the state.
+func NewStorageDecoder(state *State) *StorageDecoder {
+	return &StorageDecoder{state: state}
+}
+
+// Decode decodes a raw storage value of the state, it returns nil for empty value.
+func (d *StorageDecoder) Decode(addr meter.Address, key meter.Bytes32, raw rlp.RawValue) (interface{}, error) {
+	if len(raw) == 0 || addr != meter.StakingModuleAddr || !d.state.IsStakingEntryLayout() {
+		return DecodeStorageValue(addr, key, raw)
+	}
+	switch key {
+	case meter.BucketListKey, meter.CandidateListKey:
+		// revision of entries
+		return decodeBytes32(raw)
+	case meter.BucketIndexKey, meter.CandidateIndexKey:
+		return decodeRaw(raw, &entryIndex{})
+	}
+	if newValue, ok := d.entryTypes()[key]; ok {
+		return decodeRaw(raw, newValue())
+	}
+	if err := d.state.Err(); err != nil {
+		return nil, err
+	}
+	return DecodeStorageValue(addr, key, raw)
+}
+
+func (d *StorageDecoder) entryTypes() map[meter.Bytes32]func() interface{} {
+	if d.entries != nil {
+		return d.entries
+	}
+	newBucket := func() interface{} { return &meter.Bucket{} }
+	newCandidate := func() interface{} { return &meter.Candidate{} }
+	newLink := func() interface{} { return &entryLink{} }
+
+	d.entries = make(map[meter.Bytes32]func() interface{})
+	for _, id := range d.state.getEntryIDs(meter.BucketIndexKey) {
+		d.entries[bucketEntryKey(id)] = newBucket
+		d.entries[entryLinkKey(meter.BucketIndexKey, id)] = newLink
+	}
+	for _, id := range d.state.getEntryIDs(meter.CandidateIndexKey) {
+		d.entries[candidateEntryKey(meter.BytesToAddress(id[:]))] = newCandidate
+		d.entries[entryLinkKey(meter.CandidateIndexKey, id)] = newLink
+	}
+	return d.entries
+}
+
+func decodeRaw(raw rlp.RawValue, v interface{}) (interface{}, error) {
+	if err := rlp.DecodeBytes(raw, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	defer kv.Close()
+
+	var (
+		addr1 = meter.BytesToAddress([]byte("addr1"))
+		addr2 = meter.BytesToAddress([]byte("addr2"))
+		addr3 = meter.BytesToAddress([]byte("addr3"))
+		key1  = meter.BytesToBytes32([]byte("key1"))
+		key2  = meter.BytesToBytes32([]byte("key2"))
+	)
+
+	st, _ := New(meter.Bytes32{}, kv)
+	st.SetBalance(addr1, big.NewInt(1))
+	st.SetEnergy(addr2, big.NewInt(2))
+	st.SetStorage(addr2, key1, meter.BytesToBytes32([]byte{1}))
+	st.SetBalance(addr3, big.NewInt(3))
+	from, err := st.Stage().Commit()
+	assert.Nil(t, err)
+
+	st, _ = New(from, kv)
+	st.SetBalance(addr1, big.NewInt(10))
+	st.SetStorage(addr2, key1, meter.Bytes32{})
+	st.SetStorage(addr2, key2, meter.BytesToBytes32([]byte{2}))
+	st.Delete(addr3)
+	to, err := st.Stage().Commit()
+	assert.Nil(t, err)
+
+	diffs, err := Diff(kv, from, to, nil)
+	assert.Nil(t, err)
+	byAddr := make(map[meter.Address]*AccountDiff)
+	for _, d := range diffs {
+		byAddr[d.Address] = d
+	}
+	assert.Equal(t, 3, len(byAddr))
+
+	assert.Equal(t, big.NewInt(1), byAddr[addr1].From.Balance)
+	assert.Equal(t, big.NewInt(10), byAddr[addr1].To.Balance)
+	assert.Empty(t, byAddr[addr1].Storage)
+
+	assert.Nil(t, byAddr[addr3].To)
+	assert.Equal(t, big.NewInt(3), byAddr[addr3].From.Balance)
+
+	storage := make(map[meter.Bytes32]*StorageDiff)
+	for _, s := range byAddr[addr2].Storage {
+		storage[s.Key] = s
+	}
+	assert.Equal(t, 2, len(storage))
+	assert.Empty(t, storage[key1].To)
+	v, err := DecodeStorageValue(addr2, key1, storage[key1].From)
+	assert.Nil(t, err)
+	assert.Equal(t, meter.BytesToBytes32([]byte{1}), v)
+	assert.Empty(t, storage[key2].From)
+
+	// reversed
+	diffs, err = Diff(kv, to, from, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(diffs))
+
+	// no change
+	diffs, err = Diff(kv, to, to, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, diffs)
+
+	// paged
+	all, _ := Diff(kv, from, to, nil)
+	diffs, err = Diff(kv, from, to, &DiffOptions{Offset: 1, Limit: 1, StorageLimit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(diffs))
+	assert.Equal(t, all[1].Address, diffs[0].Address)
+	diffs, err = Diff(kv, from, to, &DiffOptions{StorageLimit: 1})
+	assert.Nil(t, err)
+	for _, d := range diffs {
+		if d.Address == addr2 {
+			assert.Equal(t, 1, len(d.Storage))
+			assert.True(t, d.StorageTruncated)
+		}
+	}
+}
+
+func TestDecodeStorageValue(t *testing.T) {
+	epoch, _ := rlp.EncodeToBytes(uint32(10))
+	v, err := DecodeStorageValue(meter.StakingModuleAddr, meter.StatisticsEpochKey, epoch)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(10), *v.(*uint32))
+
+	candidates, _ := rlp.EncodeToBytes([]*meter.Candidate{})
+	v, err = DecodeStorageValue(meter.StakingModuleAddr, meter.CandidateListKey, candidates)
+	assert.Nil(t, err)
+	assert.IsType(t, &[]*meter.Candidate{}, v)
+
+	v, err = DecodeStorageValue(meter.StakingModuleAddr, meter.CandidateListKey, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+}
+
+func TestStorageDecoder(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	st, _ := New(meter.Bytes32{}, kv)
+
+	owner := meter.BytesToAddress([]byte("owner"))
+	b := meter.NewBucket(owner, meter.Address{}, big.NewInt(100), meter.MTRG, 0, 0, 0, 1, 1)
+	st.SetBucketList(meter.NewBucketList([]*meter.Bucket{b}))
+	legacy := NewStorageDecoder(st)
+	v, err := legacy.Decode(meter.StakingModuleAddr, meter.BucketListKey, st.GetRawStorage(meter.StakingModuleAddr, meter.BucketListKey))
+	assert.Nil(t, err)
+	assert.IsType(t, &[]*meter.Bucket{}, v)
+
+	st.MigrateStakingStorage()
+	decoder := NewStorageDecoder(st)
+	v, err = decoder.Decode(meter.StakingModuleAddr, meter.BucketListKey, st.GetRawStorage(meter.StakingModuleAddr, meter.BucketListKey))
+	assert.Nil(t, err)
+	assert.Equal(t, meter.BytesToBytes32([]byte{1}), v, "list key holds the revision")
+
+	key := bucketEntryKey(b.BucketID)
+	v, err = decoder.Decode(meter.StakingModuleAddr, key, st.GetRawStorage(meter.StakingModuleAddr, key))
+	assert.Nil(t, err)
+	assert.Equal(t, b.BucketID, v.(*meter.Bucket).BucketID)
+
+	key = entryLinkKey(meter.BucketIndexKey, b.BucketID)
+	v, err = decoder.Decode(meter.StakingModuleAddr, key, st.GetRawStorage(meter.StakingModuleAddr, key))
+	assert.Nil(t, err)
+	assert.IsType(t, &entryLink{}, v)
+	assert.Nil(t, st.Err())
+}
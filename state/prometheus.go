@@ -0,0 +1,21 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	cacheRequestsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_requests_total",
+		Help: "Counter of state cache lookups, by cache (trie or code) and result (hit or miss)",
+	}, []string{"cache", "result"})
+
+	// children of cacheRequestsCounter, curried once to keep lookups off the label map
+	trieCacheHits   = cacheRequestsCounter.WithLabelValues("trie", "hit")
+	trieCacheMisses = cacheRequestsCounter.WithLabelValues("trie", "miss")
+	codeCacheHits   = cacheRequestsCounter.WithLabelValues("code", "hit")
+	codeCacheMisses = cacheRequestsCounter.WithLabelValues("code", "miss")
+)
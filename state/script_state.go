@@ -151,6 +151,9 @@ func (s *State) CommitScriptEngineChanges() {
 
 // Candidate List
 func (s *State) GetCandidateList() (result *meter.CandidateList) {
+	if s.IsStakingEntryLayout() {
+		return s.getCandidateEntries()
+	}
 	s.DecodeStorage(meter.StakingModuleAddr, meter.CandidateListKey, func(raw []byte) error {
 		candidates := make([]*meter.Candidate, 0)
 
@@ -178,10 +181,25 @@ func (s *State) SetCandidateList(candList *meter.CandidateList) {
 		return bytes.Compare(candList.candidates[i].Addr.Bytes(), candList.candidates[j].Addr.Bytes()) <= 0
 	})
 	*****/
+	if s.IsStakingEntryLayout() {
+		s.setCandidateEntries(candList)
+		return
+	}
 
-	s.EncodeStorage(meter.StakingModuleAddr, meter.CandidateListKey, func() ([]byte, error) {
-		return rlp.EncodeToBytes(candList.Candidates)
-	})
+	raw, err := rlp.EncodeToBytes(candList.Candidates)
+	if err != nil {
+		s.setError(err)
+		return
+	}
+	if s.stakingWatcher != nil {
+		changed, removed, err := diffRawList(s.GetRawStorage(meter.StakingModuleAddr, meter.CandidateListKey), raw)
+		if err != nil {
+			s.setError(err)
+			return
+		}
+		defer s.notifyCandidates(candList, changed, removed)
+	}
+	s.SetRawStorage(meter.StakingModuleAddr, meter.CandidateListKey, raw)
 }
 
 // StakeHolder List
@@ -221,6 +239,9 @@ func (s *State) SetStakeHolderList(holderList *meter.StakeholderList) {
 
 // Bucket List
 func (s *State) GetBucketList() (result *meter.BucketList) {
+	if s.IsStakingEntryLayout() {
+		return s.getBucketEntries()
+	}
 	s.DecodeStorage(meter.StakingModuleAddr, meter.BucketListKey, func(raw []byte) error {
 		buckets := make([]*meter.Bucket, 0)
 
@@ -248,10 +269,25 @@ func (s *State) SetBucketList(bucketList *meter.BucketList) {
 		return bytes.Compare(bucketList.Buckets[i].BucketID.Bytes(), bucketList.Buckets[j].BucketID.Bytes()) <= 0
 	})
 	***/
+	if s.IsStakingEntryLayout() {
+		s.setBucketEntries(bucketList)
+		return
+	}
 
-	s.EncodeStorage(meter.StakingModuleAddr, meter.BucketListKey, func() ([]byte, error) {
-		return rlp.EncodeToBytes(bucketList.Buckets)
-	})
+	raw, err := rlp.EncodeToBytes(bucketList.Buckets)
+	if err != nil {
+		s.setError(err)
+		return
+	}
+	if s.stakingWatcher != nil {
+		changed, removed, err := diffRawList(s.GetRawStorage(meter.StakingModuleAddr, meter.BucketListKey), raw)
+		if err != nil {
+			s.setError(err)
+			return
+		}
+		defer s.notifyBuckets(bucketList, changed, removed)
+	}
+	s.SetRawStorage(meter.StakingModuleAddr, meter.BucketListKey, raw)
 }
 
 // Delegates List
@@ -131,7 +131,13 @@ func (s *Stage) Commit() (meter.Bytes32, error) {
 	}
 	// atrieClone.CommitTo(s.store)
 
-	if err := batch.Write(); err != nil {
+	commitLock.RLock()
+	err = batch.Write()
+	if err == nil {
+		recentRoots.Add(root, struct{}{})
+	}
+	commitLock.RUnlock()
+	if err != nil {
 		return meter.Bytes32{}, err
 	}
 	trCache.Add(root, s.accountTrie, s.kv)
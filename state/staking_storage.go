@@ -0,0 +1,592 @@
+// Copyright (c) 2020 The meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/kv"
+	"github.com/meterio/meter-pov/meter"
+)
+
+// Since fork12, each bucket and candidate is stored under its own key. The order of the list is
+// kept by an index, a doubly linked list with head and tail stored at the index key and the links
+// of each id stored at Blake2b(indexKey, id), so adding or removing an entry only touches its
+// neighbours. Single entries are read and written by key, lists read and written as a whole only
+// write changed entries and links. Storage at the list key becomes the revision of the entries, which changes
+// whenever any entry changes.
+
+func bucketEntryKey(id meter.Bytes32) meter.Bytes32 {
+	return meter.Blake2b(meter.BucketListKey[:], id[:])
+}
+
+func candidateEntryKey(addr meter.Address) meter.Bytes32 {
+	return meter.Blake2b(meter.CandidateListKey[:], addr[:])
+}
+
+func entryLinkKey(indexKey, id meter.Bytes32) meter.Bytes32 {
+	return meter.Blake2b(indexKey[:], id[:])
+}
+
+// entryIndex is stored at the index key.
+type entryIndex struct {
+	Head meter.Bytes32
+	Tail meter.Bytes32
+	Len  uint64
+}
+
+// entryLink is stored at the link key of each id, zero means none.
+type entryLink struct {
+	Prev meter.Bytes32
+	Next meter.Bytes32
+}
+
+// IsStakingEntryLayout returns whether buckets and candidates are stored per entry.
+func (s *State) IsStakingEntryLayout() bool {
+	return !s.GetStorage(meter.StakingModuleAddr, meter.StakingEntryLayoutKey).IsZero()
+}
+
+// MigrateStakingStorage moves buckets and candidates from rlp lists to per entry storage,
+// the order of the lists is kept.
+func (s *State) MigrateStakingStorage() {
+	if s.IsStakingEntryLayout() {
+		return
+	}
+	var (
+		buckets    []*meter.Bucket
+		candidates []*meter.Candidate
+	)
+	s.DecodeStorage(meter.StakingModuleAddr, meter.BucketListKey, func(raw []byte) error {
+		if len(raw) == 0 {
+			return nil
+		}
+		return rlp.DecodeBytes(raw, &buckets)
+	})
+	s.DecodeStorage(meter.StakingModuleAddr, meter.CandidateListKey, func(raw []byte) error {
+		if len(raw) == 0 {
+			return nil
+		}
+		return rlp.DecodeBytes(raw, &candidates)
+	})
+
+	s.SetRawStorage(meter.StakingModuleAddr, meter.BucketListKey, nil)
+	s.SetRawStorage(meter.StakingModuleAddr, meter.CandidateListKey, nil)
+	s.SetStorage(meter.StakingModuleAddr, meter.StakingEntryLayoutKey, meter.BytesToBytes32([]byte{1}))
+
+	s.setBucketEntries(&meter.BucketList{Buckets: buckets})
+	s.setCandidateEntries(&meter.CandidateList{Candidates: candidates})
+}
+
+func (s *State) getEntryIndex(indexKey meter.Bytes32) (index entryIndex) {
+	s.DecodeStorage(meter.StakingModuleAddr, indexKey, func(raw []byte) error {
+		if len(raw) == 0 {
+			return nil
+		}
+		return rlp.DecodeBytes(raw, &index)
+	})
+	return
+}
+
+func (s *State) getEntryLink(indexKey, id meter.Bytes32) (link entryLink) {
+	s.DecodeStorage(meter.StakingModuleAddr, entryLinkKey(indexKey, id), func(raw []byte) error {
+		if len(raw) == 0 {
+			return nil
+		}
+		return rlp.DecodeBytes(raw, &link)
+	})
+	return
+}
+
+// getEntryIDs returns ids in the index, in list order.
+func (s *State) getEntryIDs(indexKey meter.Bytes32) []meter.Bytes32 {
+	index := s.getEntryIndex(indexKey)
+	ids := make([]meter.Bytes32, 0, index.Len)
+	for id := index.Head; !id.IsZero() && uint64(len(ids)) < index.Len; id = s.getEntryLink(indexKey, id).Next {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// setRawIfChanged writes the raw value only if it differs from the stored one.
+func (s *State) setRawIfChanged(key meter.Bytes32, raw []byte) bool {
+	if bytes.Equal(s.GetRawStorage(meter.StakingModuleAddr, key), raw) {
+		return false
+	}
+	s.SetRawStorage(meter.StakingModuleAddr, key, raw)
+	return true
+}
+
+func (s *State) encodeRaw(v interface{}) []byte {
+	raw, err := rlp.EncodeToBytes(v)
+	if err != nil {
+		s.setError(err)
+	}
+	return raw
+}
+
+// entryWrites are entries written by setEntries.
+type entryWrites struct {
+	written bool           // anything is written, including links and the index
+	changed []int          // indexes in ids of entries which are added or changed
+	removed []rlp.RawValue // stored values of removed entries
+}
+
+// setEntries writes entries and links which differ from the stored ones, and removes entries not in ids.
+// Entries are encoded to detect in place changes, stored values are only compared as raw bytes, and the
+// stored index is walked only if some entries are removed.
+func (s *State) setEntries(indexKey meter.Bytes32, ids []meter.Bytes32, entryKey func(meter.Bytes32) meter.Bytes32, encode func(int) ([]byte, error)) (w entryWrites) {
+	// stored entries not in ids are removed, find them before links are rewritten
+	var present uint64
+	for _, id := range ids {
+		if len(s.GetRawStorage(meter.StakingModuleAddr, entryKey(id))) > 0 {
+			present++
+		}
+	}
+	var removed []meter.Bytes32
+	if present < s.getEntryIndex(indexKey).Len {
+		kept := make(map[meter.Bytes32]bool, len(ids))
+		for _, id := range ids {
+			kept[id] = true
+		}
+		for _, id := range s.getEntryIDs(indexKey) {
+			if !kept[id] {
+				removed = append(removed, id)
+			}
+		}
+	}
+
+	w.written = len(removed) > 0
+	for _, id := range removed {
+		w.removed = append(w.removed, s.GetRawStorage(meter.StakingModuleAddr, entryKey(id)))
+		s.SetRawStorage(meter.StakingModuleAddr, entryKey(id), nil)
+		s.SetRawStorage(meter.StakingModuleAddr, entryLinkKey(indexKey, id), nil)
+	}
+	for i, id := range ids {
+		raw, err := encode(i)
+		if err != nil {
+			s.setError(err)
+			return
+		}
+		if s.setRawIfChanged(entryKey(id), raw) {
+			w.written = true
+			w.changed = append(w.changed, i)
+		}
+
+		var link entryLink
+		if i > 0 {
+			link.Prev = ids[i-1]
+		}
+		if i < len(ids)-1 {
+			link.Next = ids[i+1]
+		}
+		if s.setRawIfChanged(entryLinkKey(indexKey, id), s.encodeRaw(&link)) {
+			w.written = true
+		}
+	}
+
+	var raw []byte
+	if len(ids) > 0 {
+		raw = s.encodeRaw(&entryIndex{Head: ids[0], Tail: ids[len(ids)-1], Len: uint64(len(ids))})
+	}
+	if s.setRawIfChanged(indexKey, raw) {
+		w.written = true
+	}
+	return
+}
+
+// bumpRevision increases the revision stored at the list key.
+func (s *State) bumpRevision(listKey meter.Bytes32) {
+	cur := s.GetStorage(meter.StakingModuleAddr, listKey)
+	rev := new(big.Int).SetBytes(cur[:])
+	s.SetStorage(meter.StakingModuleAddr, listKey, meter.BytesToBytes32(rev.Add(rev, big.NewInt(1)).Bytes()))
+}
+
+func (s *State) getBucketEntries() *meter.BucketList {
+	ids := s.getEntryIDs(meter.BucketIndexKey)
+	buckets := make([]*meter.Bucket, 0, len(ids))
+	for _, id := range ids {
+		var b meter.Bucket
+		s.DecodeStorage(meter.StakingModuleAddr, bucketEntryKey(id), func(raw []byte) error {
+			return rlp.DecodeBytes(raw, &b)
+		})
+		buckets = append(buckets, &b)
+	}
+	return meter.NewBucketList(buckets)
+}
+
+func (s *State) setBucketEntries(list *meter.BucketList) {
+	ids := make([]meter.Bytes32, 0, len(list.Buckets))
+	for _, b := range list.Buckets {
+		ids = append(ids, b.BucketID)
+	}
+	w := s.setEntries(meter.BucketIndexKey, ids, bucketEntryKey, func(i int) ([]byte, error) {
+		return rlp.EncodeToBytes(list.Buckets[i])
+	})
+	if w.written {
+		s.bumpRevision(meter.BucketListKey)
+	}
+	s.notifyBuckets(list, w.changed, w.removed)
+}
+
+func (s *State) getCandidateEntries() *meter.CandidateList {
+	ids := s.getEntryIDs(meter.CandidateIndexKey)
+	candidates := make([]*meter.Candidate, 0, len(ids))
+	for _, id := range ids {
+		var c meter.Candidate
+		s.DecodeStorage(meter.StakingModuleAddr, candidateEntryKey(meter.BytesToAddress(id[:])), func(raw []byte) error {
+			return rlp.DecodeBytes(raw, &c)
+		})
+		candidates = append(candidates, &c)
+	}
+	return meter.NewCandidateList(candidates)
+}
+
+func (s *State) setCandidateEntries(list *meter.CandidateList) {
+	// candidate addresses are indexed as bytes32
+	ids := make([]meter.Bytes32, 0, len(list.Candidates))
+	for _, c := range list.Candidates {
+		ids = append(ids, meter.BytesToBytes32(c.Addr[:]))
+	}
+	entryKey := func(id meter.Bytes32) meter.Bytes32 {
+		return candidateEntryKey(meter.BytesToAddress(id[:]))
+	}
+	w := s.setEntries(meter.CandidateIndexKey, ids, entryKey, func(i int) ([]byte, error) {
+		return rlp.EncodeToBytes(list.Candidates[i])
+	})
+	if w.written {
+		s.bumpRevision(meter.CandidateListKey)
+	}
+	s.notifyCandidates(list, w.changed, w.removed)
+}
+
+// GetBucket returns the bucket of given id, nil if not found.
+func (s *State) GetBucket(id meter.Bytes32) *meter.Bucket {
+	if !s.IsStakingEntryLayout() {
+		return s.GetBucketList().Get(id)
+	}
+	var b *meter.Bucket
+	s.DecodeStorage(meter.StakingModuleAddr, bucketEntryKey(id), func(raw []byte) error {
+		if len(raw) == 0 {
+			return nil
+		}
+		b = new(meter.Bucket)
+		return rlp.DecodeBytes(raw, b)
+	})
+	return b
+}
+
+// SetBucket adds or updates the bucket, a new bucket is linked at the tail of the index.
+func (s *State) SetBucket(b *meter.Bucket) {
+	if !s.IsStakingEntryLayout() {
+		list := s.GetBucketList()
+		list.Add(b)
+		s.SetBucketList(list)
+		return
+	}
+	if s.setEntry(meter.BucketIndexKey, b.BucketID, bucketEntryKey(b.BucketID), b) {
+		s.bumpRevision(meter.BucketListKey)
+		if s.stakingWatcher != nil {
+			s.stakingWatcher.BucketChanged(b, false)
+		}
+	}
+}
+
+// RemoveBucket removes the bucket of given id if any.
+func (s *State) RemoveBucket(id meter.Bytes32) {
+	if !s.IsStakingEntryLayout() {
+		list := s.GetBucketList()
+		list.Remove(id)
+		s.SetBucketList(list)
+		return
+	}
+	if raw := s.removeEntry(meter.BucketIndexKey, id, bucketEntryKey(id)); len(raw) > 0 {
+		s.bumpRevision(meter.BucketListKey)
+		s.notifyBuckets(nil, nil, []rlp.RawValue{raw})
+	}
+}
+
+// GetCandidate returns the candidate of given address, nil if not found.
+func (s *State) GetCandidate(addr meter.Address) *meter.Candidate {
+	if !s.IsStakingEntryLayout() {
+		return s.GetCandidateList().Get(addr)
+	}
+	var c *meter.Candidate
+	s.DecodeStorage(meter.StakingModuleAddr, candidateEntryKey(addr), func(raw []byte) error {
+		if len(raw) == 0 {
+			return nil
+		}
+		c = new(meter.Candidate)
+		return rlp.DecodeBytes(raw, c)
+	})
+	return c
+}
+
+// SetCandidate adds or updates the candidate, a new candidate is linked at the tail of the index.
+func (s *State) SetCandidate(c *meter.Candidate) {
+	if !s.IsStakingEntryLayout() {
+		list := s.GetCandidateList()
+		list.Add(c)
+		s.SetCandidateList(list)
+		return
+	}
+	if s.setEntry(meter.CandidateIndexKey, meter.BytesToBytes32(c.Addr[:]), candidateEntryKey(c.Addr), c) {
+		s.bumpRevision(meter.CandidateListKey)
+		if s.stakingWatcher != nil {
+			s.stakingWatcher.CandidateChanged(c, false)
+		}
+	}
+}
+
+// RemoveCandidate removes the candidate of given address if any.
+func (s *State) RemoveCandidate(addr meter.Address) {
+	if !s.IsStakingEntryLayout() {
+		list := s.GetCandidateList()
+		list.Remove(addr)
+		s.SetCandidateList(list)
+		return
+	}
+	if raw := s.removeEntry(meter.CandidateIndexKey, meter.BytesToBytes32(addr[:]), candidateEntryKey(addr)); len(raw) > 0 {
+		s.bumpRevision(meter.CandidateListKey)
+		s.notifyCandidates(nil, nil, []rlp.RawValue{raw})
+	}
+}
+
+// setEntry writes the entry if it differs from the stored one, and links a new id at the tail of the index.
+// It returns whether the entry is written.
+func (s *State) setEntry(indexKey, id, entryKey meter.Bytes32, v interface{}) bool {
+	raw := s.encodeRaw(v)
+	if raw == nil {
+		return false
+	}
+	if len(s.GetRawStorage(meter.StakingModuleAddr, entryKey)) == 0 {
+		index := s.getEntryIndex(indexKey)
+		var link entryLink
+		if index.Len > 0 {
+			tail := s.getEntryLink(indexKey, index.Tail)
+			tail.Next = id
+			s.SetRawStorage(meter.StakingModuleAddr, entryLinkKey(indexKey, index.Tail), s.encodeRaw(&tail))
+			link.Prev = index.Tail
+		} else {
+			index.Head = id
+		}
+		s.SetRawStorage(meter.StakingModuleAddr, entryLinkKey(indexKey, id), s.encodeRaw(&link))
+		index.Tail = id
+		index.Len++
+		s.SetRawStorage(meter.StakingModuleAddr, indexKey, s.encodeRaw(&index))
+	}
+	return s.setRawIfChanged(entryKey, raw)
+}
+
+// removeEntry clears the entry and unlinks the id from its neighbours. It returns the stored value,
+// empty if the entry is not found.
+func (s *State) removeEntry(indexKey, id, entryKey meter.Bytes32) []byte {
+	raw := s.GetRawStorage(meter.StakingModuleAddr, entryKey)
+	if len(raw) == 0 {
+		return nil
+	}
+	index, link := s.getEntryIndex(indexKey), s.getEntryLink(indexKey, id)
+	if link.Prev.IsZero() {
+		index.Head = link.Next
+	} else {
+		prev := s.getEntryLink(indexKey, link.Prev)
+		prev.Next = link.Next
+		s.SetRawStorage(meter.StakingModuleAddr, entryLinkKey(indexKey, link.Prev), s.encodeRaw(&prev))
+	}
+	if link.Next.IsZero() {
+		index.Tail = link.Prev
+	} else {
+		next := s.getEntryLink(indexKey, link.Next)
+		next.Prev = link.Prev
+		s.SetRawStorage(meter.StakingModuleAddr, entryLinkKey(indexKey, link.Next), s.encodeRaw(&next))
+	}
+	index.Len--
+
+	var indexRaw []byte
+	if index.Len > 0 {
+		indexRaw = s.encodeRaw(&index)
+	}
+	s.SetRawStorage(meter.StakingModuleAddr, indexKey, indexRaw)
+	s.SetRawStorage(meter.StakingModuleAddr, entryLinkKey(indexKey, id), nil)
+	s.SetRawStorage(meter.StakingModuleAddr, entryKey, nil)
+	return raw
+}
+
+// StakingWatcher is notified of buckets and candidates changed by the list and keyed setters.
+// Removed entries come with the values before removal.
+type StakingWatcher interface {
+	BucketChanged(b *meter.Bucket, removed bool)
+	CandidateChanged(c *meter.Candidate, removed bool)
+}
+
+// WatchStaking sets the watcher of bucket and candidate writes, nil stops watching. Only entries whose
+// encoding changes are notified, they're found by comparing raw values the write produces anyway.
+func (s *State) WatchStaking(w StakingWatcher) {
+	s.stakingWatcher = w
+}
+
+func (s *State) notifyBuckets(list *meter.BucketList, changed []int, removed []rlp.RawValue) {
+	if s.stakingWatcher == nil {
+		return
+	}
+	for _, i := range changed {
+		s.stakingWatcher.BucketChanged(list.Buckets[i], false)
+	}
+	for _, raw := range removed {
+		var b meter.Bucket
+		if err := rlp.DecodeBytes(raw, &b); err != nil {
+			s.setError(err)
+			return
+		}
+		s.stakingWatcher.BucketChanged(&b, true)
+	}
+}
+
+func (s *State) notifyCandidates(list *meter.CandidateList, changed []int, removed []rlp.RawValue) {
+	if s.stakingWatcher == nil {
+		return
+	}
+	for _, i := range changed {
+		s.stakingWatcher.CandidateChanged(list.Candidates[i], false)
+	}
+	for _, raw := range removed {
+		var c meter.Candidate
+		if err := rlp.DecodeBytes(raw, &c); err != nil {
+			s.setError(err)
+			return
+		}
+		s.stakingWatcher.CandidateChanged(&c, true)
+	}
+}
+
+// diffRawList compares elements of two rlp lists as raw bytes, elements are keyed by their first field.
+// It returns indexes of added or changed elements in the new list, and removed elements of the old list.
+func diffRawList(oldRaw, newRaw []byte) (changed []int, removed []rlp.RawValue, err error) {
+	oldElems, err := splitRawList(oldRaw)
+	if err != nil {
+		return nil, nil, err
+	}
+	newElems, err := splitRawList(newRaw)
+	if err != nil {
+		return nil, nil, err
+	}
+	old := make(map[string]rlp.RawValue, len(oldElems))
+	for _, elem := range oldElems {
+		key, err := firstField(elem)
+		if err != nil {
+			return nil, nil, err
+		}
+		old[string(key)] = elem
+	}
+	for i, elem := range newElems {
+		key, err := firstField(elem)
+		if err != nil {
+			return nil, nil, err
+		}
+		if prev, ok := old[string(key)]; !ok || !bytes.Equal(prev, elem) {
+			changed = append(changed, i)
+		}
+		delete(old, string(key))
+	}
+	for _, elem := range oldElems {
+		key, _ := firstField(elem)
+		if _, ok := old[string(key)]; ok {
+			removed = append(removed, elem)
+		}
+	}
+	return changed, removed, nil
+}
+
+func splitRawList(raw []byte) ([]rlp.RawValue, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	content, _, err := rlp.SplitList(raw)
+	if err != nil {
+		return nil, err
+	}
+	var elems []rlp.RawValue
+	for len(content) > 0 {
+		_, _, rest, err := rlp.Split(content)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, content[:len(content)-len(rest)])
+		content = rest
+	}
+	return elems, nil
+}
+
+func firstField(elem rlp.RawValue) ([]byte, error) {
+	content, _, err := rlp.SplitList(elem)
+	if err != nil {
+		return nil, err
+	}
+	key, _, err := rlp.SplitString(content)
+	return key, err
+}
+
+// StakingEntryDiff describes buckets and candidates changed between two states in per entry layout,
+// nil means the entry is removed.
+type StakingEntryDiff struct {
+	Buckets    map[meter.Bytes32]*meter.Bucket
+	Candidates map[meter.Address]*meter.Candidate
+}
+
+// DiffStakingEntries returns buckets and candidates changed between two states in per entry layout.
+// Only changed storage slots of the staking module are walked, and a slot is taken as an entry if it
+// decodes to a bucket or candidate whose entry key is the slot key.
+func DiffStakingEntries(kv kv.GetPutter, from, to meter.Bytes32) (*StakingEntryDiff, error) {
+	fromState, err := New(from, kv)
+	if err != nil {
+		return nil, err
+	}
+	toState, err := New(to, kv)
+	if err != nil {
+		return nil, err
+	}
+	fromAcc, toAcc := fromState.getAccount(meter.StakingModuleAddr), toState.getAccount(meter.StakingModuleAddr)
+	if err := fromState.Err(); err != nil {
+		return nil, err
+	}
+	if err := toState.Err(); err != nil {
+		return nil, err
+	}
+	slots, _, err := diffStorage(kv, fromAcc, toAcc, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &StakingEntryDiff{
+		Buckets:    make(map[meter.Bytes32]*meter.Bucket),
+		Candidates: make(map[meter.Address]*meter.Candidate),
+	}
+	for _, slot := range slots {
+		raw := slot.To
+		if len(raw) == 0 {
+			raw = slot.From
+		}
+		var b meter.Bucket
+		if rlp.DecodeBytes(raw, &b) == nil && bucketEntryKey(b.BucketID) == slot.Key {
+			if len(slot.To) == 0 {
+				diff.Buckets[b.BucketID] = nil
+			} else {
+				diff.Buckets[b.BucketID] = &b
+			}
+			continue
+		}
+		var c meter.Candidate
+		if rlp.DecodeBytes(raw, &c) == nil && candidateEntryKey(c.Addr) == slot.Key {
+			if len(slot.To) == 0 {
+				diff.Candidates[c.Addr] = nil
+			} else {
+				diff.Candidates[c.Addr] = &c
+			}
+		}
+	}
+	return diff, nil
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package state
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStakingStorageMigration(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	state, _ := New(meter.Bytes32{}, kv)
+
+	owner := meter.BytesToAddress([]byte("owner"))
+	cand := meter.BytesToAddress([]byte("cand"))
+	b1 := meter.NewBucket(owner, cand, big.NewInt(100), meter.MTRG, 0, 0, 0, 1, 1)
+	b2 := meter.NewBucket(owner, cand, big.NewInt(200), meter.MTRG, 0, 0, 0, 1, 2)
+	state.SetBucketList(meter.NewBucketList([]*meter.Bucket{b1, b2}))
+	c := meter.NewCandidate(cand, []byte("cand"), []byte("desc"), []byte("pubkey"), []byte("1.2.3.4"), 8670, 0, 1)
+	state.SetCandidateList(meter.NewCandidateList([]*meter.Candidate{c}))
+	assert.False(t, state.IsStakingEntryLayout())
+
+	state.MigrateStakingStorage()
+	assert.True(t, state.IsStakingEntryLayout())
+	assert.Equal(t, []meter.Bytes32{b1.BucketID, b2.BucketID}, state.getEntryIDs(meter.BucketIndexKey))
+
+	buckets := state.GetBucketList()
+	assert.Equal(t, 2, buckets.Len())
+	assert.Equal(t, b1.Value, buckets.Get(b1.BucketID).Value)
+	assert.Equal(t, b2.Value, buckets.Get(b2.BucketID).Value)
+	candidates := state.GetCandidateList()
+	assert.Equal(t, 1, candidates.Len())
+	assert.Equal(t, c.PubKey, candidates.Candidates[0].PubKey)
+
+	// unchanged lists leave the revision untouched
+	rev := state.GetStorage(meter.StakingModuleAddr, meter.BucketListKey)
+	assert.False(t, rev.IsZero())
+	state.SetBucketList(buckets)
+	assert.Equal(t, rev, state.GetStorage(meter.StakingModuleAddr, meter.BucketListKey))
+
+	// update one bucket and remove the other
+	buckets.Get(b1.BucketID).Value = big.NewInt(150)
+	buckets.Remove(b2.BucketID)
+	state.SetBucketList(buckets)
+	assert.NotEqual(t, rev, state.GetStorage(meter.StakingModuleAddr, meter.BucketListKey))
+	assert.Nil(t, state.GetRawStorage(meter.StakingModuleAddr, bucketEntryKey(b2.BucketID)))
+
+	buckets = state.GetBucketList()
+	assert.Equal(t, 1, buckets.Len())
+	assert.Equal(t, big.NewInt(150), buckets.Get(b1.BucketID).Value)
+	assert.Nil(t, state.Err())
+}
+
+func TestStakingEntryOrder(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	state, _ := New(meter.Bytes32{}, kv)
+
+	owner := meter.BytesToAddress([]byte("owner"))
+	var buckets []*meter.Bucket
+	for i := uint64(1); i <= 4; i++ {
+		buckets = append(buckets, meter.NewBucket(owner, meter.Address{}, big.NewInt(100), meter.MTRG, 0, 0, 0, 1, i))
+	}
+	// legacy lists are not sorted by bucket id
+	sort.Slice(buckets, func(i, j int) bool {
+		return bytes.Compare(buckets[i].BucketID[:], buckets[j].BucketID[:]) > 0
+	})
+	ids := func(bs []*meter.Bucket) (ids []meter.Bytes32) {
+		for _, b := range bs {
+			ids = append(ids, b.BucketID)
+		}
+		return
+	}
+	state.EncodeStorage(meter.StakingModuleAddr, meter.BucketListKey, func() ([]byte, error) {
+		return rlp.EncodeToBytes(buckets)
+	})
+
+	state.MigrateStakingStorage()
+	assert.Equal(t, ids(buckets), state.getEntryIDs(meter.BucketIndexKey), "migration keeps the legacy order")
+
+	// removing the middle one relinks its neighbours only
+	list := &meter.BucketList{Buckets: []*meter.Bucket{buckets[0], buckets[2], buckets[3]}}
+	state.SetBucketList(list)
+	assert.Equal(t, ids(list.Buckets), state.getEntryIDs(meter.BucketIndexKey))
+	assert.Nil(t, state.GetRawStorage(meter.StakingModuleAddr, entryLinkKey(meter.BucketIndexKey, buckets[1].BucketID)))
+
+	// appending keeps the order given
+	list.Buckets = append(list.Buckets, buckets[1])
+	state.SetBucketList(list)
+	assert.Equal(t, ids(list.Buckets), state.getEntryIDs(meter.BucketIndexKey))
+
+	state.SetBucketList(&meter.BucketList{})
+	assert.Empty(t, state.getEntryIDs(meter.BucketIndexKey))
+	assert.Nil(t, state.GetRawStorage(meter.StakingModuleAddr, meter.BucketIndexKey))
+	assert.Nil(t, state.Err())
+}
+
+func TestDiffStakingEntries(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	state, _ := New(meter.Bytes32{}, kv)
+
+	owner := meter.BytesToAddress([]byte("owner"))
+	cand := meter.BytesToAddress([]byte("cand"))
+	b1 := meter.NewBucket(owner, cand, big.NewInt(100), meter.MTRG, 0, 0, 0, 1, 1)
+	b2 := meter.NewBucket(owner, cand, big.NewInt(200), meter.MTRG, 0, 0, 0, 1, 2)
+	state.MigrateStakingStorage()
+	state.SetBucketList(meter.NewBucketList([]*meter.Bucket{b1, b2}))
+	from, _ := state.Stage().Commit()
+
+	b3 := meter.NewBucket(owner, cand, big.NewInt(300), meter.MTRG, 0, 0, 0, 1, 3)
+	buckets := state.GetBucketList()
+	buckets.Remove(b1.BucketID)
+	buckets.Get(b2.BucketID).Value = big.NewInt(250)
+	buckets.Add(b3)
+	state.SetBucketList(buckets)
+	c := meter.NewCandidate(cand, []byte("cand"), nil, nil, nil, 0, 0, 0)
+	state.SetCandidateList(meter.NewCandidateList([]*meter.Candidate{c}))
+	to, _ := state.Stage().Commit()
+
+	diff, err := DiffStakingEntries(kv, from, to)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(diff.Buckets))
+	assert.Nil(t, diff.Buckets[b1.BucketID])
+	assert.Equal(t, big.NewInt(250), diff.Buckets[b2.BucketID].Value)
+	assert.Equal(t, b3.Value, diff.Buckets[b3.BucketID].Value)
+	assert.Equal(t, 1, len(diff.Candidates))
+	assert.Equal(t, c.Name, diff.Candidates[cand].Name)
+
+	diff, err = DiffStakingEntries(kv, to, to)
+	assert.Nil(t, err)
+	assert.Empty(t, diff.Buckets)
+	assert.Empty(t, diff.Candidates)
+}
+
+type testStakingWatcher struct {
+	buckets    []meter.Bytes32
+	candidates []meter.Address
+	removed    int
+}
+
+func (w *testStakingWatcher) BucketChanged(b *meter.Bucket, removed bool) {
+	w.buckets = append(w.buckets, b.BucketID)
+	if removed {
+		w.removed++
+	}
+}
+
+func (w *testStakingWatcher) CandidateChanged(c *meter.Candidate, removed bool) {
+	w.candidates = append(w.candidates, c.Addr)
+	if removed {
+		w.removed++
+	}
+}
+
+func TestStakingKeyedEntries(t *testing.T) {
+	owner := meter.BytesToAddress([]byte("owner"))
+	cand := meter.BytesToAddress([]byte("cand"))
+	var buckets []*meter.Bucket
+	for i := uint64(1); i <= 3; i++ {
+		buckets = append(buckets, meter.NewBucket(owner, cand, big.NewInt(100), meter.MTRG, 0, 0, 0, 1, i))
+	}
+	c := meter.NewCandidate(cand, []byte("cand"), nil, nil, nil, 0, 0, 0)
+
+	for _, entryLayout := range []bool{false, true} {
+		kv, _ := lvldb.NewMem()
+		state, _ := New(meter.Bytes32{}, kv)
+		if entryLayout {
+			state.MigrateStakingStorage()
+		}
+		w := &testStakingWatcher{}
+		state.WatchStaking(w)
+
+		for _, b := range buckets {
+			state.SetBucket(b)
+		}
+		state.SetCandidate(c)
+		assert.Equal(t, 3, state.GetBucketList().Len())
+		assert.Equal(t, c.Name, state.GetCandidate(cand).Name)
+		assert.Nil(t, state.GetBucket(meter.Bytes32{}))
+		if entryLayout {
+			ids := []meter.Bytes32{buckets[0].BucketID, buckets[1].BucketID, buckets[2].BucketID}
+			assert.Equal(t, ids, state.getEntryIDs(meter.BucketIndexKey), "new buckets are linked at the tail")
+		}
+
+		// unchanged entries are not written
+		rev := state.GetStorage(meter.StakingModuleAddr, meter.BucketListKey)
+		w.buckets = nil
+		state.SetBucket(state.GetBucket(buckets[1].BucketID))
+		assert.Equal(t, rev, state.GetStorage(meter.StakingModuleAddr, meter.BucketListKey))
+		assert.Empty(t, w.buckets)
+
+		b := state.GetBucket(buckets[1].BucketID)
+		b.Value = big.NewInt(150)
+		state.SetBucket(b)
+		assert.Equal(t, big.NewInt(150), state.GetBucketList().Get(b.BucketID).Value)
+		assert.Equal(t, []meter.Bytes32{b.BucketID}, w.buckets)
+
+		// removing the middle one relinks its neighbours
+		w.buckets = nil
+		state.RemoveBucket(buckets[1].BucketID)
+		state.RemoveBucket(buckets[1].BucketID)
+		assert.Nil(t, state.GetBucket(buckets[1].BucketID))
+		assert.Equal(t, 2, state.GetBucketList().Len())
+		assert.Equal(t, []meter.Bytes32{buckets[1].BucketID}, w.buckets)
+		assert.Equal(t, 1, w.removed)
+		if entryLayout {
+			assert.Equal(t, []meter.Bytes32{buckets[0].BucketID, buckets[2].BucketID}, state.getEntryIDs(meter.BucketIndexKey))
+		}
+
+		state.RemoveBucket(buckets[0].BucketID)
+		state.RemoveBucket(buckets[2].BucketID)
+		state.RemoveCandidate(cand)
+		assert.Equal(t, 0, state.GetBucketList().Len())
+		assert.Equal(t, 0, state.GetCandidateList().Len())
+		assert.Equal(t, []meter.Address{cand, cand}, w.candidates)
+		if entryLayout {
+			assert.Nil(t, state.GetRawStorage(meter.StakingModuleAddr, meter.BucketIndexKey))
+			assert.Nil(t, state.GetRawStorage(meter.StakingModuleAddr, meter.CandidateIndexKey))
+		}
+		assert.Nil(t, state.Err())
+	}
+}
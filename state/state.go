@@ -32,11 +32,14 @@ type State struct {
 	setError func(err error)
 
 	seCache *SECache
+
+	stakingWatcher StakingWatcher // notified of bucket and candidate writes
 }
 
 // to constrain ability of trie
 type trieReader interface {
 	TryGet(key []byte) ([]byte, error)
+	Prove(key []byte, fromLevel uint, proofDb trie.DatabaseWriter) error
 	CacheMisses() int64
 }
 
@@ -470,6 +473,33 @@ func (s *State) GetCodeHash(addr meter.Address) meter.Bytes32 {
 	return meter.BytesToBytes32(s.getAccount(addr).CodeHash)
 }
 
+// ProveAccount writes the merkle proof of the account at the given address into proofDb.
+// It returns the account in the initial state, unaffected by changes made to the state.
+func (s *State) ProveAccount(addr meter.Address, proofDb trie.DatabaseWriter) (*Account, error) {
+	if err := s.trie.Prove(addr[:], 0, proofDb); err != nil {
+		return nil, err
+	}
+	return loadAccount(s.trie, addr)
+}
+
+// ProveStorage writes the merkle proof of the storage value for the given address and key into proofDb,
+// against the storage root of the account in the initial state.
+// Nothing is written if the account has no storage.
+func (s *State) ProveStorage(addr meter.Address, key meter.Bytes32, proofDb trie.DatabaseWriter) error {
+	acc, err := loadAccount(s.trie, addr)
+	if err != nil {
+		return err
+	}
+	if len(acc.StorageRoot) == 0 {
+		return nil
+	}
+	strie, err := trCache.Get(meter.BytesToBytes32(acc.StorageRoot), s.kv, false)
+	if err != nil {
+		return err
+	}
+	return strie.Prove(key[:], 0, proofDb)
+}
+
 // SetCode set code for the given address.
 func (s *State) SetCode(addr meter.Address, code []byte) {
 	var codeHash []byte
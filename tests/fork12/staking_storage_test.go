@@ -0,0 +1,45 @@
+package fork12
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/script/staking"
+	"github.com/meterio/meter-pov/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStakingOnlyBlockMigrates(t *testing.T) {
+	rt, s, ts, chainTag := initRuntimeAtFork12()
+	assert.False(t, s.IsStakingEntryLayout())
+	cand := s.GetCandidateList().Get(tests.Cand2Addr)
+
+	boundAmount := tests.BuildAmount(1000)
+	body := &staking.StakingBody{
+		Opcode:     staking.OP_BOUND,
+		Option:     uint32(0),
+		Amount:     boundAmount,
+		HolderAddr: tests.Voter2Addr,
+		CandAddr:   tests.Cand2Addr,
+		Token:      meter.MTRG,
+	}
+	txNonce := rand.Uint64()
+	trx := tests.BuildStakingTx(chainTag, 0, body, tests.Voter2Key, txNonce)
+	receipt, err := rt.ExecuteTransaction(trx)
+	assert.Nil(t, err)
+	assert.False(t, receipt.Reverted)
+
+	// the staking clause is executed after the migration, on per entry storage
+	assert.True(t, s.IsStakingEntryLayout())
+	assert.Equal(t, 3, s.GetBucketList().Len())
+	bkt := s.GetBucket(tests.BucketID(tests.Voter2Addr, ts, txNonce))
+	assert.NotNil(t, bkt)
+	assert.Equal(t, boundAmount.String(), bkt.Value.String())
+
+	candAfter := s.GetCandidate(tests.Cand2Addr)
+	assert.NotNil(t, candAfter)
+	assert.Equal(t, 3, len(candAfter.Buckets))
+	assert.Equal(t, boundAmount.String(), new(big.Int).Sub(candAfter.TotalVotes, cand.TotalVotes).String())
+}
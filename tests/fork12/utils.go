@@ -0,0 +1,62 @@
+package fork12
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/meterio/meter-pov/builtin"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/runtime"
+	"github.com/meterio/meter-pov/script"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tests"
+	"github.com/meterio/meter-pov/xenv"
+)
+
+// initRuntimeAtFork12 returns a runtime at the first fork12 block, with staking state still in the rlp lists.
+func initRuntimeAtFork12() (*runtime.Runtime, *state.State, uint64, byte) {
+	tests.InitLogger()
+	kv, _ := lvldb.NewMem()
+	meter.InitBlockChainConfig("main")
+	ts := uint64(time.Now().Unix())
+
+	b0 := tests.BuildGenesis(kv, func(state *state.State) error {
+		state.SetCode(builtin.Prototype.Address, builtin.Prototype.RuntimeBytecodes())
+		state.SetCode(builtin.Executor.Address, builtin.Executor.RuntimeBytecodes())
+		state.SetCode(builtin.Params.Address, builtin.Params.RuntimeBytecodes())
+		builtin.Params.Native(state).Set(meter.KeyExecutorAddress, new(big.Int).SetBytes(builtin.Executor.Address[:]))
+
+		// testing env set up like this:
+		// 1 candidate: Cand2
+		// 2 votes: Voter->Cand2, Cand2->Cand2(self)
+		selfBkt := meter.NewBucket(tests.Cand2Addr, tests.Cand2Addr, tests.BuildAmount(2000), meter.MTRG, meter.FOREVER_LOCK, meter.FOREVER_LOCK_RATE, 100, 0, 0)
+		bkt := meter.NewBucket(tests.VoterAddr, tests.Cand2Addr, tests.BuildAmount(1000), meter.MTRG, meter.ONE_WEEK_LOCK, meter.ONE_WEEK_LOCK_RATE, 100, 0, 0)
+		state.SetBucketList(meter.NewBucketList([]*meter.Bucket{selfBkt, bkt}))
+		state.SetBoundedBalance(tests.VoterAddr, tests.BuildAmount(1000))
+
+		cand := meter.NewCandidate(tests.Cand2Addr, tests.Cand2Name, tests.Cand2Desc, tests.Cand2PubKey, tests.Cand2IP, tests.Cand2Port, 5e9, ts-meter.MIN_CANDIDATE_UPDATE_INTV-10)
+		cand.AddBucket(selfBkt)
+		cand.AddBucket(bkt)
+		state.SetCandidateList(meter.NewCandidateList([]*meter.Candidate{cand}))
+
+		state.AddEnergy(tests.Voter2Addr, tests.BuildAmount(100))
+		state.AddBalance(tests.Voter2Addr, tests.BuildAmount(1000))
+		return nil
+	})
+
+	c, _ := chain.New(kv, b0, false)
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+	seeker := c.NewSeeker(b0.ID())
+	sc := state.NewCreator(kv)
+	se := script.NewScriptEngine(c, sc)
+	se.StartTeslaForkModules()
+
+	rt := runtime.New(seeker, st,
+		&xenv.BlockContext{Time: ts,
+			Number: meter.TeslaFork12_MainnetStartNum + 1,
+			Signer: tests.HolderAddr})
+
+	return rt, st, ts, c.Tag()
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/meterio/meter-pov/vm"
+)
+
+// callFrame is a call made during execution, fields are ordered and omitted as the javascript callTracer does.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     *hexutil.Uint64 `json:"gas,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Input   *hexutil.Bytes  `json:"input,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Time    string          `json:"time,omitempty"`
+	Calls   []*callFrame    `json:"calls,omitempty"`
+
+	gasIn   uint64
+	gasCost uint64
+	gas     *uint64 // gas allowance observed inside the call
+	outOff  *big.Int
+	outLen  *big.Int
+}
+
+// callTracer reports all the internal calls made by a transaction, it's the go port of call_tracer.js.
+type callTracer struct {
+	callstack []*callFrame
+	// descended tracks whether we've just descended from an outer call into an inner one
+	descended bool
+
+	// top level call, which is reported by CaptureStart and CaptureEnd
+	root     callFrame
+	rootErr  string
+	rootTime time.Duration
+
+	err       error
+	interrupt uint32
+	reason    error
+}
+
+func newCallTracer(cfg json.RawMessage) (Tracer, error) {
+	return &callTracer{callstack: []*callFrame{{}}}, nil
+}
+
+func bytesPtr(b []byte) *hexutil.Bytes {
+	return (*hexutil.Bytes)(&b)
+}
+
+func uint64Ptr(n uint64) *hexutil.Uint64 {
+	return (*hexutil.Uint64)(&n)
+}
+
+func (t *callTracer) top() *callFrame {
+	return t.callstack[len(t.callstack)-1]
+}
+
+func (t *callTracer) pop() *callFrame {
+	call := t.top()
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	return call
+}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (t *callTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.root.Type = "CALL"
+	if create {
+		t.root.Type = "CREATE"
+	}
+	t.root.From = from
+	t.root.To = &to
+	t.root.Input = bytesPtr(input)
+	t.root.Gas = uint64Ptr(gas)
+	t.root.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (t *callTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.err != nil {
+		return nil
+	}
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.err = t.reason
+		return nil
+	}
+	if err != nil {
+		t.fault(err)
+		return nil
+	}
+
+	switch op {
+	case vm.CREATE, vm.CREATE2:
+		t.callstack = append(t.callstack, &callFrame{
+			Type:    op.String(),
+			From:    contract.Address(),
+			Input:   bytesPtr(memorySlice(memory, stack.Back(1), stack.Back(2))),
+			Value:   (*hexutil.Big)(new(big.Int).Set(stack.Back(0))),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+		t.descended = true
+		return nil
+	case vm.SELFDESTRUCT:
+		to := common.BigToAddress(stack.Back(0))
+		parent := t.top()
+		parent.Calls = append(parent.Calls, &callFrame{
+			Type:  op.String(),
+			From:  contract.Address(),
+			To:    &to,
+			Value: (*hexutil.Big)(env.StateDB.GetBalance(contract.Address())),
+		})
+		return nil
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		to := common.BigToAddress(stack.Back(1))
+		// skip pre-compiles, those are just fancy opcodes
+		if env.IsPrecompile(to) {
+			return nil
+		}
+		off := 1
+		if op == vm.DELEGATECALL || op == vm.STATICCALL {
+			off = 0
+		}
+		call := &callFrame{
+			Type:    op.String(),
+			From:    contract.Address(),
+			To:      &to,
+			Input:   bytesPtr(memorySlice(memory, stack.Back(2+off), stack.Back(3+off))),
+			gasIn:   gas,
+			gasCost: cost,
+			outOff:  new(big.Int).Set(stack.Back(4 + off)),
+			outLen:  new(big.Int).Set(stack.Back(5 + off)),
+		}
+		if off == 1 {
+			call.Value = (*hexutil.Big)(new(big.Int).Set(stack.Back(2)))
+		}
+		t.callstack = append(t.callstack, call)
+		t.descended = true
+		return nil
+	}
+
+	// just descended into an inner call, retrieve its true allowance, which differs from the requested
+	// one due to the 2300 stipend and 63/64 rule
+	if t.descended {
+		if depth >= len(t.callstack) {
+			g := gas
+			t.top().gas = &g
+		}
+		t.descended = false
+	}
+	if op == vm.REVERT {
+		t.top().Error = "execution reverted"
+		return nil
+	}
+	if depth == len(t.callstack)-1 {
+		// pop off the last call and get the execution results
+		call := t.pop()
+		ret := stack.Back(0)
+		if call.Type == "CREATE" || call.Type == "CREATE2" {
+			call.GasUsed = uint64Ptr(call.gasIn - call.gasCost - gas)
+			if ret.Sign() != 0 {
+				to := common.BigToAddress(ret)
+				call.To = &to
+				call.Output = bytesPtr(env.StateDB.GetCode(to))
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		} else {
+			if call.gas != nil {
+				call.GasUsed = uint64Ptr(call.gasIn - call.gasCost + *call.gas - gas)
+			}
+			if ret.Sign() != 0 {
+				call.Output = bytesPtr(memorySlice(memory, call.outOff, call.outLen))
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		}
+		if call.gas != nil {
+			call.Gas = uint64Ptr(*call.gas)
+		}
+		parent := t.top()
+		parent.Calls = append(parent.Calls, call)
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault while running an opcode.
+func (t *callTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.err == nil {
+		t.fault(err)
+	}
+	return nil
+}
+
+func (t *callTracer) fault(err error) {
+	// the topmost call already reverted, don't handle the additional fault again
+	if t.top().Error != "" {
+		return
+	}
+	// pop off the just failed call, and consume all available gas
+	call := t.pop()
+	call.Error = err.Error()
+	if call.gas != nil {
+		call.Gas = uint64Ptr(*call.gas)
+		call.GasUsed = call.Gas
+	}
+	// flatten the failed call into its parent
+	if len(t.callstack) > 0 {
+		parent := t.top()
+		parent.Calls = append(parent.Calls, call)
+		return
+	}
+	// last call failed too, leave it in the stack
+	t.callstack = append(t.callstack, call)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	t.root.Output = bytesPtr(output)
+	t.root.GasUsed = uint64Ptr(gasUsed)
+	t.rootTime = d
+	if err != nil {
+		t.rootErr = err.Error()
+	}
+	return nil
+}
+
+// GetResult returns the json encoded call frames, or any error occurred while tracing.
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	result := t.root
+	result.Time = t.rootTime.String()
+	result.Calls = t.callstack[0].Calls
+	if t.callstack[0].Error != "" {
+		result.Error = t.callstack[0].Error
+	} else {
+		result.Error = t.rootErr
+	}
+	if result.Error != "" && (result.Error != "execution reverted" || result.Output == nil || len(*result.Output) == 0) {
+		result.Output = nil
+	}
+	return json.Marshal(&result)
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *callTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package native implements tracers in go, which are much faster than the javascript ones of the same name.
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/vm"
+)
+
+// ErrUnknownTracer is returned by New if there is no native tracer of the name.
+var ErrUnknownTracer = errors.New("unknown native tracer")
+
+// Tracer is a native tracer, which collects the result while executing, and encodes it as json.
+type Tracer interface {
+	vm.Tracer
+	GetResult() (json.RawMessage, error)
+	Stop(err error)
+}
+
+// StateTracer is implemented by tracers which read the state besides what the EVM touches.
+// The state should be set before execution.
+type StateTracer interface {
+	SetState(st *state.State)
+}
+
+var ctors = map[string]func(cfg json.RawMessage) (Tracer, error){
+	"callTracer":     newCallTracer,
+	"prestateTracer": newPrestateTracer,
+}
+
+// New creates the native tracer of name, cfg is the tracer specific config and can be empty.
+func New(name string, cfg json.RawMessage) (Tracer, error) {
+	ctor, ok := ctors[name]
+	if !ok {
+		return nil, ErrUnknownTracer
+	}
+	return ctor(cfg)
+}
+
+// memorySlice returns a copy of memory in [off, off+size), nil if out of range.
+func memorySlice(m *vm.Memory, off, size *big.Int) []byte {
+	if !off.IsUint64() || !size.IsUint64() {
+		return nil
+	}
+	start, end := off.Uint64(), off.Uint64()+size.Uint64()
+	if end < start || end > uint64(m.Len()) {
+		return nil
+	}
+	return m.Get(int64(start), int64(size.Uint64()))
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package native_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/meterio/meter-pov/chain"
+	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/runtime"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/tracers"
+	"github.com/meterio/meter-pov/tracers/native"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/meterio/meter-pov/vm"
+	"github.com/meterio/meter-pov/xenv"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	callerAddr = meter.BytesToAddress([]byte("caller"))
+	calleeAddr = meter.BytesToAddress([]byte("callee"))
+)
+
+// callee stores 1 at slot 0, and returns 42
+var calleeCode = common.FromHex("600160005560" + "2a60005260206000f3")
+
+// caller calls callee and returns its output
+var callerCode = common.FromHex("60206000600060006000" + "73" + common.Bytes2Hex(calleeAddr.Bytes()) + "61fffff15060206000f3")
+
+// execute runs the caller contract with tracer and returns the result.
+func execute(t *testing.T, tracer tracers.ResultTracer) map[string]interface{} {
+	meter.InitBlockChainConfig("test")
+	kv, _ := lvldb.NewMem()
+	b0, _, err := genesis.NewDevnet().Build(state.NewCreator(kv))
+	assert.Nil(t, err)
+	ch, _ := chain.New(kv, b0, false)
+
+	st, _ := state.New(b0.Header().StateRoot(), kv)
+	st.SetCode(callerAddr, callerCode)
+	st.SetCode(calleeAddr, calleeCode)
+	st.SetBalance(calleeAddr, big.NewInt(100))
+
+	if s, ok := tracer.(native.StateTracer); ok {
+		s.SetState(st)
+	}
+	rt := runtime.New(ch.NewSeeker(b0.ID()), st, &xenv.BlockContext{Time: b0.Timestamp()})
+	rt.SetVMConfig(vm.Config{Debug: true, Tracer: tracer})
+	out := rt.ExecuteClause(tx.NewClause(&callerAddr), 0, 1000000, &xenv.TransactionContext{Origin: genesis.DevAccounts()[0].Address})
+	assert.Nil(t, out.VMErr)
+
+	raw, err := tracer.GetResult()
+	assert.Nil(t, err)
+	var res map[string]interface{}
+	assert.Nil(t, json.Unmarshal(raw, &res))
+	return res
+}
+
+func TestCallTracer(t *testing.T) {
+	tr, err := native.New("callTracer", nil)
+	assert.Nil(t, err)
+	res := execute(t, tr)
+
+	code, _ := tracers.CodeByName("callTracer")
+	jsTracer, err := tracers.New(code)
+	assert.Nil(t, err)
+	expected := execute(t, jsTracer)
+
+	// same as the javascript tracer, except the elapsed time
+	delete(res, "time")
+	delete(expected, "time")
+	assert.Equal(t, expected, res)
+
+	calls := res["calls"].([]interface{})
+	assert.Equal(t, 1, len(calls))
+	call := calls[0].(map[string]interface{})
+	assert.Equal(t, "CALL", call["type"])
+	assert.Equal(t, calleeAddr.String(), call["to"])
+	assert.Equal(t, "0x000000000000000000000000000000000000000000000000000000000000002a", call["output"])
+}
+
+func TestPrestateTracer(t *testing.T) {
+	tr, err := native.New("prestateTracer", nil)
+	assert.Nil(t, err)
+	res := execute(t, tr)
+
+	callee := res[calleeAddr.String()].(map[string]interface{})
+	assert.Equal(t, "0x64", callee["balance"])
+	assert.Equal(t, map[string]interface{}{
+		"0x0000000000000000000000000000000000000000000000000000000000000000": "0x0000000000000000000000000000000000000000000000000000000000000000",
+	}, callee["storage"])
+	assert.Contains(t, res, callerAddr.String())
+
+	tr, err = native.New("prestateTracer", json.RawMessage(`{"diffMode": true}`))
+	assert.Nil(t, err)
+	res = execute(t, tr)
+
+	pre := res["pre"].(map[string]interface{})
+	post := res["post"].(map[string]interface{})
+	// only callee is modified
+	assert.Equal(t, 1, len(pre))
+	assert.Equal(t, 1, len(post))
+	assert.Equal(t, map[string]interface{}{
+		"storage": map[string]interface{}{
+			"0x0000000000000000000000000000000000000000000000000000000000000000": "0x0000000000000000000000000000000000000000000000000000000000000001",
+		},
+	}, post[calleeAddr.String()])
+
+	_, err = native.New("noSuchTracer", nil)
+	assert.Equal(t, native.ErrUnknownTracer, err)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package native
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/vm"
+)
+
+type prestateTracerConfig struct {
+	DiffMode bool `json:"diffMode"` // report both pre and post state of modified accounts
+}
+
+type account struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"` // MTRG
+	Energy  *hexutil.Big                `json:"energy,omitempty"`  // MTR
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+
+	exists bool
+}
+
+type prestateDiff struct {
+	Pre  map[common.Address]*account `json:"pre"`
+	Post map[common.Address]*account `json:"post"`
+}
+
+// prestateTracer reports the state touched by a transaction before execution, which is sufficient to
+// replay it locally. In diff mode, it reports the pre and post state of modified accounts instead.
+type prestateTracer struct {
+	config prestateTracerConfig
+	state  *state.State
+
+	pre     map[common.Address]*account
+	diff    *prestateDiff // computed once execution ends in diff mode
+	created map[common.Address]bool
+	// depths of pending CREATE and CREATE2, whose address is on stack once returned
+	creating []int
+
+	err       error
+	interrupt uint32
+	reason    error
+}
+
+func newPrestateTracer(cfg json.RawMessage) (Tracer, error) {
+	var config prestateTracerConfig
+	if len(cfg) > 0 {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	return &prestateTracer{
+		config:  config,
+		pre:     make(map[common.Address]*account),
+		created: make(map[common.Address]bool),
+	}, nil
+}
+
+// SetState sets the state the transaction is executed on.
+func (t *prestateTracer) SetState(st *state.State) {
+	t.state = st
+}
+
+// lookupAccount adds the account to prestate if not yet.
+func (t *prestateTracer) lookupAccount(addr common.Address) {
+	if _, ok := t.pre[addr]; ok {
+		return
+	}
+	maddr := meter.Address(addr)
+	t.pre[addr] = &account{
+		Balance: (*hexutil.Big)(t.state.GetBalance(maddr)),
+		Energy:  (*hexutil.Big)(t.state.GetEnergy(maddr)),
+		Code:    t.state.GetCode(maddr),
+		Storage: make(map[common.Hash]common.Hash),
+		exists:  t.state.Exists(maddr),
+	}
+}
+
+// lookupStorage adds the storage slot to prestate if not yet.
+func (t *prestateTracer) lookupStorage(addr common.Address, key common.Hash) {
+	t.lookupAccount(addr)
+	acc := t.pre[addr]
+	if _, ok := acc.Storage[key]; ok {
+		return
+	}
+	acc.Storage[key] = common.Hash(t.state.GetStorage(meter.Address(addr), meter.Bytes32(key)))
+}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (t *prestateTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	if t.state == nil {
+		t.err = errors.New("prestate tracer: state not set")
+		return nil
+	}
+	// value is not transferred yet, no need to fix balances later
+	t.lookupAccount(from)
+	t.lookupAccount(to)
+	if create {
+		t.created[to] = true
+	}
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (t *prestateTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.err != nil {
+		return nil
+	}
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.err = t.reason
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+
+	// back from a creation, the created address is on top of stack
+	if n := len(t.creating); n > 0 && t.creating[n-1] == depth {
+		t.creating = t.creating[:n-1]
+		if ret := stack.Back(0); ret.Sign() != 0 {
+			addr := common.BigToAddress(ret)
+			t.created[addr] = true
+			if _, ok := t.pre[addr]; !ok {
+				t.pre[addr] = &account{
+					Balance: (*hexutil.Big)(new(big.Int)),
+					Energy:  (*hexutil.Big)(new(big.Int)),
+					Storage: make(map[common.Hash]common.Hash),
+				}
+			}
+		}
+	}
+
+	switch op {
+	case vm.SLOAD, vm.SSTORE:
+		t.lookupStorage(contract.Address(), common.BigToHash(stack.Back(0)))
+	case vm.BALANCE, vm.EXTCODESIZE, vm.EXTCODECOPY, vm.EXTCODEHASH, vm.SELFDESTRUCT:
+		t.lookupAccount(common.BigToAddress(stack.Back(0)))
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		t.lookupAccount(common.BigToAddress(stack.Back(1)))
+	case vm.CREATE, vm.CREATE2:
+		t.creating = append(t.creating, depth)
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault while running an opcode.
+func (t *prestateTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd is called after the call finishes, the state is final at this point.
+func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	if t.err != nil || !t.config.DiffMode {
+		return nil
+	}
+	t.diff = &prestateDiff{
+		Pre:  make(map[common.Address]*account),
+		Post: make(map[common.Address]*account),
+	}
+	for addr, pre := range t.pre {
+		maddr := meter.Address(addr)
+		if !t.state.Exists(maddr) {
+			// self destructed accounts are only in pre state
+			if pre.exists && !t.created[addr] {
+				t.diff.Pre[addr] = pre
+			}
+			continue
+		}
+		var (
+			modified bool
+			preAcc   = &account{Balance: pre.Balance, Energy: pre.Energy, Code: pre.Code, Storage: make(map[common.Hash]common.Hash)}
+			postAcc  = &account{Storage: make(map[common.Hash]common.Hash)}
+		)
+		if bal := t.state.GetBalance(maddr); bal.Cmp(pre.Balance.ToInt()) != 0 {
+			postAcc.Balance = (*hexutil.Big)(bal)
+			modified = true
+		}
+		if energy := t.state.GetEnergy(maddr); energy.Cmp(pre.Energy.ToInt()) != 0 {
+			postAcc.Energy = (*hexutil.Big)(energy)
+			modified = true
+		}
+		if code := t.state.GetCode(maddr); !bytes.Equal(code, pre.Code) {
+			postAcc.Code = code
+			modified = true
+		}
+		// only modified storage slots are reported
+		for key, val := range pre.Storage {
+			newVal := common.Hash(t.state.GetStorage(maddr, meter.Bytes32(key)))
+			if newVal == val {
+				continue
+			}
+			modified = true
+			preAcc.Storage[key] = val
+			if newVal != (common.Hash{}) {
+				postAcc.Storage[key] = newVal
+			}
+		}
+		if !modified {
+			continue
+		}
+		// created accounts are empty before execution
+		if !t.created[addr] {
+			t.diff.Pre[addr] = preAcc
+		}
+		t.diff.Post[addr] = postAcc
+	}
+	return nil
+}
+
+// GetResult returns the json encoded prestate, or pre and post state in diff mode.
+func (t *prestateTracer) GetResult() (json.RawMessage, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	if t.config.DiffMode {
+		if t.diff == nil {
+			return nil, errors.New("prestate tracer: execution not finished")
+		}
+		return json.Marshal(t.diff)
+	}
+	pre := make(map[common.Address]*account, len(t.pre))
+	for addr, acc := range t.pre {
+		// created accounts are empty before execution
+		if !t.created[addr] {
+			pre[addr] = acc
+		}
+	}
+	return json.Marshal(pre)
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *prestateTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
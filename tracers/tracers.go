@@ -18,10 +18,14 @@
 package tracers
 
 import (
+	"encoding/json"
+	"errors"
 	"strings"
 	"unicode"
 
 	"github.com/meterio/meter-pov/tracers/internal/tracers"
+	"github.com/meterio/meter-pov/tracers/native"
+	"github.com/meterio/meter-pov/vm"
 )
 
 // all contains all the built in JavaScript tracers by name.
@@ -51,3 +55,24 @@ func tracer(name string) (string, bool) {
 	}
 	return "", false
 }
+
+// ResultTracer is a vm tracer which outputs its result as json, both JavaScript
+// and native tracers are.
+type ResultTracer interface {
+	vm.Tracer
+	GetResult() (json.RawMessage, error)
+	Stop(err error)
+}
+
+// NewByName creates the tracer of name. Native tracers take precedence over the
+// JavaScript ones of the same name, and only native tracers accept cfg.
+func NewByName(name string, cfg json.RawMessage) (ResultTracer, error) {
+	if tr, err := native.New(name, cfg); err != native.ErrUnknownTracer {
+		return tr, err
+	}
+	code, ok := tracer(name)
+	if !ok {
+		return nil, errors.New("unsupported tracer")
+	}
+	return New(code)
+}
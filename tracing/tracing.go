@@ -0,0 +1,109 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+// Package tracing traces the block lifecycle with OpenTelemetry spans.
+// Spans are dropped unless Init is called with an exporter.
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/meterio/meter-pov"
+
+var propagator = propagation.TraceContext{}
+
+// Options options for tracing.
+type Options struct {
+	Endpoint string // OTLP/HTTP collector address, e.g. localhost:4318
+	File     string // path of the file spans are appended to as JSON
+	Node     string // name of this node, set as the service instance
+}
+
+// Init installs the global tracer provider exporting spans as set in options.
+// The returned func flushes and stops the exporters.
+func Init(options Options) (func(context.Context) error, error) {
+	var providerOpts []sdktrace.TracerProviderOption
+	closers := make([]func() error, 0)
+
+	if options.Endpoint != "" {
+		exporter, err := otlptracehttp.New(context.Background(),
+			otlptracehttp.WithEndpoint(options.Endpoint),
+			otlptracehttp.WithInsecure())
+		if err != nil {
+			return nil, errors.WithMessage(err, "otlp exporter")
+		}
+		providerOpts = append(providerOpts, sdktrace.WithBatcher(exporter))
+	}
+	if options.File != "" {
+		f, err := os.OpenFile(options.File, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, errors.WithMessage(err, "open trace file")
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, errors.WithMessage(err, "file exporter")
+		}
+		providerOpts = append(providerOpts, sdktrace.WithBatcher(exporter))
+		closers = append(closers, f.Close)
+	}
+	if len(providerOpts) == 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName("meter"),
+		semconv.ServiceInstanceID(options.Node))
+	provider := sdktrace.NewTracerProvider(append(providerOpts, sdktrace.WithResource(res))...)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		for _, c := range closers {
+			if e := c(); err == nil {
+				err = e
+			}
+		}
+		return err
+	}, nil
+}
+
+// Start starts a span named name as a child of the span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// Inject returns the trace context of ctx in W3C format, or nil if ctx is not traced.
+func Inject(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier
+}
+
+// Extract returns a context carrying the remote trace context injected by Inject.
+func Extract(carrier map[string]string) context.Context {
+	return propagator.Extract(context.Background(), propagation.MapCarrier(carrier))
+}
+
+// ContextWith returns a context carrying span context sc, to continue a trace later.
+func ContextWith(sc trace.SpanContext) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), sc)
+}
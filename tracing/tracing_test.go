@@ -0,0 +1,38 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInjectExtract(t *testing.T) {
+	assert.Nil(t, Inject(context.Background()))
+
+	provider := sdktrace.NewTracerProvider()
+	defer provider.Shutdown(context.Background())
+	ctx, span := provider.Tracer(tracerName).Start(context.Background(), "proposal")
+	defer span.End()
+
+	carrier := Inject(ctx)
+	assert.NotEmpty(t, carrier)
+
+	// the receiver continues the trace of the proposer
+	remote := trace.SpanContextFromContext(Extract(carrier))
+	assert.True(t, remote.IsRemote())
+	assert.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), remote.SpanID())
+
+	// a message without trace context starts no trace
+	assert.False(t, trace.SpanContextFromContext(Extract(nil)).IsValid())
+
+	assert.Equal(t, span.SpanContext(), trace.SpanContextFromContext(ContextWith(span.SpanContext())))
+}
@@ -18,6 +18,7 @@ package trie
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -162,3 +163,50 @@ func get(tn node, key []byte) ([]byte, node) {
 		}
 	}
 }
+
+// ProofSet is an in-memory collection of proof nodes keyed by node hash.
+// It's used to collect proofs with Prove and to verify them with VerifyProof.
+type ProofSet map[meter.Bytes32][]byte
+
+// NewProofSet creates a proof set from encoded proof nodes.
+func NewProofSet(nodes [][]byte) ProofSet {
+	ps := make(ProofSet, len(nodes))
+	for _, n := range nodes {
+		ps[meter.Blake2b(n)] = n
+	}
+	return ps
+}
+
+func (ps ProofSet) Put(key, value []byte) error {
+	ps[meter.BytesToBytes32(key)] = append([]byte(nil), value...)
+	return nil
+}
+
+func (ps ProofSet) Get(key []byte) ([]byte, error) {
+	if v, ok := ps[meter.BytesToBytes32(key)]; ok {
+		return v, nil
+	}
+	return nil, errors.New("proof node not found")
+}
+
+func (ps ProofSet) Has(key []byte) (bool, error) {
+	_, ok := ps[meter.BytesToBytes32(key)]
+	return ok, nil
+}
+
+// Nodes returns the encoded proof nodes.
+func (ps ProofSet) Nodes() [][]byte {
+	nodes := make([][]byte, 0, len(ps))
+	for _, n := range ps {
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// ProofList collects encoded proof nodes in the order they are written, from the root to the leaf.
+type ProofList [][]byte
+
+func (l *ProofList) Put(key, value []byte) error {
+	*l = append(*l, append([]byte(nil), value...))
+	return nil
+}
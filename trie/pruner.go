@@ -223,16 +223,11 @@ func (p *Pruner) updateBloomWithTrie(root meter.Bytes32) {
 }
 
 func (p *Pruner) PrintStats() {
-	if stats, err := p.db.Stat("leveldb.stats"); err != nil {
+	if stats, err := p.db.Stat(kv.StatsProperty); err != nil {
 		slog.Warn("Failed to read database stats", "error", err)
 	} else {
 		fmt.Println(stats)
 	}
-	if ioStats, err := p.db.Stat("leveldb.iostats"); err != nil {
-		slog.Warn("Failed to read database iostats", "error", err)
-	} else {
-		fmt.Println(ioStats)
-	}
 }
 
 func (p *Pruner) Compact() {
@@ -531,6 +526,12 @@ type pruneIterator struct {
 	mark      func(key []byte)
 }
 
+// NewPruneIterator creates an iterator over the nodes of trie, the subtree under a hash node is skipped
+// if canSkip returns true for it, otherwise the hash node is passed to mark before it's resolved.
+func NewPruneIterator(trie *Trie, canSkip func([]byte) bool, mark func([]byte), loadOrGet func(key []byte) ([]byte, error)) PruneIterator {
+	return newPruneIterator(trie, canSkip, mark, loadOrGet)
+}
+
 func newPruneIterator(trie *Trie, canSkip func([]byte) bool, mark func([]byte), loadOrGet func(key []byte) ([]byte, error)) *pruneIterator {
 	if trie.Hash() == emptyState {
 		return new(pruneIterator)
@@ -104,13 +104,10 @@ func (s *TrieSync) AddSubTrie(root meter.Bytes32, depth int, parent meter.Bytes3
 		return
 	}
 	key := root.Bytes()
-	blob, err := s.database.Get(key)
-	if err != nil {
-		return
-	}
-
-	if local, err := decodeNode(key, blob, 0); local != nil && err == nil {
-		return
+	if blob, err := s.database.Get(key); err == nil {
+		if local, err := decodeNode(key, blob, 0); local != nil && err == nil {
+			return
+		}
 	}
 	// Assemble the new sub-trie sync request
 	req := &request{
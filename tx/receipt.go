@@ -35,6 +35,8 @@ type Output struct {
 	Events Events
 	// transfer occurred in clause
 	Transfers Transfers
+	// staking changes made by clause, excluded from receipt encoding
+	StakingRecords StakingRecords `rlp:"-"`
 }
 
 // Receipts slice of receipts.
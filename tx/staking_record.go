@@ -0,0 +1,43 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package tx
+
+import (
+	"math/big"
+
+	"github.com/meterio/meter-pov/meter"
+)
+
+// StakingRecord is a bucket or candidate changed by a staking op of script engine.
+// It's recorded for staking history, and not part of the receipt.
+type StakingRecord struct {
+	Op         string        // name of staking op, e.g. Bound, Delegate, Governing
+	BucketID   meter.Bytes32 // zero for candidate records
+	Owner      meter.Address // bucket owner
+	Candidate  meter.Address
+	Value      *big.Int // bucket value, nil for candidate records
+	TotalVotes *big.Int
+	Autobid    uint8
+	Unbounded  bool
+	Removed    bool // bucket or candidate is removed by the op, other fields are the ones before removal
+}
+
+// AutobidOp is the op of autobid records, made by governing when rewards are autobid.
+// The bidder is the candidate of the record and the bid amount is the value.
+const AutobidOp = "Autobid"
+
+// IsCandidate returns whether it's a candidate record.
+func (r *StakingRecord) IsCandidate() bool {
+	return r.BucketID.IsZero() && !r.IsAutobid()
+}
+
+// IsAutobid returns whether it's an autobid record.
+func (r *StakingRecord) IsAutobid() bool {
+	return r.Op == AutobidOp
+}
+
+// StakingRecords slice of staking records.
+type StakingRecords []*StakingRecord
@@ -237,6 +237,18 @@ func (t *Transaction) GetEthTx() (*types.Transaction, error) {
 	return &ethTx, nil
 }
 
+// AccessList returns the access list of a typed ethereum tx, or nil for other txs.
+func (t *Transaction) AccessList() types.AccessList {
+	if t.Type() == 0 {
+		return nil
+	}
+	ethTx, err := t.GetEthTx()
+	if err != nil {
+		return nil
+	}
+	return ethTx.AccessList()
+}
+
 func (t *Transaction) Type() byte {
 	if t.IsEthTx() && len(t.body.Reserved) >= 3 {
 		rawEthTx := t.body.Reserved[2].([]byte)
@@ -288,6 +300,9 @@ func ChainIdValidate(chainId *big.Int) (bool, error) {
 	if meter.IsTestNet() && chainId.Cmp(new(big.Int).SetUint64(meter.TestnetChainID)) != 0 {
 		return false, errors.New(fmt.Sprintf("wrong testNet chainId %v", chainId))
 	}
+	if meter.IsCustomNet() && chainId.Cmp(new(big.Int).SetUint64(meter.ChainID())) != 0 {
+		return false, errors.New(fmt.Sprintf("wrong chainId %v", chainId))
+	}
 
 	return true, nil
 }
@@ -727,6 +742,13 @@ func IntrinsicGas(isEIP2028 bool, isEIP3860 bool, clauses ...*Clause) (uint64, e
 	return total, nil
 }
 
+// AccessListGas returns intrinsic gas of the EIP-2930 access list, which is charged
+// on top of IntrinsicGas when the tx is executed in a fork13 block.
+func (t *Transaction) AccessListGas() uint64 {
+	list := t.AccessList()
+	return uint64(len(list))*params.TxAccessListAddressGas + uint64(list.StorageKeys())*params.TxAccessListStorageKeyGas
+}
+
 // see core.IntrinsicGas
 func dataGas(data []byte, isEIP2028 bool) (uint64, error) {
 	if len(data) == 0 {
@@ -0,0 +1,130 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"io"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/pkg/errors"
+)
+
+var errNoActiveJournal = errors.New("no active journal")
+
+// txJournal is a rotating log of local txs, so that they survive node restarts.
+// txs are rlp encoded and appended to the file one by one.
+type txJournal struct {
+	lock   sync.Mutex
+	path   string
+	limit  int // max count of txs in the journal
+	count  int
+	writer io.WriteCloser // nil until first rotated
+}
+
+func newTxJournal(path string, limit int) *txJournal {
+	return &txJournal{path: path, limit: limit}
+}
+
+// load parses the journal and feeds txs to add. It returns the count of
+// loaded txs and the count of txs rejected by add.
+func (j *txJournal) load(add func(tx *tx.Transaction) error) (loaded int, dropped int, err error) {
+	f, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	stream := rlp.NewStream(f, 0)
+	for {
+		var trx tx.Transaction
+		if err := stream.Decode(&trx); err != nil {
+			if err == io.EOF {
+				return loaded, dropped, nil
+			}
+			// the tail may be corrupted by an unclean shutdown
+			return loaded, dropped, errors.WithMessage(err, "decode journal")
+		}
+		loaded++
+		if err := add(&trx); err != nil {
+			dropped++
+		}
+	}
+}
+
+// insert appends a tx to the journal. The tx is ignored if the journal is full.
+func (j *txJournal) insert(trx *tx.Transaction) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer == nil {
+		return errNoActiveJournal
+	}
+	if j.count >= j.limit {
+		return errors.New("journal is full")
+	}
+	if err := rlp.Encode(j.writer, trx); err != nil {
+		return err
+	}
+	j.count++
+	return nil
+}
+
+// rotate regenerates the journal with the given txs, which are truncated to the limit.
+func (j *txJournal) rotate(txs tx.Transactions) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+		j.writer = nil
+	}
+	if len(txs) > j.limit {
+		txs = txs[:j.limit]
+	}
+
+	replacement, err := os.OpenFile(j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	for _, trx := range txs {
+		if err := rlp.Encode(replacement, trx); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	replacement.Close()
+
+	if err := os.Rename(j.path+".new", j.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	j.writer = sink
+	j.count = len(txs)
+	return nil
+}
+
+func (j *txJournal) close() error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	var err error
+	if j.writer != nil {
+		err = j.writer.Close()
+		j.writer = nil
+	}
+	return err
+}
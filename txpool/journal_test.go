@@ -0,0 +1,54 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/meterio/meter-pov/genesis"
+	"github.com/meterio/meter-pov/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxJournal(t *testing.T) {
+	journal := newTxJournal(filepath.Join(t.TempDir(), "txpool.journal"), 2)
+	defer journal.close()
+
+	var txs tx.Transactions
+	for i := 0; i < 3; i++ {
+		txs = append(txs, newTx(0, nil, 21000, tx.BlockRef{}, 100, nil, genesis.DevAccounts()[i]))
+	}
+
+	// inactive before rotated
+	assert.Equal(t, errNoActiveJournal, journal.insert(txs[0]))
+
+	assert.Nil(t, journal.rotate(txs[:1]))
+	assert.Nil(t, journal.insert(txs[1]))
+	// over limit
+	assert.NotNil(t, journal.insert(txs[2]))
+
+	var loaded tx.Transactions
+	n, dropped, err := journal.load(func(trx *tx.Transaction) error {
+		loaded = append(loaded, trx)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+	assert.Zero(t, dropped)
+	assert.Equal(t, txs[:2].RootHash(), loaded.RootHash())
+
+	// truncated to the limit
+	assert.Nil(t, journal.rotate(txs))
+	loaded = nil
+	n, _, err = journal.load(func(trx *tx.Transaction) error {
+		loaded = append(loaded, trx)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, txs[:2].RootHash(), loaded.RootHash())
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package txpool
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	txsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "txpool_txs",
+		Help: "Count of txs in pool, by state (executable or non_executable)",
+	}, []string{"state"})
+	txsReceivedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "txpool_txs_received_total",
+		Help: "Counter of txs submitted to pool, by result (accepted, bad, rejected or error)",
+	}, []string{"result"})
+	txsWashedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "txpool_txs_washed_total",
+		Help: "Counter of txs washed out of pool",
+	})
+	washDurationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "txpool_wash_duration_seconds",
+		Help:    "Time taken to wash the pool",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 12),
+	})
+)
+
+// receivedResult returns the result label of a tx submitted with the given error.
+func receivedResult(err error) string {
+	switch {
+	case err == nil:
+		return "accepted"
+	case IsBadTx(err):
+		return "bad"
+	case IsTxRejected(err):
+		return "rejected"
+	default:
+		return "error"
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/chain"
 	"github.com/meterio/meter-pov/meter"
@@ -20,12 +21,20 @@ import (
 	"github.com/pkg/errors"
 )
 
+// reasons of txs not executable yet
+const (
+	ReasonDepMissing     = "dependency not found"
+	ReasonFutureBlockRef = "block ref in future"
+)
+
 type txObject struct {
 	*tx.Transaction
 	resolved *runtime.ResolvedTransaction
+	ethTx    *types.Transaction // decoded ethereum tx, nil for native tx
 
 	timeAdded       int64
 	executable      bool
+	local           bool     // submitted via local api, journaled if enabled
 	overallGasPrice *big.Int // don't touch this value, it's only be used in pool's housekeeping
 }
 
@@ -35,11 +44,17 @@ func resolveTx(tx *tx.Transaction) (*txObject, error) {
 		return nil, err
 	}
 
-	return &txObject{
+	txObj := &txObject{
 		Transaction: tx,
 		resolved:    resolved,
 		timeAdded:   time.Now().UnixNano(),
-	}, nil
+	}
+	if tx.IsEthTx() {
+		if txObj.ethTx, err = tx.GetEthTx(); err != nil {
+			return nil, err
+		}
+	}
+	return txObj, nil
 }
 
 func (o *txObject) Origin() meter.Address {
@@ -47,49 +62,66 @@ func (o *txObject) Origin() meter.Address {
 }
 
 func (o *txObject) Executable(chain *chain.Chain, state *state.State, headBlock *block.Header) (bool, error) {
+	executable, _, err := o.inspect(chain, state, headBlock)
+	return executable, err
+}
+
+// inspect checks whether the tx is executable on head block. For a tx that may be executable later,
+// the reason is returned, while err is returned if the tx should be evicted.
+func (o *txObject) inspect(chain *chain.Chain, state *state.State, headBlock *block.Header) (executable bool, reason string, err error) {
 	if o == nil {
 		slog.Error("tx object is nil")
-		return false, errors.New("txobject is null")
+		return false, "", errors.New("txobject is null")
 	}
 	switch {
 	case o.Gas() > headBlock.GasLimit():
-		return false, errors.New("gas too large")
+		return false, "", errors.New("gas too large")
 	case o.IsExpired(headBlock.Number()):
-		return false, errors.New("head block expired")
+		return false, "", errors.New("head block expired")
 	case o.BlockRef().Number() > headBlock.Number()+uint32(3600*24/meter.BlockInterval):
-		return false, errors.New("block ref out of schedule")
+		return false, "", errors.New("block ref out of schedule")
 	}
 
 	if has, err := chain.HasTransactionMeta(o.ID()); err != nil {
-		return false, err
+		return false, "", err
 	} else if has {
-		return false, errors.New("known tx")
+		return false, "", errors.New("known tx")
 	}
 
 	if dep := o.DependsOn(); dep != nil {
 		txMeta, err := chain.GetTransactionMeta(*dep, headBlock.ID())
 		if err != nil {
 			if chain.IsNotFound(err) {
-				return false, nil
+				return false, ReasonDepMissing, nil
 			}
-			return false, err
+			return false, "", err
 		}
 		if txMeta.Reverted {
-			return false, errors.New("dep reverted")
+			return false, "", errors.New("dep reverted")
 		}
 	}
 
 	if o.BlockRef().Number() > headBlock.Number() {
-		return false, nil
+		return false, ReasonFutureBlockRef, nil
 	}
 
 	checkpoint := state.NewCheckpoint()
 	defer state.RevertTo(checkpoint)
 
 	if _, _, _, _, err := o.resolved.BuyGas(state, headBlock.Timestamp()+meter.BlockInterval); err != nil {
-		return false, err
+		return false, "", err
+	}
+	return true, "", nil
+}
+
+// bumps returns whether the eth tx pays enough more than the old one to replace it,
+// both fee cap and tip cap should be raised by at least priceBump percent.
+func (o *txObject) bumps(old *txObject, priceBump uint64) bool {
+	bumped := func(newPrice, oldPrice *big.Int) bool {
+		threshold := new(big.Int).Mul(oldPrice, new(big.Int).SetUint64(100+priceBump))
+		return new(big.Int).Mul(newPrice, big.NewInt(100)).Cmp(threshold) >= 0
 	}
-	return true, nil
+	return bumped(o.ethTx.GasFeeCap(), old.ethTx.GasFeeCap()) && bumped(o.ethTx.GasTipCap(), old.ethTx.GasTipCap())
 }
 
 func sortTxObjsByOverallGasPriceDesc(txObjs []*txObject) {
@@ -98,3 +130,28 @@ func sortTxObjsByOverallGasPriceDesc(txObjs []*txObject) {
 		return gp1.Cmp(gp2) >= 0
 	})
 }
+
+// sortEthTxObjsByNonce reorders eth txs of each origin by nonce ascending, in the positions they take.
+func sortEthTxObjsByNonce(txObjs []*txObject) {
+	positions := make(map[meter.Address][]int)
+	for i, txObj := range txObjs {
+		if txObj.ethTx != nil {
+			positions[txObj.Origin()] = append(positions[txObj.Origin()], i)
+		}
+	}
+	for _, pos := range positions {
+		if len(pos) < 2 {
+			continue
+		}
+		objs := make([]*txObject, 0, len(pos))
+		for _, i := range pos {
+			objs = append(objs, txObjs[i])
+		}
+		sort.SliceStable(objs, func(i, j int) bool {
+			return objs[i].ethTx.Nonce() < objs[j].ethTx.Nonce()
+		})
+		for k, i := range pos {
+			txObjs[i] = objs[k]
+		}
+	}
+}
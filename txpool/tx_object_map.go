@@ -17,15 +17,17 @@ import (
 
 // txObjectMap to maintain mapping of ID to tx object, and account quota.
 type txObjectMap struct {
-	lock     sync.RWMutex
-	txObjMap map[meter.Bytes32]*txObject
-	quota    map[meter.Address]int
+	lock      sync.RWMutex
+	txObjMap  map[meter.Bytes32]*txObject
+	quota     map[meter.Address]int
+	ethNonces map[meter.Address]map[uint64]*txObject // eth txs by origin and nonce
 }
 
 func newTxObjectMap() *txObjectMap {
 	return &txObjectMap{
-		txObjMap: make(map[meter.Bytes32]*txObject),
-		quota:    make(map[meter.Address]int),
+		txObjMap:  make(map[meter.Bytes32]*txObject),
+		quota:     make(map[meter.Address]int),
+		ethNonces: make(map[meter.Address]map[uint64]*txObject),
 	}
 }
 
@@ -36,22 +38,76 @@ func (m *txObjectMap) Contains(txID meter.Bytes32) bool {
 	return found
 }
 
-func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int) error {
+// Add adds the tx object. An eth tx replaces the one of the same origin and nonce if it bumps
+// the price by at least priceBump percent, and the replaced tx object is returned.
+func (m *txObjectMap) Add(txObj *txObject, limitPerAccount int, priceBump uint64) (*txObject, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
 	if _, found := m.txObjMap[txObj.ID()]; found {
-		return nil
+		return nil, nil
+	}
+
+	var replaced *txObject
+	if txObj.ethTx != nil {
+		if old := m.ethNonces[txObj.Origin()][txObj.ethTx.Nonce()]; old != nil {
+			if !txObj.bumps(old, priceBump) {
+				return nil, errors.New("replacement tx underpriced")
+			}
+			m.remove(old)
+			replaced = old
+		}
 	}
 
 	if m.quota[txObj.Origin()] >= limitPerAccount {
-		return errors.New("account quota exceeded")
+		return nil, errors.New("account quota exceeded")
+	}
+
+	m.add(txObj)
+	slog.Debug(fmt.Sprintf("added tx %s", txObj.ID()), "poolSize", len(m.txObjMap))
+	return replaced, nil
+}
+
+// HasEthNonce returns whether an eth tx of the same origin and nonce is in the map,
+// so adding the tx object replaces it instead of growing the map.
+func (m *txObjectMap) HasEthNonce(txObj *txObject) bool {
+	if txObj.ethTx == nil {
+		return false
 	}
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.ethNonces[txObj.Origin()][txObj.ethTx.Nonce()] != nil
+}
 
+func (m *txObjectMap) add(txObj *txObject) {
 	m.quota[txObj.Origin()]++
 	m.txObjMap[txObj.ID()] = txObj
-	slog.Debug(fmt.Sprintf("added tx %s", txObj.ID()), "poolSize", len(m.txObjMap))
-	return nil
+	if txObj.ethTx != nil {
+		nonces := m.ethNonces[txObj.Origin()]
+		if nonces == nil {
+			nonces = make(map[uint64]*txObject)
+			m.ethNonces[txObj.Origin()] = nonces
+		}
+		nonces[txObj.ethTx.Nonce()] = txObj
+	}
+}
+
+func (m *txObjectMap) remove(txObj *txObject) {
+	if m.quota[txObj.Origin()] > 1 {
+		m.quota[txObj.Origin()]--
+	} else {
+		delete(m.quota, txObj.Origin())
+	}
+	delete(m.txObjMap, txObj.ID())
+	if txObj.ethTx != nil {
+		nonces := m.ethNonces[txObj.Origin()]
+		if nonces[txObj.ethTx.Nonce()] == txObj {
+			delete(nonces, txObj.ethTx.Nonce())
+			if len(nonces) == 0 {
+				delete(m.ethNonces, txObj.Origin())
+			}
+		}
+	}
 }
 
 func (m *txObjectMap) GetByID(id meter.Bytes32) *txObject {
@@ -65,12 +121,7 @@ func (m *txObjectMap) Remove(txID meter.Bytes32) bool {
 	defer m.lock.Unlock()
 
 	if txObj, ok := m.txObjMap[txID]; ok {
-		if m.quota[txObj.Origin()] > 1 {
-			m.quota[txObj.Origin()]--
-		} else {
-			delete(m.quota, txObj.Origin())
-		}
-		delete(m.txObjMap, txID)
+		m.remove(txObj)
 		slog.Debug("removed tx", "id", txID, "mapSize", len(m.txObjMap))
 		return true
 	}
@@ -106,10 +157,11 @@ func (m *txObjectMap) Fill(txObjs []*txObject) {
 		if _, found := m.txObjMap[txObj.ID()]; found {
 			continue
 		}
-		// skip account limit check
-
-		m.quota[txObj.Origin()]++
-		m.txObjMap[txObj.ID()] = txObj
+		// skip account limit check, and keep the existing eth tx of the same nonce
+		if txObj.ethTx != nil && m.ethNonces[txObj.Origin()][txObj.ethTx.Nonce()] != nil {
+			continue
+		}
+		m.add(txObj)
 	}
 }
 
@@ -11,6 +11,8 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/meterio/meter-pov/block"
 	"github.com/meterio/meter-pov/chain"
@@ -113,3 +115,46 @@ func TestExecutable(t *testing.T) {
 		}
 	}
 }
+
+func newEthTx(chainTag byte, nonce uint64, tipCap int64, feeCap int64, from genesis.DevAccount) *tx.Transaction {
+	chainID := big.NewInt(83)
+	to := common.Address{1}
+	ethTx, _ := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tipCap),
+		GasFeeCap: big.NewInt(feeCap),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(0),
+	}), types.NewLondonSigner(chainID), from.PrivateKey)
+	trx, err := tx.NewTransactionFromEthTx(ethTx, chainTag, tx.BlockRef{}, false)
+	if err != nil {
+		panic(err)
+	}
+	return trx
+}
+
+func TestSortEthTxObjsByNonce(t *testing.T) {
+	acc0, acc1 := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+	resolve := func(trx *tx.Transaction) *txObject {
+		txObj, err := resolveTx(trx)
+		assert.Nil(t, err)
+		return txObj
+	}
+	native := resolve(newTx(0, nil, 21000, tx.BlockRef{}, 100, nil, acc0))
+	objs := []*txObject{
+		resolve(newEthTx(0, 2, 1, 1, acc0)),
+		native,
+		resolve(newEthTx(0, 5, 1, 1, acc1)),
+		resolve(newEthTx(0, 0, 1, 1, acc0)),
+		resolve(newEthTx(0, 1, 1, 1, acc0)),
+	}
+	sortEthTxObjsByNonce(objs)
+
+	assert.Equal(t, uint64(0), objs[0].ethTx.Nonce())
+	assert.Equal(t, native, objs[1])
+	assert.Equal(t, uint64(5), objs[2].ethTx.Nonce())
+	assert.Equal(t, uint64(1), objs[3].ethTx.Nonce())
+	assert.Equal(t, uint64(2), objs[4].ethTx.Nonce())
+}
@@ -6,7 +6,10 @@
 package txpool
 
 import (
+	"bytes"
 	"log/slog"
+	"math/big"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -20,6 +23,7 @@ import (
 	"github.com/meterio/meter-pov/state"
 	"github.com/meterio/meter-pov/tx"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
@@ -32,12 +36,18 @@ type Options struct {
 	Limit           int
 	LimitPerAccount int
 	MaxLifetime     time.Duration
+	PriceBump       uint64 // minimum price bump percent to replace an eth tx of the same nonce
+
+	Journal      string        // path of the local txs journal, journal is disabled if empty
+	Rejournal    time.Duration // interval to regenerate the journal
+	JournalLimit int           // max count of txs in the journal
 }
 
 // TxEvent will be posted when tx is added or status changed.
 type TxEvent struct {
 	Tx         *tx.Transaction
 	Executable *bool
+	Replaced   *tx.Transaction // tx replaced by Tx, which has the same origin and nonce
 }
 
 // TxPool maintains unprocessed transactions.
@@ -50,6 +60,9 @@ type TxPool struct {
 	all            *txObjectMap
 	addedAfterWash uint32
 
+	journal   *txJournal
+	journaled uint32 // set once the journal is loaded
+
 	done   chan struct{}
 	txFeed event.Feed
 	scope  event.SubscriptionScope
@@ -62,6 +75,11 @@ type TxPool struct {
 // New create a new TxPool instance.
 // Shutdown is required to be called at end.
 func New(chain *chain.Chain, stateCreator *state.Creator, options Options) *TxPool {
+	prometheus.Register(txsGauge)
+	prometheus.Register(txsReceivedCounter)
+	prometheus.Register(txsWashedCounter)
+	prometheus.Register(washDurationHistogram)
+
 	pool := &TxPool{
 		options:      options,
 		chain:        chain,
@@ -72,6 +90,12 @@ func New(chain *chain.Chain, stateCreator *state.Creator, options Options) *TxPo
 		newTxFeed: make(chan meter.Bytes32, options.Limit),
 		logger:    slog.With("pkg", "txpool"),
 	}
+	if options.Journal != "" {
+		if pool.options.Rejournal <= 0 {
+			pool.options.Rejournal = time.Hour
+		}
+		pool.journal = newTxJournal(options.Journal, options.JournalLimit)
+	}
 	pool.goes.Go(pool.housekeeping)
 	return pool
 }
@@ -84,6 +108,13 @@ func (p *TxPool) housekeeping() {
 	ticker := time.NewTicker(washInterval)
 	defer ticker.Stop()
 
+	var rejournal <-chan time.Time
+	if p.journal != nil {
+		journalTicker := time.NewTicker(p.options.Rejournal)
+		defer journalTicker.Stop()
+		rejournal = journalTicker.C
+	}
+
 	// Hotstuff: Should change to after seem new proposal and do wash txs.
 	headBlock := p.chain.BestBlock().Header()
 
@@ -91,6 +122,14 @@ func (p *TxPool) housekeeping() {
 		select {
 		case <-p.done:
 			return
+		case <-rejournal:
+			if atomic.LoadUint32(&p.journaled) == 0 {
+				// not loaded yet, keep the journal as is
+				continue
+			}
+			if err := p.rotateJournal(); err != nil {
+				p.logger.Warn("rotate journal", "err", err)
+			}
 		case <-ticker.C:
 			var headBlockChanged bool
 			if newHeadBlock := p.chain.BestBlock().Header(); newHeadBlock.ID() != headBlock.ID() {
@@ -126,7 +165,11 @@ func (p *TxPool) housekeeping() {
 					ctx = append(ctx, "err", err)
 				} else {
 					p.executables.Store(executables)
+					txsGauge.WithLabelValues("executable").Set(float64(len(executables)))
+					txsGauge.WithLabelValues("non_executable").Set(float64(p.all.Len() - len(executables)))
 				}
+				txsWashedCounter.Add(float64(removed))
+				washDurationHistogram.Observe(time.Duration(elapsed).Seconds())
 
 				p.logger.Debug("wash done", ctx...)
 			}
@@ -139,6 +182,11 @@ func (p *TxPool) Close() {
 	close(p.done)
 	p.scope.Close()
 	p.goes.Wait()
+	if p.journal != nil {
+		if err := p.journal.close(); err != nil {
+			p.logger.Warn("close journal", "err", err)
+		}
+	}
 	p.logger.Debug("closed")
 }
 
@@ -147,7 +195,11 @@ func (p *TxPool) SubscribeTxEvent(ch chan *TxEvent) event.Subscription {
 	return p.scope.Track(p.txFeed.Subscribe(ch))
 }
 
-func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
+func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool, local bool) (err error) {
+	defer func() {
+		txsReceivedCounter.WithLabelValues(receivedResult(err)).Inc()
+	}()
+
 	if p.all.Contains(newTx.ID()) {
 		// tx already in the pool
 		return nil
@@ -178,6 +230,7 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 	if err != nil {
 		return badTxError{err.Error()}
 	}
+	txObj.local = local
 
 	headBlock := p.chain.BestBlock().Header()
 	if isChainSynced(uint64(time.Now().Unix()), headBlock.Timestamp()) {
@@ -195,29 +248,38 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 			return txRejectedError{"tx is not executable"}
 		}
 
-		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
+		replaced, err := p.all.Add(txObj, p.options.LimitPerAccount, p.options.PriceBump)
+		if err != nil {
 			return txRejectedError{err.Error()}
 		}
 		p.logger.Debug("tx added, chain is synced", "id", newTx.ID(), "pool size", p.all.Len())
 		txObj.executable = executable
+		ev := p.newTxEvent(newTx, &executable, replaced)
 		p.goes.Go(func() {
-			p.txFeed.Send(&TxEvent{newTx, &executable})
+			p.txFeed.Send(ev)
 		})
 	} else {
 		// we skip steps that rely on head block when chain is not synced,
 		// but check the pool's limit
-		if p.all.Len() >= p.options.Limit {
+		if p.all.Len() >= p.options.Limit && !p.all.HasEthNonce(txObj) {
 			return txRejectedError{"pool is full"}
 		}
 
-		if err := p.all.Add(txObj, p.options.LimitPerAccount); err != nil {
+		replaced, err := p.all.Add(txObj, p.options.LimitPerAccount, p.options.PriceBump)
+		if err != nil {
 			return txRejectedError{err.Error()}
 		}
 		p.logger.Debug("tx added, chain is not synced", "id", newTx.ID(), "pool size", p.all.Len())
-		p.txFeed.Send(&TxEvent{newTx, nil})
+		p.txFeed.Send(p.newTxEvent(newTx, nil, replaced))
 	}
 	p.logger.Debug("tx added to pool", "id", newTx.ID())
 
+	if local && p.journal != nil {
+		if err := p.journal.insert(newTx); err != nil && err != errNoActiveJournal {
+			p.logger.Warn("journal tx", "id", newTx.ID(), "err", err)
+		}
+	}
+
 	if len(p.newTxFeed) < cap(p.newTxFeed) {
 		p.newTxFeed <- newTx.ID()
 		p.logger.Debug("new tx feed: ", "id", newTx.ID())
@@ -234,6 +296,15 @@ func (p *TxPool) add(newTx *tx.Transaction, rejectNonexecutable bool) error {
 	return nil
 }
 
+func (p *TxPool) newTxEvent(newTx *tx.Transaction, executable *bool, replaced *txObject) *TxEvent {
+	ev := &TxEvent{Tx: newTx, Executable: executable}
+	if replaced != nil {
+		ev.Replaced = replaced.Transaction
+		p.logger.Debug("tx replaced", "id", replaced.ID(), "by", newTx.ID())
+	}
+	return ev
+}
+
 func (p *TxPool) GetNewTxFeed() chan meter.Bytes32 {
 	return p.newTxFeed
 }
@@ -241,7 +312,12 @@ func (p *TxPool) GetNewTxFeed() chan meter.Bytes32 {
 // Add add new tx into pool.
 // It's not assumed as an error if the tx to be added is already in the pool,
 func (p *TxPool) Add(newTx *tx.Transaction) error {
-	return p.add(newTx, false)
+	return p.add(newTx, false, false)
+}
+
+// AddLocal add new tx submitted via local api into pool, the tx is journaled if journal is enabled.
+func (p *TxPool) AddLocal(newTx *tx.Transaction) error {
+	return p.add(newTx, false, true)
 }
 
 func (p *TxPool) Get(id meter.Bytes32) *tx.Transaction {
@@ -260,7 +336,56 @@ func (p *TxPool) GetTxObj(id meter.Bytes32) *txObject {
 
 // StrictlyAdd add new tx into pool. A rejection error will be returned, if tx is not executable at this time.
 func (p *TxPool) StrictlyAdd(newTx *tx.Transaction) error {
-	return p.add(newTx, true)
+	return p.add(newTx, true, false)
+}
+
+// LoadJournal re-validates journaled local txs as StrictlyAdd does, then activates the journal.
+// It should be called once the chain is synced, and returns txs accepted.
+func (p *TxPool) LoadJournal() (tx.Transactions, error) {
+	if p.journal == nil {
+		return nil, nil
+	}
+	var accepted tx.Transactions
+	loaded, dropped, err := p.journal.load(func(trx *tx.Transaction) error {
+		if err := p.add(trx, true, true); err != nil {
+			p.logger.Debug("journaled tx dropped", "id", trx.ID(), "err", err)
+			return err
+		}
+		accepted = append(accepted, trx)
+		return nil
+	})
+	if err != nil {
+		p.logger.Warn("load journal", "err", err)
+	}
+	p.logger.Info("loaded local txs from journal", "loaded", loaded, "dropped", dropped)
+
+	if err := p.rotateJournal(); err != nil {
+		return accepted, errors.WithMessage(err, "rotate journal")
+	}
+	atomic.StoreUint32(&p.journaled, 1)
+	return accepted, nil
+}
+
+// rotateJournal regenerates the journal with local txs in the pool, in the order they were added.
+func (p *TxPool) rotateJournal() error {
+	var locals []*txObject
+	for _, txObj := range p.all.ToTxObjects() {
+		if txObj.local {
+			locals = append(locals, txObj)
+		}
+	}
+	sort.Slice(locals, func(i, j int) bool {
+		return locals[i].timeAdded < locals[j].timeAdded
+	})
+	txs := make(tx.Transactions, 0, len(locals))
+	for _, txObj := range locals {
+		txs = append(txs, txObj.Transaction)
+	}
+	if err := p.journal.rotate(txs); err != nil {
+		return err
+	}
+	p.logger.Debug("journal rotated", "count", len(txs))
+	return nil
 }
 
 // Remove removes tx from pool by its ID.
@@ -378,6 +503,9 @@ func (p *TxPool) wash(headBlock *block.Header, timeLimit time.Duration) (executa
 	// sort objs by price from high to low
 	// sortTxObjsByOverallGasPriceDesc(executableObjs)
 
+	// eth txs of the same origin are kept in nonce order
+	sortEthTxObjsByNonce(executableObjs)
+
 	limit := p.options.Limit
 
 	// remove over limit txs, from non-executables to low priced
@@ -413,7 +541,7 @@ func (p *TxPool) wash(headBlock *block.Header, timeLimit time.Duration) (executa
 	p.goes.Go(func() {
 		for _, tx := range toBroadcast {
 			executable := true
-			p.txFeed.Send(&TxEvent{tx, &executable})
+			p.txFeed.Send(&TxEvent{Tx: tx, Executable: &executable})
 		}
 	})
 	p.logger.Debug("in wash", "executables size", len(executables), "non-executables size", len(nonExecutableObjs))
@@ -435,3 +563,75 @@ func (p *TxPool) All() []*txObject {
 func (p *TxPool) Len() int {
 	return p.all.Len()
 }
+
+// TxStatus is the status of a tx in the pool, inspected on the best block.
+type TxStatus struct {
+	Tx         *tx.Transaction
+	Origin     meter.Address
+	GasPrice   *big.Int // overall gas price
+	TimeAdded  time.Time
+	Lifetime   time.Duration // time left before washed out
+	Executable bool
+	Reason     string // why the tx is not executable
+}
+
+// Inspect returns status of all txs in the pool, ordered by origin and time added.
+func (p *TxPool) Inspect() ([]*TxStatus, error) {
+	headBlock := p.chain.BestBlock().Header()
+	state, err := p.stateCreator.NewState(headBlock.StateRoot())
+	if err != nil {
+		return nil, errors.WithMessage(err, "new state")
+	}
+	var (
+		seeker       = p.chain.NewSeeker(headBlock.ID())
+		baseGasPrice = builtin.Params.Native(state).Get(meter.KeyBaseGasPrice)
+		now          = time.Now()
+		all          = p.all.ToTxObjects()
+		statuses     = make([]*TxStatus, 0, len(all))
+	)
+	for _, txObj := range all {
+		executable, reason, err := txObj.inspect(p.chain, state, headBlock)
+		if err != nil {
+			reason = err.Error()
+		}
+		timeAdded := time.Unix(0, txObj.timeAdded)
+		lifetime := p.options.MaxLifetime - now.Sub(timeAdded)
+		if lifetime < 0 {
+			lifetime = 0
+		}
+		statuses = append(statuses, &TxStatus{
+			Tx:         txObj.Transaction,
+			Origin:     txObj.Origin(),
+			GasPrice:   txObj.OverallGasPrice(baseGasPrice, headBlock.Number(), seeker.GetID),
+			TimeAdded:  timeAdded,
+			Lifetime:   lifetime,
+			Executable: executable,
+			Reason:     reason,
+		})
+	}
+	if err := state.Err(); err != nil {
+		return nil, errors.WithMessage(err, "state")
+	}
+	if err := seeker.Err(); err != nil {
+		return nil, errors.WithMessage(err, "seeker")
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		if c := bytes.Compare(statuses[i].Origin[:], statuses[j].Origin[:]); c != 0 {
+			return c < 0
+		}
+		return statuses[i].TimeAdded.Before(statuses[j].TimeAdded)
+	})
+	return statuses, nil
+}
+
+// RemoveByOrigin removes all txs sent by the origin, and returns count of removed txs.
+func (p *TxPool) RemoveByOrigin(origin meter.Address) int {
+	removed := 0
+	for _, txObj := range p.all.ToTxObjects() {
+		if txObj.Origin() == origin && p.Remove(txObj.ID()) {
+			removed++
+		}
+	}
+	return removed
+}
@@ -6,6 +6,7 @@
 package txpool
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -20,6 +21,8 @@ import (
 )
 
 func init() {
+	// eth txs are identified by their hash since tesla
+	meter.InitBlockChainConfig("test")
 }
 
 func newPool() *TxPool {
@@ -59,7 +62,7 @@ func TestSubscribeNewTx(t *testing.T) {
 	assert.Nil(t, pool.Add(tx))
 
 	v := true
-	assert.Equal(t, &TxEvent{tx, &v}, <-txCh)
+	assert.Equal(t, &TxEvent{Tx: tx, Executable: &v}, <-txCh)
 }
 
 func TestWashTxs(t *testing.T) {
@@ -145,3 +148,155 @@ func TestAdd(t *testing.T) {
 		}
 	}
 }
+
+func TestInspect(t *testing.T) {
+	pool := newPool()
+	defer pool.Close()
+	b1 := new(block.Builder).
+		ParentID(pool.chain.GenesisBlock().ID()).
+		Timestamp(uint64(time.Now().Unix())).
+		TotalScore(100).
+		GasLimit(10000000).
+		StateRoot(pool.chain.GenesisBlock().Header().StateRoot()).
+		Build()
+	qc := block.QuorumCert{QCHeight: 1, QCRound: 1, EpochID: 0}
+	b1.SetQC(&qc)
+	pool.chain.AddBlock(b1, nil, nil)
+
+	acc0, acc1 := genesis.DevAccounts()[0], genesis.DevAccounts()[1]
+	executable := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, acc0)
+	futureRef := newTx(pool.chain.Tag(), nil, 21000, tx.NewBlockRef(200), 100, nil, acc0)
+	depMissing := newTx(pool.chain.Tag(), nil, 21000, tx.BlockRef{}, 100, &meter.Bytes32{1}, acc1)
+	for _, tx := range []*tx.Transaction{executable, futureRef, depMissing} {
+		assert.Nil(t, pool.Add(tx))
+	}
+
+	statuses, err := pool.Inspect()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(statuses))
+	reasons := make(map[meter.Bytes32]string)
+	for _, s := range statuses {
+		assert.Equal(t, s.Reason == "", s.Executable)
+		assert.True(t, s.Lifetime > 0 && s.Lifetime <= time.Hour)
+		reasons[s.Tx.ID()] = s.Reason
+	}
+	assert.Equal(t, "", reasons[executable.ID()])
+	assert.Equal(t, ReasonFutureBlockRef, reasons[futureRef.ID()])
+	assert.Equal(t, ReasonDepMissing, reasons[depMissing.ID()])
+
+	assert.Equal(t, 2, pool.RemoveByOrigin(acc0.Address))
+	assert.Equal(t, 0, pool.RemoveByOrigin(acc0.Address))
+	assert.Equal(t, 1, pool.Len())
+}
+
+func TestReplaceEthTx(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	chain := newChain(kv)
+	pool := New(chain, state.NewCreator(kv), Options{
+		Limit:           10,
+		LimitPerAccount: 2,
+		MaxLifetime:     time.Hour,
+		PriceBump:       10,
+	})
+	defer pool.Close()
+
+	txCh := make(chan *TxEvent, 10)
+	pool.SubscribeTxEvent(txCh)
+
+	acc := genesis.DevAccounts()[0]
+	tx1 := newEthTx(pool.chain.Tag(), 1, 100, 1000, acc)
+	assert.Nil(t, pool.Add(tx1))
+	assert.Nil(t, (<-txCh).Replaced)
+
+	// fee cap and tip cap both need a 10% bump
+	underpriced := newEthTx(pool.chain.Tag(), 1, 109, 2000, acc)
+	assert.Equal(t, "tx rejected: replacement tx underpriced", pool.Add(underpriced).Error())
+
+	tx2 := newEthTx(pool.chain.Tag(), 1, 110, 1100, acc)
+	assert.Nil(t, pool.Add(tx2))
+	ev := <-txCh
+	assert.Equal(t, tx2, ev.Tx)
+	assert.Equal(t, tx1, ev.Replaced)
+	assert.Nil(t, pool.Get(tx1.ID()))
+	assert.NotNil(t, pool.Get(tx2.ID()))
+	assert.Equal(t, 1, pool.Len())
+
+	// other nonces are not replaced
+	assert.Nil(t, pool.Add(newEthTx(pool.chain.Tag(), 2, 100, 1000, acc)))
+	assert.Nil(t, (<-txCh).Replaced)
+	assert.Equal(t, 2, pool.Len())
+
+	// replacing tx is removed from the nonce index
+	assert.True(t, pool.Remove(tx2.ID()))
+	tx3 := newEthTx(pool.chain.Tag(), 1, 1, 1, acc)
+	assert.Nil(t, pool.Add(tx3))
+	assert.Nil(t, (<-txCh).Replaced)
+}
+
+func TestReplaceEthTxPoolFull(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	chain := newChain(kv)
+	pool := New(chain, state.NewCreator(kv), Options{
+		Limit:           1,
+		LimitPerAccount: 2,
+		MaxLifetime:     time.Hour,
+		PriceBump:       10,
+	})
+	defer pool.Close()
+
+	acc := genesis.DevAccounts()[0]
+	tx1 := newEthTx(pool.chain.Tag(), 1, 100, 1000, acc)
+	assert.Nil(t, pool.Add(tx1))
+	assert.Equal(t, "tx rejected: pool is full", pool.Add(newEthTx(pool.chain.Tag(), 2, 100, 1000, acc)).Error())
+
+	// a replacement does not grow the pool
+	tx2 := newEthTx(pool.chain.Tag(), 1, 110, 1100, acc)
+	assert.Nil(t, pool.Add(tx2))
+	assert.Nil(t, pool.Get(tx1.ID()))
+	assert.NotNil(t, pool.Get(tx2.ID()))
+	assert.Equal(t, 1, pool.Len())
+}
+
+func TestJournal(t *testing.T) {
+	kv, _ := lvldb.NewMem()
+	chain := newChain(kv)
+	options := Options{
+		Limit:           10,
+		LimitPerAccount: 2,
+		MaxLifetime:     time.Hour,
+		Journal:         filepath.Join(t.TempDir(), "txpool.journal"),
+		Rejournal:       time.Hour,
+		JournalLimit:    10,
+	}
+
+	pool := New(chain, state.NewCreator(kv), options)
+	loaded, err := pool.LoadJournal()
+	assert.Nil(t, err)
+	assert.Zero(t, len(loaded))
+
+	local := newTx(chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, genesis.DevAccounts()[0])
+	remote := newTx(chain.Tag(), nil, 21000, tx.BlockRef{}, 100, nil, genesis.DevAccounts()[1])
+	assert.Nil(t, pool.AddLocal(local))
+	assert.Nil(t, pool.Add(remote))
+	pool.Close()
+
+	// only local txs are restored
+	pool = New(chain, state.NewCreator(kv), options)
+	loaded, err = pool.LoadJournal()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(loaded))
+	assert.Equal(t, local.ID(), loaded[0].ID())
+	assert.Equal(t, 1, pool.Len())
+	assert.NotNil(t, pool.Get(local.ID()))
+
+	// journal is regenerated without txs gone from the pool
+	pool.Remove(local.ID())
+	assert.Nil(t, pool.rotateJournal())
+	pool.Close()
+
+	pool = New(chain, state.NewCreator(kv), options)
+	defer pool.Close()
+	loaded, err = pool.LoadJournal()
+	assert.Nil(t, err)
+	assert.Zero(t, len(loaded))
+}
@@ -13,6 +13,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	bls "github.com/meterio/meter-pov/crypto/multi_sig"
@@ -94,6 +95,23 @@ func LoadDelegatesFile(ctx *cli.Context, blsCommon *BlsCommon) []*Delegate {
 		content = preset.MustAsset("shoal/delegates.json")
 	} else if ctx.String("network") == "main" {
 		content = preset.MustAsset("mainnet/delegates.json")
+	} else if network := ctx.String("network"); strings.HasSuffix(network, ".json") {
+		// load initial delegates from custom network file
+		file, err := ioutil.ReadFile(network)
+		if err != nil {
+			fmt.Println("Unable load network file at", network, "error", err)
+			os.Exit(1)
+			return nil
+		}
+		var spec struct {
+			Delegates json.RawMessage `json:"delegates"`
+		}
+		if err := json.Unmarshal(file, &spec); err != nil {
+			fmt.Println("Unable unmarshal network file, please check your config", "error", err)
+			os.Exit(1)
+			return nil
+		}
+		content = spec.Delegates
 	} else {
 		// load delegates from file system
 		dataDir := ctx.String("data-dir")
@@ -25,6 +25,7 @@ type ChainConfig struct {
 	IstanbulBlock *big.Int `json:"istanbulBlock,omitempty"` // Istanbul switch block (nil = no fork, 0 = already on istanbul)
 	LondonBlock   *big.Int `json:"londonBlock,omitempty"`   // London switch block (nil = no fork, 0 = already on london)
 	ParisBlock    *big.Int `json:"parisBlock,omitempty"`    //  Paris switch block (nil = no fork, 0 = already on paris)
+	BerlinBlock   *big.Int `json:"berlinBlock,omitempty"`   // Berlin switch block (nil = no fork, 0 = already on berlin)
 	LastPowNonce  uint64   `json:"lastPowNonce,omitempty"`  // Last Pow Nonce for randomness
 	BaseFee       *big.Int `json:"baseFee"`
 }
@@ -43,3 +44,17 @@ func (c *ChainConfig) IsLondon(num *big.Int) bool {
 func (c *ChainConfig) IsParis(num *big.Int) bool {
 	return isForked(c.ParisBlock, num)
 }
+
+// IsBerlin returns whether num is either equal to the Berlin fork block or greater.
+// Berlin is scheduled after Paris, it enables access lists and warm/cold gas accounting.
+func (c *ChainConfig) IsBerlin(num *big.Int) bool {
+	return isForked(c.BerlinBlock, num)
+}
+
+// GasTable returns the gas table of num, with warm access prices since Berlin.
+func (c *ChainConfig) GasTable(num *big.Int) params.GasTable {
+	if num != nil && c.IsBerlin(num) {
+		return params.GasTableBerlin
+	}
+	return c.ChainConfig.GasTable(num)
+}
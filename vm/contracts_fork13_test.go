@@ -0,0 +1,136 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package vm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/meterio/meter-pov/params"
+)
+
+// precompileVector is a precompile test vector in the go-ethereum testdata format.
+type precompileVector struct {
+	Input         string
+	Expected      string
+	ExpectedError string
+	Gas           uint64
+	Name          string
+}
+
+func loadPrecompileVectors(t *testing.T, name string) []precompileVector {
+	data, err := os.ReadFile(fmt.Sprintf("testdata/precompiles/%v.json", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vectors []precompileVector
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+	return vectors
+}
+
+// testFork13Precompile runs the vectors of name and fail-name against the precompile at addr.
+func testFork13Precompile(t *testing.T, name string, addr byte) {
+	p := PrecompiledContractsTeslaFork13[common.BytesToAddress([]byte{addr})]
+	for _, v := range loadPrecompileVectors(t, name) {
+		t.Run(v.Name, func(t *testing.T) {
+			in := common.Hex2Bytes(v.Input)
+			if gas := p.RequiredGas(in); gas != v.Gas {
+				t.Errorf("gas mismatch: have %v, want %v", gas, v.Gas)
+			}
+			res, err := p.Run(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have := common.Bytes2Hex(res); have != v.Expected {
+				t.Errorf("output mismatch: have %v, want %v", have, v.Expected)
+			}
+		})
+	}
+	for _, v := range loadPrecompileVectors(t, "fail-"+name) {
+		t.Run(v.Name, func(t *testing.T) {
+			_, err := p.Run(common.Hex2Bytes(v.Input))
+			if err == nil || err.Error() != v.ExpectedError {
+				t.Errorf("error mismatch: have %v, want %v", err, v.ExpectedError)
+			}
+		})
+	}
+}
+
+// the single pair vectors of G1Mul and G2Mul run against the MSM precompiles
+func TestPrecompiledBLS12381G1Add(t *testing.T)      { testFork13Precompile(t, "blsG1Add", 0x0b) }
+func TestPrecompiledBLS12381G1Mul(t *testing.T)      { testFork13Precompile(t, "blsG1Mul", 0x0c) }
+func TestPrecompiledBLS12381G1MultiExp(t *testing.T) { testFork13Precompile(t, "blsG1MultiExp", 0x0c) }
+func TestPrecompiledBLS12381G2Add(t *testing.T)      { testFork13Precompile(t, "blsG2Add", 0x0d) }
+func TestPrecompiledBLS12381G2Mul(t *testing.T)      { testFork13Precompile(t, "blsG2Mul", 0x0e) }
+func TestPrecompiledBLS12381G2MultiExp(t *testing.T) { testFork13Precompile(t, "blsG2MultiExp", 0x0e) }
+func TestPrecompiledBLS12381Pairing(t *testing.T)    { testFork13Precompile(t, "blsPairing", 0x0f) }
+func TestPrecompiledBLS12381MapG1(t *testing.T)      { testFork13Precompile(t, "blsMapG1", 0x10) }
+func TestPrecompiledBLS12381MapG2(t *testing.T)      { testFork13Precompile(t, "blsMapG2", 0x11) }
+
+func TestPrecompiledP256Verify(t *testing.T) {
+	p := PrecompiledContractsTeslaFork13[common.BytesToAddress([]byte{0x01, 0x00})]
+	if p == nil {
+		t.Fatal("P256VERIFY not found at 0x100")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte("meter"))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode := func(hash []byte, r, s, x, y []byte) []byte {
+		var in []byte
+		for _, b := range [][]byte{hash, r, s, x, y} {
+			in = append(in, common.LeftPadBytes(b, 32)...)
+		}
+		return in
+	}
+	valid := encode(hash[:], r.Bytes(), s.Bytes(), key.X.Bytes(), key.Y.Bytes())
+	otherHash := sha256.Sum256([]byte("meter-pov"))
+
+	for i, tc := range []struct {
+		input []byte
+		want  []byte
+	}{
+		{valid, common.LeftPadBytes([]byte{1}, 32)},
+		// wrong message
+		{encode(otherHash[:], r.Bytes(), s.Bytes(), key.X.Bytes(), key.Y.Bytes()), nil},
+		// wrong signature
+		{encode(hash[:], s.Bytes(), r.Bytes(), key.X.Bytes(), key.Y.Bytes()), nil},
+		// public key not on curve
+		{encode(hash[:], r.Bytes(), s.Bytes(), key.X.Bytes(), key.X.Bytes()), nil},
+		// point at infinity
+		{encode(hash[:], r.Bytes(), s.Bytes(), nil, nil), nil},
+		// bad input length
+		{valid[:159], nil},
+		{append(valid, 0), nil},
+		{nil, nil},
+	} {
+		if gas := p.RequiredGas(tc.input); gas != params.P256VerifyGas {
+			t.Errorf("testcase %d, gas mismatch: have %v, want %v", i, gas, params.P256VerifyGas)
+		}
+		res, err := p.Run(tc.input)
+		if err != nil {
+			t.Errorf("testcase %d, unexpected error: %v", i, err)
+		}
+		if common.Bytes2Hex(res) != common.Bytes2Hex(tc.want) {
+			t.Errorf("testcase %d, output mismatch: have %x, want %x", i, res, tc.want)
+		}
+	}
+}
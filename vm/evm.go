@@ -49,16 +49,40 @@ type (
 	OnSuicideContractFunc func(evm *EVM, contractAddr common.Address, tokenReceiver common.Address)
 )
 
+// precompiles returns the precompiled contracts active at the block of evm.
+func (evm *EVM) precompiles() map[common.Address]PrecompiledContract {
+	switch {
+	case evm.ChainConfig().IsBerlin(evm.BlockNumber):
+		return PrecompiledContractsTeslaFork13
+	case evm.ChainConfig().IsIstanbul(evm.BlockNumber):
+		return PrecompiledContractsIstanbul
+	case evm.ChainConfig().IsByzantium(evm.BlockNumber):
+		return PrecompiledContractsByzantium
+	default:
+		return PrecompiledContractsHomestead
+	}
+}
+
+// ActivePrecompiles returns addresses of the precompiled contracts active at the block of evm.
+func (evm *EVM) ActivePrecompiles() []common.Address {
+	precompiles := evm.precompiles()
+	addrs := make([]common.Address, 0, len(precompiles))
+	for addr := range precompiles {
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+// IsPrecompile returns whether addr is a precompiled contract active at the block of evm.
+func (evm *EVM) IsPrecompile(addr common.Address) bool {
+	_, ok := evm.precompiles()[addr]
+	return ok
+}
+
 // run runs the given contract and takes care of running precompiles with a fallback to the byte code interpreter.
 func run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
 	if contract.CodeAddr != nil {
-		precompiles := PrecompiledContractsHomestead
-		if evm.ChainConfig().IsIstanbul(evm.BlockNumber) {
-			precompiles = PrecompiledContractsIstanbul
-		} else if evm.ChainConfig().IsByzantium(evm.BlockNumber) {
-			precompiles = PrecompiledContractsByzantium
-		}
-		if p := precompiles[*contract.CodeAddr]; p != nil {
+		if p := evm.precompiles()[*contract.CodeAddr]; p != nil {
 			return RunPrecompiledContract(p, input, contract)
 		}
 	}
@@ -200,13 +224,7 @@ func (evm *EVM) call(caller ContractRef, addr common.Address, input []byte, gas
 		snapshot = evm.StateDB.Snapshot()
 	)
 	if !evm.StateDB.Exist(addr) {
-		precompiles := PrecompiledContractsHomestead
-		if evm.ChainConfig().IsIstanbul(evm.BlockNumber) {
-			precompiles = PrecompiledContractsIstanbul
-		} else if evm.ChainConfig().IsByzantium(evm.BlockNumber) {
-			precompiles = PrecompiledContractsByzantium
-		}
-		if precompiles[addr] == nil && evm.ChainConfig().IsEIP158(evm.BlockNumber) && value.Sign() == 0 {
+		if evm.precompiles()[addr] == nil && evm.ChainConfig().IsEIP158(evm.BlockNumber) && value.Sign() == 0 {
 			return nil, gas, nil
 		}
 		evm.StateDB.CreateAccount(addr)
@@ -377,6 +395,11 @@ func (evm *EVM) create(caller ContractRef, code []byte, gas uint64, value *big.I
 	}
 	nonce := evm.StateDB.GetNonce(caller.Address())
 	evm.StateDB.SetNonce(caller.Address(), nonce+1)
+	// We add this to the access list _before_ taking a snapshot. Even if the creation fails,
+	// the access-list change should not be rolled back
+	if evm.ChainConfig().IsBerlin(evm.BlockNumber) {
+		evm.StateDB.AddAddressToAccessList(contractAddr)
+	}
 
 	// Increase counter, same behavior as Create()
 	// We already have address, just need to increase the counter.
@@ -68,6 +68,15 @@ type StateDB interface {
 	GetTransientState(addr common.Address, key common.Hash) common.Hash
 	SetTransientState(addr common.Address, key, value common.Hash)
 
+	// AddressInAccessList returns whether the address is warm.
+	AddressInAccessList(addr common.Address) bool
+	// SlotInAccessList returns whether the address and the slot are warm.
+	SlotInAccessList(addr common.Address, slot common.Hash) (addressOk bool, slotOk bool)
+	// AddAddressToAccessList marks the address warm, it is reverted with the snapshot.
+	AddAddressToAccessList(addr common.Address)
+	// AddSlotToAccessList marks the address and the slot warm, it is reverted with the snapshot.
+	AddSlotToAccessList(addr common.Address, slot common.Hash)
+
 	// ForEachStorage(common.Address, func(common.Hash, common.Hash) bool)
 }
 
@@ -62,6 +62,8 @@ func NewInterpreter(evm *EVM, cfg Config) *Interpreter {
 	// we'll set the default jump table.
 	if !cfg.JumpTable[STOP].valid {
 		switch {
+		case evm.ChainConfig().IsBerlin(evm.BlockNumber):
+			cfg.JumpTable = berlinInstructionSet
 		case evm.ChainConfig().IsParis(evm.BlockNumber):
 			cfg.JumpTable = parisInstructionSet
 		case evm.ChainConfig().IsLondon(evm.BlockNumber):
@@ -58,8 +58,29 @@ var (
 	istanbulInstructionSet       = NewIstanbulInstructionSet()
 	londonInstructionSet         = NewLondonInstructionSet()
 	parisInstructionSet          = newParisInstructionSet()
+	berlinInstructionSet         = newBerlinInstructionSet()
 )
 
+// newBerlinInstructionSet returns the paris instructions with EIP-2929 gas accounting.
+// Berlin is ported after Paris, so it builds on top of the paris instructions.
+func newBerlinInstructionSet() [256]operation {
+	instructionSet := newParisInstructionSet()
+	// enables "EIP-2929: Gas cost increases for state access opcodes"
+	// https://eips.ethereum.org/EIPS/eip-2929
+	instructionSet[SLOAD].gasCost = gasSLoadEIP2929
+	instructionSet[SSTORE].gasCost = gasSStoreEIP2929
+	instructionSet[EXTCODECOPY].gasCost = makeGasAccountCheckEIP2929(gasExtCodeCopy)
+	instructionSet[EXTCODESIZE].gasCost = makeGasAccountCheckEIP2929(gasExtCodeSize)
+	instructionSet[EXTCODEHASH].gasCost = makeGasAccountCheckEIP2929(gasExtCodeHash)
+	instructionSet[BALANCE].gasCost = makeGasAccountCheckEIP2929(gasBalance)
+	instructionSet[CALL].gasCost = makeCallVariantGasCallEIP2929(gasCall)
+	instructionSet[CALLCODE].gasCost = makeCallVariantGasCallEIP2929(gasCallCode)
+	instructionSet[STATICCALL].gasCost = makeCallVariantGasCallEIP2929(gasStaticCall)
+	instructionSet[DELEGATECALL].gasCost = makeCallVariantGasCallEIP2929(gasDelegateCall)
+	instructionSet[SELFDESTRUCT].gasCost = makeSelfdestructGasFnEIP2929(gasSuicideEIP3529)
+	return instructionSet
+}
+
 func newCancunInstructionSet() [256]operation {
 	instructionSet := newParisInstructionSet()
 	// ##### Cancun updates #####
@@ -97,7 +118,7 @@ func newParisInstructionSet() [256]operation {
 	instructionSet := NewLondonInstructionSet()
 
 	// ##### Berlin #####
-	// EIP-2929
+	// EIP-2929 is enabled by tesla fork13, see newBerlinInstructionSet
 	// End of ##### Berlin #####
 
 	// ##### London updates #####
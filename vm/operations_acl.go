@@ -0,0 +1,145 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/meterio/meter-pov/params"
+)
+
+// The gas functions below implement EIP-2929. Warm access prices come from
+// params.GasTableBerlin, and accessing a cold account or slot is charged on top.
+
+// gasSLoadEIP2929 calculates dynamic gas for SLOAD according to EIP-2929.
+func gasSLoadEIP2929(gt params.GasTable, evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	slot := common.BigToHash(stack.peek())
+	if _, slotPresent := evm.StateDB.SlotInAccessList(contract.Address(), slot); !slotPresent {
+		// If the caller cannot afford the cost, this change will be rolled back
+		evm.StateDB.AddSlotToAccessList(contract.Address(), slot)
+		return params.ColdSloadCostEIP2929, nil
+	}
+	return gt.SLoad, nil
+}
+
+// gasSStoreEIP2929 charges SSTORE as before, except that the reset price is reduced
+// by the cold sload cost, and a cold slot is charged the cold sload cost first.
+// It is layered on the legacy schedule of gasSStore on purpose: meter never adopted the
+// net gas metering of EIP-2200, so unlike geth there is no dirty/original slot pricing here.
+func gasSStoreEIP2929(gt params.GasTable, evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	var (
+		y, x = stack.Back(1), stack.Back(0)
+		slot = common.BigToHash(x)
+		cost uint64
+	)
+	if _, slotPresent := evm.StateDB.SlotInAccessList(contract.Address(), slot); !slotPresent {
+		cost = params.ColdSloadCostEIP2929
+		evm.StateDB.AddSlotToAccessList(contract.Address(), slot)
+	}
+	val := evm.StateDB.GetState(contract.Address(), slot)
+	if val == (common.Hash{}) && y.Sign() != 0 {
+		// 0 => non 0
+		return cost + params.SstoreSetGas, nil
+	} else if val != (common.Hash{}) && y.Sign() == 0 {
+		// non 0 => 0
+		evm.StateDB.AddRefund(params.SstoreRefundGas)
+		return cost + params.SstoreClearGas - params.ColdSloadCostEIP2929, nil
+	}
+	// non 0 => non 0 (or 0 => 0)
+	return cost + params.SstoreResetGas - params.ColdSloadCostEIP2929, nil
+}
+
+// makeGasAccountCheckEIP2929 charges the cold account access cost on top of oldCalculator
+// for EXTCODECOPY, EXTCODESIZE, EXTCODEHASH and BALANCE if the address is cold.
+func makeGasAccountCheckEIP2929(oldCalculator gasFunc) gasFunc {
+	return func(gt params.GasTable, evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+		gas, err := oldCalculator(gt, evm, contract, stack, mem, memorySize)
+		if err != nil {
+			return 0, err
+		}
+		addr := common.BigToAddress(stack.peek())
+		if evm.StateDB.AddressInAccessList(addr) {
+			return gas, nil
+		}
+		// If the caller cannot afford the cost, this change will be rolled back
+		evm.StateDB.AddAddressToAccessList(addr)
+		var overflow bool
+		if gas, overflow = math.SafeAdd(gas, params.ColdAccountAccessCostEIP2929-params.WarmStorageReadCostEIP2929); overflow {
+			return 0, errGasUintOverflow
+		}
+		return gas, nil
+	}
+}
+
+// makeCallVariantGasCallEIP2929 charges the cold account access cost for the call family.
+// The cold cost is deducted before oldCalculator applies the 63/64 rule to the rest of the gas.
+func makeCallVariantGasCallEIP2929(oldCalculator gasFunc) gasFunc {
+	return func(gt params.GasTable, evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+		addr := common.BigToAddress(stack.Back(1))
+		// Check slot presence in the access list
+		warmAccess := evm.StateDB.AddressInAccessList(addr)
+		// The WarmStorageReadCostEIP2929 (100) is already deducted in the form of a constant cost, so
+		// the cost to charge for cold access, if any, is Cold - Warm
+		coldCost := params.ColdAccountAccessCostEIP2929 - params.WarmStorageReadCostEIP2929
+		if !warmAccess {
+			evm.StateDB.AddAddressToAccessList(addr)
+			// Charge the remaining difference here already, to correctly calculate available
+			// gas for call
+			if !contract.UseGas(coldCost) {
+				return 0, ErrOutOfGas
+			}
+		}
+		// Now call the old calculator, which takes into account
+		// - create new account
+		// - transfer value
+		// - memory expansion
+		// - 63/64ths rule
+		gas, err := oldCalculator(gt, evm, contract, stack, mem, memorySize)
+		if warmAccess || err != nil {
+			return gas, err
+		}
+		// In case of a cold access, we temporarily add the cold charge back, and also
+		// add it to the returned gas. By adding it to the return, it will be charged
+		// outside of this function, as part of the dynamic gas, and that will make it
+		// also become correctly reported to tracers.
+		contract.Gas += coldCost
+
+		var overflow bool
+		if gas, overflow = math.SafeAdd(gas, coldCost); overflow {
+			return 0, errGasUintOverflow
+		}
+		return gas, nil
+	}
+}
+
+// makeSelfdestructGasFnEIP2929 charges the cold account access cost on top of oldCalculator
+// if the beneficiary is cold.
+func makeSelfdestructGasFnEIP2929(oldCalculator gasFunc) gasFunc {
+	return func(gt params.GasTable, evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+		gas, err := oldCalculator(gt, evm, contract, stack, mem, memorySize)
+		if err != nil {
+			return 0, err
+		}
+		addr := common.BigToAddress(stack.peek())
+		if !evm.StateDB.AddressInAccessList(addr) {
+			// If the caller cannot afford the cost, this change will be rolled back
+			evm.StateDB.AddAddressToAccessList(addr)
+			gas += params.ColdAccountAccessCostEIP2929
+		}
+		return gas, nil
+	}
+}
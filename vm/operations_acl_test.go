@@ -0,0 +1,166 @@
+// Copyright (c) 2020 The Meter.io developers
+
+// Distributed under the GNU Lesser General Public License v3.0 software license, see the accompanying
+// file LICENSE or <https://www.gnu.org/licenses/lgpl-3.0.html>
+
+package vm_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/meterio/meter-pov/lvldb"
+	"github.com/meterio/meter-pov/meter"
+	"github.com/meterio/meter-pov/params"
+	"github.com/meterio/meter-pov/runtime/statedb"
+	"github.com/meterio/meter-pov/state"
+	"github.com/meterio/meter-pov/vm"
+)
+
+// stepGasCost runs code with the given access list and returns the gas cost of the step.
+func stepGasCost(t *testing.T, berlin bool, code []byte, list types.AccessList, step int) uint64 {
+	var (
+		db, _    = lvldb.NewMem()
+		st, _    = state.New(meter.Bytes32{}, db)
+		stateDB  = statedb.New(st)
+		origin   = common.BytesToAddress([]byte("origin"))
+		contract = common.BytesToAddress([]byte("contract"))
+		tracer   = vm.NewStructLogger(nil)
+		config   = &vm.ChainConfig{
+			ChainConfig:   *params.TestChainConfig,
+			IstanbulBlock: big.NewInt(0),
+			LondonBlock:   big.NewInt(0),
+			ParisBlock:    big.NewInt(0),
+		}
+	)
+	if berlin {
+		config.BerlinBlock = big.NewInt(0)
+	}
+	stateDB.SetCode(contract, code)
+
+	evm := vm.NewEVM(vm.Context{
+		CanTransfer: func(vm.StateDB, common.Address, *big.Int, byte) bool { return true },
+		Transfer:    func(vm.StateDB, common.Address, common.Address, *big.Int, byte) {},
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(0),
+		Difficulty:  big.NewInt(0),
+	}, stateDB, config, vm.Config{Debug: true, Tracer: tracer})
+	if berlin {
+		stateDB.PrepareAccessList(origin, &contract, evm.ActivePrecompiles(), list)
+	}
+	if _, _, err := evm.Call(vm.AccountRef(origin), contract, nil, 100000, new(big.Int), meter.MTR); err != nil {
+		t.Fatal(err)
+	}
+	logs := tracer.StructLogs()
+	if step >= len(logs) {
+		t.Fatalf("step %v not executed, %v steps in total", step, len(logs))
+	}
+	return logs[step].GasCost
+}
+
+// TestColdAccountAccessCost mirrors the go-ethereum reference vectors of EIP-2929.
+func TestColdAccountAccessCost(t *testing.T) {
+	for i, tc := range []struct {
+		code []byte
+		step int
+		want uint64
+	}{
+		{ // EXTCODEHASH(0xff)
+			code: []byte{byte(vm.PUSH1), 0xFF, byte(vm.EXTCODEHASH), byte(vm.POP)},
+			step: 1,
+			want: 2600,
+		},
+		{ // BALANCE(0xff)
+			code: []byte{byte(vm.PUSH1), 0xFF, byte(vm.BALANCE), byte(vm.POP)},
+			step: 1,
+			want: 2600,
+		},
+		{ // CALL(0xff)
+			code: []byte{
+				byte(vm.PUSH1), 0x0,
+				byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1),
+				byte(vm.PUSH1), 0xff, byte(vm.DUP1), byte(vm.CALL), byte(vm.POP),
+			},
+			step: 7,
+			want: 2855,
+		},
+		{ // CALLCODE(0xff)
+			code: []byte{
+				byte(vm.PUSH1), 0x0,
+				byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1),
+				byte(vm.PUSH1), 0xff, byte(vm.DUP1), byte(vm.CALLCODE), byte(vm.POP),
+			},
+			step: 7,
+			want: 2855,
+		},
+		{ // DELEGATECALL(0xff)
+			code: []byte{
+				byte(vm.PUSH1), 0x0,
+				byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1),
+				byte(vm.PUSH1), 0xff, byte(vm.DUP1), byte(vm.DELEGATECALL), byte(vm.POP),
+			},
+			step: 6,
+			want: 2855,
+		},
+		{ // STATICCALL(0xff)
+			code: []byte{
+				byte(vm.PUSH1), 0x0,
+				byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1),
+				byte(vm.PUSH1), 0xff, byte(vm.DUP1), byte(vm.STATICCALL), byte(vm.POP),
+			},
+			step: 6,
+			want: 2855,
+		},
+		{ // SELFDESTRUCT(0xff)
+			code: []byte{byte(vm.PUSH1), 0xff, byte(vm.SELFDESTRUCT)},
+			step: 1,
+			want: 7600,
+		},
+	} {
+		if have := stepGasCost(t, true, tc.code, nil, tc.step); have != tc.want {
+			t.Errorf("testcase %d, gas mismatch: have %d, want %d", i, have, tc.want)
+		}
+	}
+}
+
+func TestWarmAccessCost(t *testing.T) {
+	sload := []byte{
+		byte(vm.PUSH1), 0x0, byte(vm.SLOAD), byte(vm.POP),
+		byte(vm.PUSH1), 0x0, byte(vm.SLOAD), byte(vm.POP),
+	}
+	balance := []byte{byte(vm.PUSH1), 0xff, byte(vm.BALANCE), byte(vm.POP)}
+	list := types.AccessList{
+		{Address: common.BytesToAddress([]byte("contract")), StorageKeys: []common.Hash{{}}},
+		{Address: common.BytesToAddress([]byte{0xff})},
+	}
+
+	for i, tc := range []struct {
+		berlin bool
+		code   []byte
+		list   types.AccessList
+		step   int
+		want   uint64
+	}{
+		{true, sload, nil, 1, params.ColdSloadCostEIP2929},
+		{true, sload, nil, 4, params.WarmStorageReadCostEIP2929},
+		{true, sload, list, 1, params.WarmStorageReadCostEIP2929},
+		{true, balance, list, 1, params.WarmStorageReadCostEIP2929},
+		// precompiles are warm
+		{true, []byte{byte(vm.PUSH1), 0x01, byte(vm.BALANCE), byte(vm.POP)}, nil, 1, params.WarmStorageReadCostEIP2929},
+		{true, []byte{byte(vm.PUSH1), 0x0b, byte(vm.BALANCE), byte(vm.POP)}, nil, 1, params.WarmStorageReadCostEIP2929},
+		// 0x0a is reserved for the point evaluation, which is not active
+		{true, []byte{byte(vm.PUSH1), 0x0a, byte(vm.BALANCE), byte(vm.POP)}, nil, 1, params.ColdAccountAccessCostEIP2929},
+		{true, []byte{byte(vm.PUSH2), 0x01, 0x00, byte(vm.BALANCE), byte(vm.POP)}, nil, 1, params.WarmStorageReadCostEIP2929},
+		// cold 0 => non 0
+		{true, []byte{byte(vm.PUSH1), 0x1, byte(vm.PUSH1), 0x0, byte(vm.SSTORE)}, nil, 2, params.ColdSloadCostEIP2929 + params.SstoreSetGas},
+		// prices are unchanged before berlin
+		{false, sload, nil, 1, params.GasTableConstantinople.SLoad},
+		{false, balance, nil, 1, params.GasTableConstantinople.Balance},
+	} {
+		if have := stepGasCost(t, tc.berlin, tc.code, tc.list, tc.step); have != tc.want {
+			t.Errorf("testcase %d, gas mismatch: have %d, want %d", i, have, tc.want)
+		}
+	}
+}
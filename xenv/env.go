@@ -42,6 +42,7 @@ type TransactionContext struct {
 	Expiration uint32
 	Nonce      uint64
 	Counter    uint64
+	AccessList types.AccessList // access list of typed ethereum tx
 }
 
 func (ctx *TransactionContext) String() string {